@@ -3,21 +3,285 @@ package ugdmintv1beta1
 
 import (
 	_ "cosmossdk.io/api/amino"
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	fmt "fmt"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	reflect "reflect"
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_GenesisState_3_list)(nil)
+
+type _GenesisState_3_list struct {
+	list *[]*ProcessedMint
+}
+
+func (x *_GenesisState_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ProcessedMint)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ProcessedMint)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_3_list) AppendMutable() protoreflect.Value {
+	v := new(ProcessedMint)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_3_list) NewElement() protoreflect.Value {
+	v := new(ProcessedMint)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_5_list)(nil)
+
+type _GenesisState_5_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_GenesisState_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_5_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_5_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_7_list)(nil)
+
+type _GenesisState_7_list struct {
+	list *[]*ScheduledParamsChange
+}
+
+func (x *_GenesisState_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ScheduledParamsChange)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ScheduledParamsChange)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_7_list) AppendMutable() protoreflect.Value {
+	v := new(ScheduledParamsChange)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_7_list) NewElement() protoreflect.Value {
+	v := new(ScheduledParamsChange)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_8_list)(nil)
+
+type _GenesisState_8_list struct {
+	list *[]*EscrowSchedule
+}
+
+func (x *_GenesisState_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EscrowSchedule)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EscrowSchedule)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_8_list) AppendMutable() protoreflect.Value {
+	v := new(EscrowSchedule)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_8_list) NewElement() protoreflect.Value {
+	v := new(EscrowSchedule)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_9_list)(nil)
+
+type _GenesisState_9_list struct {
+	list *[]*AgreedMints
+}
+
+func (x *_GenesisState_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AgreedMints)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AgreedMints)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_9_list) AppendMutable() protoreflect.Value {
+	v := new(AgreedMints)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_9_list) NewElement() protoreflect.Value {
+	v := new(AgreedMints)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_GenesisState        protoreflect.MessageDescriptor
-	fd_GenesisState_minter protoreflect.FieldDescriptor
-	fd_GenesisState_params protoreflect.FieldDescriptor
+	md_GenesisState                          protoreflect.MessageDescriptor
+	fd_GenesisState_minter                   protoreflect.FieldDescriptor
+	fd_GenesisState_params                   protoreflect.FieldDescriptor
+	fd_GenesisState_processed_mints          protoreflect.FieldDescriptor
+	fd_GenesisState_previous_block_time      protoreflect.FieldDescriptor
+	fd_GenesisState_total_minted             protoreflect.FieldDescriptor
+	fd_GenesisState_minting_pause            protoreflect.FieldDescriptor
+	fd_GenesisState_scheduled_params_changes protoreflect.FieldDescriptor
+	fd_GenesisState_escrow_schedules         protoreflect.FieldDescriptor
+	fd_GenesisState_agreed_mints             protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -25,6 +289,13 @@ func init() {
 	md_GenesisState = File_cosmos_ugdmint_v1beta1_genesis_proto.Messages().ByName("GenesisState")
 	fd_GenesisState_minter = md_GenesisState.Fields().ByName("minter")
 	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
+	fd_GenesisState_processed_mints = md_GenesisState.Fields().ByName("processed_mints")
+	fd_GenesisState_previous_block_time = md_GenesisState.Fields().ByName("previous_block_time")
+	fd_GenesisState_total_minted = md_GenesisState.Fields().ByName("total_minted")
+	fd_GenesisState_minting_pause = md_GenesisState.Fields().ByName("minting_pause")
+	fd_GenesisState_scheduled_params_changes = md_GenesisState.Fields().ByName("scheduled_params_changes")
+	fd_GenesisState_escrow_schedules = md_GenesisState.Fields().ByName("escrow_schedules")
+	fd_GenesisState_agreed_mints = md_GenesisState.Fields().ByName("agreed_mints")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -104,6 +375,48 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.ProcessedMints) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_3_list{list: &x.ProcessedMints})
+		if !f(fd_GenesisState_processed_mints, value) {
+			return
+		}
+	}
+	if x.PreviousBlockTime != nil {
+		value := protoreflect.ValueOfMessage(x.PreviousBlockTime.ProtoReflect())
+		if !f(fd_GenesisState_previous_block_time, value) {
+			return
+		}
+	}
+	if len(x.TotalMinted) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_5_list{list: &x.TotalMinted})
+		if !f(fd_GenesisState_total_minted, value) {
+			return
+		}
+	}
+	if x.MintingPause != nil {
+		value := protoreflect.ValueOfMessage(x.MintingPause.ProtoReflect())
+		if !f(fd_GenesisState_minting_pause, value) {
+			return
+		}
+	}
+	if len(x.ScheduledParamsChanges) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_7_list{list: &x.ScheduledParamsChanges})
+		if !f(fd_GenesisState_scheduled_params_changes, value) {
+			return
+		}
+	}
+	if len(x.EscrowSchedules) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_8_list{list: &x.EscrowSchedules})
+		if !f(fd_GenesisState_escrow_schedules, value) {
+			return
+		}
+	}
+	if len(x.AgreedMints) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_9_list{list: &x.AgreedMints})
+		if !f(fd_GenesisState_agreed_mints, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -123,6 +436,20 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return x.Minter != nil
 	case "cosmos.ugdmint.v1beta1.GenesisState.params":
 		return x.Params != nil
+	case "cosmos.ugdmint.v1beta1.GenesisState.processed_mints":
+		return len(x.ProcessedMints) != 0
+	case "cosmos.ugdmint.v1beta1.GenesisState.previous_block_time":
+		return x.PreviousBlockTime != nil
+	case "cosmos.ugdmint.v1beta1.GenesisState.total_minted":
+		return len(x.TotalMinted) != 0
+	case "cosmos.ugdmint.v1beta1.GenesisState.minting_pause":
+		return x.MintingPause != nil
+	case "cosmos.ugdmint.v1beta1.GenesisState.scheduled_params_changes":
+		return len(x.ScheduledParamsChanges) != 0
+	case "cosmos.ugdmint.v1beta1.GenesisState.escrow_schedules":
+		return len(x.EscrowSchedules) != 0
+	case "cosmos.ugdmint.v1beta1.GenesisState.agreed_mints":
+		return len(x.AgreedMints) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.ugdmint.v1beta1.GenesisState"))
@@ -143,6 +470,20 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.Minter = nil
 	case "cosmos.ugdmint.v1beta1.GenesisState.params":
 		x.Params = nil
+	case "cosmos.ugdmint.v1beta1.GenesisState.processed_mints":
+		x.ProcessedMints = nil
+	case "cosmos.ugdmint.v1beta1.GenesisState.previous_block_time":
+		x.PreviousBlockTime = nil
+	case "cosmos.ugdmint.v1beta1.GenesisState.total_minted":
+		x.TotalMinted = nil
+	case "cosmos.ugdmint.v1beta1.GenesisState.minting_pause":
+		x.MintingPause = nil
+	case "cosmos.ugdmint.v1beta1.GenesisState.scheduled_params_changes":
+		x.ScheduledParamsChanges = nil
+	case "cosmos.ugdmint.v1beta1.GenesisState.escrow_schedules":
+		x.EscrowSchedules = nil
+	case "cosmos.ugdmint.v1beta1.GenesisState.agreed_mints":
+		x.AgreedMints = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.ugdmint.v1beta1.GenesisState"))
@@ -165,6 +506,42 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 	case "cosmos.ugdmint.v1beta1.GenesisState.params":
 		value := x.Params
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.ugdmint.v1beta1.GenesisState.processed_mints":
+		if len(x.ProcessedMints) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_3_list{})
+		}
+		listValue := &_GenesisState_3_list{list: &x.ProcessedMints}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.ugdmint.v1beta1.GenesisState.previous_block_time":
+		value := x.PreviousBlockTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.ugdmint.v1beta1.GenesisState.total_minted":
+		if len(x.TotalMinted) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_5_list{})
+		}
+		listValue := &_GenesisState_5_list{list: &x.TotalMinted}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.ugdmint.v1beta1.GenesisState.minting_pause":
+		value := x.MintingPause
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.ugdmint.v1beta1.GenesisState.scheduled_params_changes":
+		if len(x.ScheduledParamsChanges) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_7_list{})
+		}
+		listValue := &_GenesisState_7_list{list: &x.ScheduledParamsChanges}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.ugdmint.v1beta1.GenesisState.escrow_schedules":
+		if len(x.EscrowSchedules) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_8_list{})
+		}
+		listValue := &_GenesisState_8_list{list: &x.EscrowSchedules}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.ugdmint.v1beta1.GenesisState.agreed_mints":
+		if len(x.AgreedMints) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_9_list{})
+		}
+		listValue := &_GenesisState_9_list{list: &x.AgreedMints}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.ugdmint.v1beta1.GenesisState"))
@@ -189,6 +566,30 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		x.Minter = value.Message().Interface().(*Minter)
 	case "cosmos.ugdmint.v1beta1.GenesisState.params":
 		x.Params = value.Message().Interface().(*Params)
+	case "cosmos.ugdmint.v1beta1.GenesisState.processed_mints":
+		lv := value.List()
+		clv := lv.(*_GenesisState_3_list)
+		x.ProcessedMints = *clv.list
+	case "cosmos.ugdmint.v1beta1.GenesisState.previous_block_time":
+		x.PreviousBlockTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "cosmos.ugdmint.v1beta1.GenesisState.total_minted":
+		lv := value.List()
+		clv := lv.(*_GenesisState_5_list)
+		x.TotalMinted = *clv.list
+	case "cosmos.ugdmint.v1beta1.GenesisState.minting_pause":
+		x.MintingPause = value.Message().Interface().(*MintingPause)
+	case "cosmos.ugdmint.v1beta1.GenesisState.scheduled_params_changes":
+		lv := value.List()
+		clv := lv.(*_GenesisState_7_list)
+		x.ScheduledParamsChanges = *clv.list
+	case "cosmos.ugdmint.v1beta1.GenesisState.escrow_schedules":
+		lv := value.List()
+		clv := lv.(*_GenesisState_8_list)
+		x.EscrowSchedules = *clv.list
+	case "cosmos.ugdmint.v1beta1.GenesisState.agreed_mints":
+		lv := value.List()
+		clv := lv.(*_GenesisState_9_list)
+		x.AgreedMints = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.ugdmint.v1beta1.GenesisState"))
@@ -219,6 +620,46 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 			x.Params = new(Params)
 		}
 		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "cosmos.ugdmint.v1beta1.GenesisState.processed_mints":
+		if x.ProcessedMints == nil {
+			x.ProcessedMints = []*ProcessedMint{}
+		}
+		value := &_GenesisState_3_list{list: &x.ProcessedMints}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.ugdmint.v1beta1.GenesisState.previous_block_time":
+		if x.PreviousBlockTime == nil {
+			x.PreviousBlockTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.PreviousBlockTime.ProtoReflect())
+	case "cosmos.ugdmint.v1beta1.GenesisState.total_minted":
+		if x.TotalMinted == nil {
+			x.TotalMinted = []*v1beta1.Coin{}
+		}
+		value := &_GenesisState_5_list{list: &x.TotalMinted}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.ugdmint.v1beta1.GenesisState.minting_pause":
+		if x.MintingPause == nil {
+			x.MintingPause = new(MintingPause)
+		}
+		return protoreflect.ValueOfMessage(x.MintingPause.ProtoReflect())
+	case "cosmos.ugdmint.v1beta1.GenesisState.scheduled_params_changes":
+		if x.ScheduledParamsChanges == nil {
+			x.ScheduledParamsChanges = []*ScheduledParamsChange{}
+		}
+		value := &_GenesisState_7_list{list: &x.ScheduledParamsChanges}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.ugdmint.v1beta1.GenesisState.escrow_schedules":
+		if x.EscrowSchedules == nil {
+			x.EscrowSchedules = []*EscrowSchedule{}
+		}
+		value := &_GenesisState_8_list{list: &x.EscrowSchedules}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.ugdmint.v1beta1.GenesisState.agreed_mints":
+		if x.AgreedMints == nil {
+			x.AgreedMints = []*AgreedMints{}
+		}
+		value := &_GenesisState_9_list{list: &x.AgreedMints}
+		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.ugdmint.v1beta1.GenesisState"))
@@ -238,6 +679,27 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 	case "cosmos.ugdmint.v1beta1.GenesisState.params":
 		m := new(Params)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.ugdmint.v1beta1.GenesisState.processed_mints":
+		list := []*ProcessedMint{}
+		return protoreflect.ValueOfList(&_GenesisState_3_list{list: &list})
+	case "cosmos.ugdmint.v1beta1.GenesisState.previous_block_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.ugdmint.v1beta1.GenesisState.total_minted":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_GenesisState_5_list{list: &list})
+	case "cosmos.ugdmint.v1beta1.GenesisState.minting_pause":
+		m := new(MintingPause)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.ugdmint.v1beta1.GenesisState.scheduled_params_changes":
+		list := []*ScheduledParamsChange{}
+		return protoreflect.ValueOfList(&_GenesisState_7_list{list: &list})
+	case "cosmos.ugdmint.v1beta1.GenesisState.escrow_schedules":
+		list := []*EscrowSchedule{}
+		return protoreflect.ValueOfList(&_GenesisState_8_list{list: &list})
+	case "cosmos.ugdmint.v1beta1.GenesisState.agreed_mints":
+		list := []*AgreedMints{}
+		return protoreflect.ValueOfList(&_GenesisState_9_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.ugdmint.v1beta1.GenesisState"))
@@ -315,6 +777,44 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.Params)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.ProcessedMints) > 0 {
+			for _, e := range x.ProcessedMints {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.PreviousBlockTime != nil {
+			l = options.Size(x.PreviousBlockTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.TotalMinted) > 0 {
+			for _, e := range x.TotalMinted {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.MintingPause != nil {
+			l = options.Size(x.MintingPause)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.ScheduledParamsChanges) > 0 {
+			for _, e := range x.ScheduledParamsChanges {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.EscrowSchedules) > 0 {
+			for _, e := range x.EscrowSchedules {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.AgreedMints) > 0 {
+			for _, e := range x.AgreedMints {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -344,6 +844,114 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.AgreedMints) > 0 {
+			for iNdEx := len(x.AgreedMints) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.AgreedMints[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if len(x.EscrowSchedules) > 0 {
+			for iNdEx := len(x.EscrowSchedules) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.EscrowSchedules[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if len(x.ScheduledParamsChanges) > 0 {
+			for iNdEx := len(x.ScheduledParamsChanges) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ScheduledParamsChanges[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if x.MintingPause != nil {
+			encoded, err := options.Marshal(x.MintingPause)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.TotalMinted) > 0 {
+			for iNdEx := len(x.TotalMinted) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TotalMinted[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.PreviousBlockTime != nil {
+			encoded, err := options.Marshal(x.PreviousBlockTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.ProcessedMints) > 0 {
+			for iNdEx := len(x.ProcessedMints) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ProcessedMints[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
 		if x.Params != nil {
 			encoded, err := options.Marshal(x.Params)
 			if err != nil {
@@ -493,6 +1101,248 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProcessedMints", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ProcessedMints = append(x.ProcessedMints, &ProcessedMint{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ProcessedMints[len(x.ProcessedMints)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousBlockTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.PreviousBlockTime == nil {
+					x.PreviousBlockTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PreviousBlockTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalMinted", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TotalMinted = append(x.TotalMinted, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TotalMinted[len(x.TotalMinted)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MintingPause", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.MintingPause == nil {
+					x.MintingPause = &MintingPause{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MintingPause); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ScheduledParamsChanges", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ScheduledParamsChanges = append(x.ScheduledParamsChanges, &ScheduledParamsChange{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ScheduledParamsChanges[len(x.ScheduledParamsChanges)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EscrowSchedules", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EscrowSchedules = append(x.EscrowSchedules, &EscrowSchedule{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EscrowSchedules[len(x.EscrowSchedules)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AgreedMints", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AgreedMints = append(x.AgreedMints, &AgreedMints{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AgreedMints[len(x.AgreedMints)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -551,6 +1401,27 @@ type GenesisState struct {
 	Minter *Minter `protobuf:"bytes,1,opt,name=minter,proto3" json:"minter,omitempty"`
 	// params defines all the parameters of the module.
 	Params *Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+	// processed_mints holds the Hedgehog mints that have already been paid out.
+	ProcessedMints []*ProcessedMint `protobuf:"bytes,3,rep,name=processed_mints,json=processedMints,proto3" json:"processed_mints,omitempty"`
+	// previous_block_time is the time of the last block the module has seen. It
+	// is unset for a new chain.
+	PreviousBlockTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=previous_block_time,json=previousBlockTime,proto3" json:"previous_block_time,omitempty"`
+	// total_minted is the supply of every denom counted against the max_supply
+	// param. Denoms missing from it are counted from their supply in the bank
+	// module.
+	TotalMinted []*v1beta1.Coin `protobuf:"bytes,5,rep,name=total_minted,json=totalMinted,proto3" json:"total_minted,omitempty"`
+	// minting_pause holds the kinds of minting that are paused. It is unset when
+	// nothing is paused.
+	MintingPause *MintingPause `protobuf:"bytes,6,opt,name=minting_pause,json=mintingPause,proto3" json:"minting_pause,omitempty"`
+	// scheduled_params_changes holds the params changes that are still to be
+	// applied.
+	ScheduledParamsChanges []*ScheduledParamsChange `protobuf:"bytes,7,rep,name=scheduled_params_changes,json=scheduledParamsChanges,proto3" json:"scheduled_params_changes,omitempty"`
+	// escrow_schedules holds the coins of Hedgehog mints that are still in
+	// escrow.
+	EscrowSchedules []*EscrowSchedule `protobuf:"bytes,8,rep,name=escrow_schedules,json=escrowSchedules,proto3" json:"escrow_schedules,omitempty"`
+	// agreed_mints holds the Hedgehog mints agreed on while Hedgehog mints were
+	// paused, which are paid out once they are resumed.
+	AgreedMints []*AgreedMints `protobuf:"bytes,9,rep,name=agreed_mints,json=agreedMints,proto3" json:"agreed_mints,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -587,6 +1458,55 @@ func (x *GenesisState) GetParams() *Params {
 	return nil
 }
 
+func (x *GenesisState) GetProcessedMints() []*ProcessedMint {
+	if x != nil {
+		return x.ProcessedMints
+	}
+	return nil
+}
+
+func (x *GenesisState) GetPreviousBlockTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PreviousBlockTime
+	}
+	return nil
+}
+
+func (x *GenesisState) GetTotalMinted() []*v1beta1.Coin {
+	if x != nil {
+		return x.TotalMinted
+	}
+	return nil
+}
+
+func (x *GenesisState) GetMintingPause() *MintingPause {
+	if x != nil {
+		return x.MintingPause
+	}
+	return nil
+}
+
+func (x *GenesisState) GetScheduledParamsChanges() []*ScheduledParamsChange {
+	if x != nil {
+		return x.ScheduledParamsChanges
+	}
+	return nil
+}
+
+func (x *GenesisState) GetEscrowSchedules() []*EscrowSchedule {
+	if x != nil {
+		return x.EscrowSchedules
+	}
+	return nil
+}
+
+func (x *GenesisState) GetAgreedMints() []*AgreedMints {
+	if x != nil {
+		return x.AgreedMints
+	}
+	return nil
+}
+
 var File_cosmos_ugdmint_v1beta1_genesis_proto protoreflect.FileDescriptor
 
 var file_cosmos_ugdmint_v1beta1_genesis_proto_rawDesc = []byte{
@@ -595,34 +1515,86 @@ var file_cosmos_ugdmint_v1beta1_genesis_proto_rawDesc = []byte{
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x75,
 	0x67, 0x64, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x1a, 0x14,
 	0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x23, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x75, 0x67, 0x64,
-	0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f,
-	0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x94, 0x01, 0x0a,
-	0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x41, 0x0a,
-	0x06, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x75, 0x67, 0x64, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x42, 0x09, 0xc8,
-	0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x72,
-	0x12, 0x41, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1e, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x75, 0x67, 0x64, 0x6d, 0x69, 0x6e,
-	0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x42, 0xdc, 0x01, 0x0a, 0x1a, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x75, 0x67, 0x64, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x50, 0x01, 0x5a, 0x36, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x75, 0x67, 0x64, 0x6d,
-	0x69, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x75, 0x67, 0x64, 0x6d,
-	0x69, 0x6e, 0x74, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x55, 0x58,
-	0xaa, 0x02, 0x16, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x55, 0x67, 0x64, 0x6d, 0x69, 0x6e,
-	0x74, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x16, 0x43, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x5c, 0x55, 0x67, 0x64, 0x6d, 0x69, 0x6e, 0x74, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0xe2, 0x02, 0x22, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x55, 0x67, 0x64, 0x6d,
-	0x69, 0x6e, 0x74, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x18, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x3a, 0x3a, 0x55, 0x67, 0x64, 0x6d, 0x69, 0x6e, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x23, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x75, 0x67,
+	0x64, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e,
+	0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x28, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x75, 0x67, 0x64, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
+	0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69,
+	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
+	0x75, 0x67, 0x64, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f,
+	0x76, 0x6f, 0x74, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb8, 0x06, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x41, 0x0a, 0x06, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x75,
+	0x67, 0x64, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x06, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x41, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x75, 0x67, 0x64, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x59, 0x0a, 0x0f, 0x70,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x6d, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x75, 0x67,
+	0x64, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x4d, 0x69, 0x6e, 0x74, 0x42, 0x09, 0xc8, 0xde, 0x1f,
+	0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65,
+	0x64, 0x4d, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x50, 0x0a, 0x13, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f,
+	0x75, 0x73, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42,
+	0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x11, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x84, 0x01, 0x0a, 0x0c, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00,
+	0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b,
+	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a,
+	0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4d, 0x69, 0x6e, 0x74, 0x65, 0x64, 0x12,
+	0x49, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x61, 0x75, 0x73, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x75, 0x67, 0x64, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x4d, 0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x75, 0x73, 0x65, 0x52, 0x0c, 0x6d, 0x69,
+	0x6e, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x75, 0x73, 0x65, 0x12, 0x72, 0x0a, 0x18, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x5f, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x75, 0x67, 0x64, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x09, 0xc8, 0xde, 0x1f,
+	0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x16, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x5c,
+	0x0a, 0x10, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x75, 0x67, 0x64, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0f, 0x65, 0x73, 0x63,
+	0x72, 0x6f, 0x77, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x51, 0x0a, 0x0c,
+	0x61, 0x67, 0x72, 0x65, 0x65, 0x64, 0x5f, 0x6d, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x09, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x75, 0x67, 0x64, 0x6d,
+	0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x41, 0x67, 0x72, 0x65,
+	0x65, 0x64, 0x4d, 0x69, 0x6e, 0x74, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x0b, 0x61, 0x67, 0x72, 0x65, 0x65, 0x64, 0x4d, 0x69, 0x6e, 0x74, 0x73, 0x42,
+	0xdc, 0x01, 0x0a, 0x1a, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x75,
+	0x67, 0x64, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0c,
+	0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x36,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x75, 0x67, 0x64, 0x6d, 0x69, 0x6e, 0x74, 0x2f,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x75, 0x67, 0x64, 0x6d, 0x69, 0x6e, 0x74, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x55, 0x58, 0xaa, 0x02, 0x16, 0x43,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x55, 0x67, 0x64, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x56, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x16, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x55,
+	0x67, 0x64, 0x6d, 0x69, 0x6e, 0x74, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02,
+	0x22, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x55, 0x67, 0x64, 0x6d, 0x69, 0x6e, 0x74, 0x5c,
+	0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0xea, 0x02, 0x18, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x55, 0x67,
+	0x64, 0x6d, 0x69, 0x6e, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -639,18 +1611,32 @@ func file_cosmos_ugdmint_v1beta1_genesis_proto_rawDescGZIP() []byte {
 
 var file_cosmos_ugdmint_v1beta1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_cosmos_ugdmint_v1beta1_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil), // 0: cosmos.ugdmint.v1beta1.GenesisState
-	(*Minter)(nil),       // 1: cosmos.ugdmint.v1beta1.Minter
-	(*Params)(nil),       // 2: cosmos.ugdmint.v1beta1.Params
+	(*GenesisState)(nil),          // 0: cosmos.ugdmint.v1beta1.GenesisState
+	(*Minter)(nil),                // 1: cosmos.ugdmint.v1beta1.Minter
+	(*Params)(nil),                // 2: cosmos.ugdmint.v1beta1.Params
+	(*ProcessedMint)(nil),         // 3: cosmos.ugdmint.v1beta1.ProcessedMint
+	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
+	(*v1beta1.Coin)(nil),          // 5: cosmos.base.v1beta1.Coin
+	(*MintingPause)(nil),          // 6: cosmos.ugdmint.v1beta1.MintingPause
+	(*ScheduledParamsChange)(nil), // 7: cosmos.ugdmint.v1beta1.ScheduledParamsChange
+	(*EscrowSchedule)(nil),        // 8: cosmos.ugdmint.v1beta1.EscrowSchedule
+	(*AgreedMints)(nil),           // 9: cosmos.ugdmint.v1beta1.AgreedMints
 }
 var file_cosmos_ugdmint_v1beta1_genesis_proto_depIdxs = []int32{
 	1, // 0: cosmos.ugdmint.v1beta1.GenesisState.minter:type_name -> cosmos.ugdmint.v1beta1.Minter
 	2, // 1: cosmos.ugdmint.v1beta1.GenesisState.params:type_name -> cosmos.ugdmint.v1beta1.Params
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	3, // 2: cosmos.ugdmint.v1beta1.GenesisState.processed_mints:type_name -> cosmos.ugdmint.v1beta1.ProcessedMint
+	4, // 3: cosmos.ugdmint.v1beta1.GenesisState.previous_block_time:type_name -> google.protobuf.Timestamp
+	5, // 4: cosmos.ugdmint.v1beta1.GenesisState.total_minted:type_name -> cosmos.base.v1beta1.Coin
+	6, // 5: cosmos.ugdmint.v1beta1.GenesisState.minting_pause:type_name -> cosmos.ugdmint.v1beta1.MintingPause
+	7, // 6: cosmos.ugdmint.v1beta1.GenesisState.scheduled_params_changes:type_name -> cosmos.ugdmint.v1beta1.ScheduledParamsChange
+	8, // 7: cosmos.ugdmint.v1beta1.GenesisState.escrow_schedules:type_name -> cosmos.ugdmint.v1beta1.EscrowSchedule
+	9, // 8: cosmos.ugdmint.v1beta1.GenesisState.agreed_mints:type_name -> cosmos.ugdmint.v1beta1.AgreedMints
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_ugdmint_v1beta1_genesis_proto_init() }
@@ -659,6 +1645,8 @@ func file_cosmos_ugdmint_v1beta1_genesis_proto_init() {
 		return
 	}
 	file_cosmos_ugdmint_v1beta1_params_proto_init()
+	file_cosmos_ugdmint_v1beta1_mint_record_proto_init()
+	file_cosmos_ugdmint_v1beta1_vote_extension_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_cosmos_ugdmint_v1beta1_genesis_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GenesisState); i {
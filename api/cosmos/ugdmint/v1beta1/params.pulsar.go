@@ -3,6 +3,7 @@ package ugdmintv1beta1
 
 import (
 	_ "cosmossdk.io/api/amino"
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
@@ -15,15 +16,182 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_Minter_2_list)(nil)
+
+type _Minter_2_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_Minter_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Minter_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Minter_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Minter_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Minter_2_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Minter_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Minter_2_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Minter_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Minter_3_list)(nil)
+
+type _Minter_3_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_Minter_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Minter_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Minter_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Minter_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Minter_3_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Minter_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Minter_3_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Minter_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Minter_5_list)(nil)
+
+type _Minter_5_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_Minter_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Minter_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Minter_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Minter_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Minter_5_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Minter_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Minter_5_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Minter_5_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Minter                          protoreflect.MessageDescriptor
-	fd_Minter_subsidy_halving_interval protoreflect.FieldDescriptor
+	md_Minter                             protoreflect.MessageDescriptor
+	fd_Minter_subsidy_halving_interval    protoreflect.FieldDescriptor
+	fd_Minter_block_provision_minted      protoreflect.FieldDescriptor
+	fd_Minter_hedgehog_minted             protoreflect.FieldDescriptor
+	fd_Minter_last_mint_height            protoreflect.FieldDescriptor
+	fd_Minter_block_subsidy               protoreflect.FieldDescriptor
+	fd_Minter_inflation                   protoreflect.FieldDescriptor
+	fd_Minter_annual_provisions           protoreflect.FieldDescriptor
+	fd_Minter_observed_block_time_seconds protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_ugdmint_v1beta1_params_proto_init()
 	md_Minter = File_cosmos_ugdmint_v1beta1_params_proto.Messages().ByName("Minter")
 	fd_Minter_subsidy_halving_interval = md_Minter.Fields().ByName("subsidy_halving_interval")
+	fd_Minter_block_provision_minted = md_Minter.Fields().ByName("block_provision_minted")
+	fd_Minter_hedgehog_minted = md_Minter.Fields().ByName("hedgehog_minted")
+	fd_Minter_last_mint_height = md_Minter.Fields().ByName("last_mint_height")
+	fd_Minter_block_subsidy = md_Minter.Fields().ByName("block_subsidy")
+	fd_Minter_inflation = md_Minter.Fields().ByName("inflation")
+	fd_Minter_annual_provisions = md_Minter.Fields().ByName("annual_provisions")
+	fd_Minter_observed_block_time_seconds = md_Minter.Fields().ByName("observed_block_time_seconds")
 }
 
 var _ protoreflect.Message = (*fastReflection_Minter)(nil)
@@ -97,6 +265,48 @@ func (x *fastReflection_Minter) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if len(x.BlockProvisionMinted) != 0 {
+		value := protoreflect.ValueOfList(&_Minter_2_list{list: &x.BlockProvisionMinted})
+		if !f(fd_Minter_block_provision_minted, value) {
+			return
+		}
+	}
+	if len(x.HedgehogMinted) != 0 {
+		value := protoreflect.ValueOfList(&_Minter_3_list{list: &x.HedgehogMinted})
+		if !f(fd_Minter_hedgehog_minted, value) {
+			return
+		}
+	}
+	if x.LastMintHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.LastMintHeight)
+		if !f(fd_Minter_last_mint_height, value) {
+			return
+		}
+	}
+	if len(x.BlockSubsidy) != 0 {
+		value := protoreflect.ValueOfList(&_Minter_5_list{list: &x.BlockSubsidy})
+		if !f(fd_Minter_block_subsidy, value) {
+			return
+		}
+	}
+	if x.Inflation != "" {
+		value := protoreflect.ValueOfString(x.Inflation)
+		if !f(fd_Minter_inflation, value) {
+			return
+		}
+	}
+	if x.AnnualProvisions != "" {
+		value := protoreflect.ValueOfString(x.AnnualProvisions)
+		if !f(fd_Minter_annual_provisions, value) {
+			return
+		}
+	}
+	if x.ObservedBlockTimeSeconds != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ObservedBlockTimeSeconds)
+		if !f(fd_Minter_observed_block_time_seconds, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -114,6 +324,20 @@ func (x *fastReflection_Minter) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	case "cosmos.ugdmint.v1beta1.Minter.subsidy_halving_interval":
 		return x.SubsidyHalvingInterval != ""
+	case "cosmos.ugdmint.v1beta1.Minter.block_provision_minted":
+		return len(x.BlockProvisionMinted) != 0
+	case "cosmos.ugdmint.v1beta1.Minter.hedgehog_minted":
+		return len(x.HedgehogMinted) != 0
+	case "cosmos.ugdmint.v1beta1.Minter.last_mint_height":
+		return x.LastMintHeight != int64(0)
+	case "cosmos.ugdmint.v1beta1.Minter.block_subsidy":
+		return len(x.BlockSubsidy) != 0
+	case "cosmos.ugdmint.v1beta1.Minter.inflation":
+		return x.Inflation != ""
+	case "cosmos.ugdmint.v1beta1.Minter.annual_provisions":
+		return x.AnnualProvisions != ""
+	case "cosmos.ugdmint.v1beta1.Minter.observed_block_time_seconds":
+		return x.ObservedBlockTimeSeconds != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.ugdmint.v1beta1.Minter"))
@@ -132,6 +356,20 @@ func (x *fastReflection_Minter) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	case "cosmos.ugdmint.v1beta1.Minter.subsidy_halving_interval":
 		x.SubsidyHalvingInterval = ""
+	case "cosmos.ugdmint.v1beta1.Minter.block_provision_minted":
+		x.BlockProvisionMinted = nil
+	case "cosmos.ugdmint.v1beta1.Minter.hedgehog_minted":
+		x.HedgehogMinted = nil
+	case "cosmos.ugdmint.v1beta1.Minter.last_mint_height":
+		x.LastMintHeight = int64(0)
+	case "cosmos.ugdmint.v1beta1.Minter.block_subsidy":
+		x.BlockSubsidy = nil
+	case "cosmos.ugdmint.v1beta1.Minter.inflation":
+		x.Inflation = ""
+	case "cosmos.ugdmint.v1beta1.Minter.annual_provisions":
+		x.AnnualProvisions = ""
+	case "cosmos.ugdmint.v1beta1.Minter.observed_block_time_seconds":
+		x.ObservedBlockTimeSeconds = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.ugdmint.v1beta1.Minter"))
@@ -151,6 +389,36 @@ func (x *fastReflection_Minter) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "cosmos.ugdmint.v1beta1.Minter.subsidy_halving_interval":
 		value := x.SubsidyHalvingInterval
 		return protoreflect.ValueOfString(value)
+	case "cosmos.ugdmint.v1beta1.Minter.block_provision_minted":
+		if len(x.BlockProvisionMinted) == 0 {
+			return protoreflect.ValueOfList(&_Minter_2_list{})
+		}
+		listValue := &_Minter_2_list{list: &x.BlockProvisionMinted}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.ugdmint.v1beta1.Minter.hedgehog_minted":
+		if len(x.HedgehogMinted) == 0 {
+			return protoreflect.ValueOfList(&_Minter_3_list{})
+		}
+		listValue := &_Minter_3_list{list: &x.HedgehogMinted}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.ugdmint.v1beta1.Minter.last_mint_height":
+		value := x.LastMintHeight
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.ugdmint.v1beta1.Minter.block_subsidy":
+		if len(x.BlockSubsidy) == 0 {
+			return protoreflect.ValueOfList(&_Minter_5_list{})
+		}
+		listValue := &_Minter_5_list{list: &x.BlockSubsidy}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.ugdmint.v1beta1.Minter.inflation":
+		value := x.Inflation
+		return protoreflect.ValueOfString(value)
+	case "cosmos.ugdmint.v1beta1.Minter.annual_provisions":
+		value := x.AnnualProvisions
+		return protoreflect.ValueOfString(value)
+	case "cosmos.ugdmint.v1beta1.Minter.observed_block_time_seconds":
+		value := x.ObservedBlockTimeSeconds
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.ugdmint.v1beta1.Minter"))
@@ -173,6 +441,26 @@ func (x *fastReflection_Minter) Set(fd protoreflect.FieldDescriptor, value proto
 	switch fd.FullName() {
 	case "cosmos.ugdmint.v1beta1.Minter.subsidy_halving_interval":
 		x.SubsidyHalvingInterval = value.Interface().(string)
+	case "cosmos.ugdmint.v1beta1.Minter.block_provision_minted":
+		lv := value.List()
+		clv := lv.(*_Minter_2_list)
+		x.BlockProvisionMinted = *clv.list
+	case "cosmos.ugdmint.v1beta1.Minter.hedgehog_minted":
+		lv := value.List()
+		clv := lv.(*_Minter_3_list)
+		x.HedgehogMinted = *clv.list
+	case "cosmos.ugdmint.v1beta1.Minter.last_mint_height":
+		x.LastMintHeight = value.Int()
+	case "cosmos.ugdmint.v1beta1.Minter.block_subsidy":
+		lv := value.List()
+		clv := lv.(*_Minter_5_list)
+		x.BlockSubsidy = *clv.list
+	case "cosmos.ugdmint.v1beta1.Minter.inflation":
+		x.Inflation = value.Interface().(string)
+	case "cosmos.ugdmint.v1beta1.Minter.annual_provisions":
+		x.AnnualProvisions = value.Interface().(string)
+	case "cosmos.ugdmint.v1beta1.Minter.observed_block_time_seconds":
+		x.ObservedBlockTimeSeconds = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.ugdmint.v1beta1.Minter"))
@@ -193,8 +481,34 @@ func (x *fastReflection_Minter) Set(fd protoreflect.FieldDescriptor, value proto
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Minter) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "cosmos.ugdmint.v1beta1.Minter.block_provision_minted":
+		if x.BlockProvisionMinted == nil {
+			x.BlockProvisionMinted = []*v1beta1.Coin{}
+		}
+		value := &_Minter_2_list{list: &x.BlockProvisionMinted}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.ugdmint.v1beta1.Minter.hedgehog_minted":
+		if x.HedgehogMinted == nil {
+			x.HedgehogMinted = []*v1beta1.Coin{}
+		}
+		value := &_Minter_3_list{list: &x.HedgehogMinted}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.ugdmint.v1beta1.Minter.block_subsidy":
+		if x.BlockSubsidy == nil {
+			x.BlockSubsidy = []*v1beta1.Coin{}
+		}
+		value := &_Minter_5_list{list: &x.BlockSubsidy}
+		return protoreflect.ValueOfList(value)
 	case "cosmos.ugdmint.v1beta1.Minter.subsidy_halving_interval":
 		panic(fmt.Errorf("field subsidy_halving_interval of message cosmos.ugdmint.v1beta1.Minter is not mutable"))
+	case "cosmos.ugdmint.v1beta1.Minter.last_mint_height":
+		panic(fmt.Errorf("field last_mint_height of message cosmos.ugdmint.v1beta1.Minter is not mutable"))
+	case "cosmos.ugdmint.v1beta1.Minter.inflation":
+		panic(fmt.Errorf("field inflation of message cosmos.ugdmint.v1beta1.Minter is not mutable"))
+	case "cosmos.ugdmint.v1beta1.Minter.annual_provisions":
+		panic(fmt.Errorf("field annual_provisions of message cosmos.ugdmint.v1beta1.Minter is not mutable"))
+	case "cosmos.ugdmint.v1beta1.Minter.observed_block_time_seconds":
+		panic(fmt.Errorf("field observed_block_time_seconds of message cosmos.ugdmint.v1beta1.Minter is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.ugdmint.v1beta1.Minter"))
@@ -210,6 +524,23 @@ func (x *fastReflection_Minter) NewField(fd protoreflect.FieldDescriptor) protor
 	switch fd.FullName() {
 	case "cosmos.ugdmint.v1beta1.Minter.subsidy_halving_interval":
 		return protoreflect.ValueOfString("")
+	case "cosmos.ugdmint.v1beta1.Minter.block_provision_minted":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_Minter_2_list{list: &list})
+	case "cosmos.ugdmint.v1beta1.Minter.hedgehog_minted":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_Minter_3_list{list: &list})
+	case "cosmos.ugdmint.v1beta1.Minter.last_mint_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.ugdmint.v1beta1.Minter.block_subsidy":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_Minter_5_list{list: &list})
+	case "cosmos.ugdmint.v1beta1.Minter.inflation":
+		return protoreflect.ValueOfString("")
+	case "cosmos.ugdmint.v1beta1.Minter.annual_provisions":
+		return protoreflect.ValueOfString("")
+	case "cosmos.ugdmint.v1beta1.Minter.observed_block_time_seconds":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.ugdmint.v1beta1.Minter"))
@@ -283,6 +614,38 @@ func (x *fastReflection_Minter) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.BlockProvisionMinted) > 0 {
+			for _, e := range x.BlockProvisionMinted {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.HedgehogMinted) > 0 {
+			for _, e := range x.HedgehogMinted {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.LastMintHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.LastMintHeight))
+		}
+		if len(x.BlockSubsidy) > 0 {
+			for _, e := range x.BlockSubsidy {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.Inflation)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.AnnualProvisions)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ObservedBlockTimeSeconds != 0 {
+			n += 1 + runtime.Sov(uint64(x.ObservedBlockTimeSeconds))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -312,6 +675,78 @@ func (x *fastReflection_Minter) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.ObservedBlockTimeSeconds != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ObservedBlockTimeSeconds))
+			i--
+			dAtA[i] = 0x40
+		}
+		if len(x.AnnualProvisions) > 0 {
+			i -= len(x.AnnualProvisions)
+			copy(dAtA[i:], x.AnnualProvisions)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AnnualProvisions)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.Inflation) > 0 {
+			i -= len(x.Inflation)
+			copy(dAtA[i:], x.Inflation)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Inflation)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.BlockSubsidy) > 0 {
+			for iNdEx := len(x.BlockSubsidy) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.BlockSubsidy[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.LastMintHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastMintHeight))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.HedgehogMinted) > 0 {
+			for iNdEx := len(x.HedgehogMinted) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.HedgehogMinted[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.BlockProvisionMinted) > 0 {
+			for iNdEx := len(x.BlockProvisionMinted) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.BlockProvisionMinted[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
 		if len(x.SubsidyHalvingInterval) > 0 {
 			i -= len(x.SubsidyHalvingInterval)
 			copy(dAtA[i:], x.SubsidyHalvingInterval)
@@ -400,115 +835,3218 @@ func (x *fastReflection_Minter) ProtoMethods() *protoiface.Methods {
 				}
 				x.SubsidyHalvingInterval = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockProvisionMinted", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BlockProvisionMinted = append(x.BlockProvisionMinted, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BlockProvisionMinted[len(x.BlockProvisionMinted)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HedgehogMinted", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.HedgehogMinted = append(x.HedgehogMinted, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.HedgehogMinted[len(x.HedgehogMinted)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-var (
-	md_Params                          protoreflect.MessageDescriptor
-	fd_Params_mint_denom               protoreflect.FieldDescriptor
-	fd_Params_subsidy_halving_interval protoreflect.FieldDescriptor
-	fd_Params_goal_bonded              protoreflect.FieldDescriptor
-	fd_Params_blocks_per_year          protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_cosmos_ugdmint_v1beta1_params_proto_init()
-	md_Params = File_cosmos_ugdmint_v1beta1_params_proto.Messages().ByName("Params")
-	fd_Params_mint_denom = md_Params.Fields().ByName("mint_denom")
-	fd_Params_subsidy_halving_interval = md_Params.Fields().ByName("subsidy_halving_interval")
-	fd_Params_goal_bonded = md_Params.Fields().ByName("goal_bonded")
-	fd_Params_blocks_per_year = md_Params.Fields().ByName("blocks_per_year")
-}
-
-var _ protoreflect.Message = (*fastReflection_Params)(nil)
-
-type fastReflection_Params Params
-
-func (x *Params) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Params)(x)
-}
-
-func (x *Params) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_ugdmint_v1beta1_params_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-var _fastReflection_Params_messageType fastReflection_Params_messageType
-var _ protoreflect.MessageType = fastReflection_Params_messageType{}
-
-type fastReflection_Params_messageType struct{}
-
-func (x fastReflection_Params_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Params)(nil)
-}
-func (x fastReflection_Params_messageType) New() protoreflect.Message {
-	return new(fastReflection_Params)
-}
-func (x fastReflection_Params_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Params
-}
-
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_Params) Descriptor() protoreflect.MessageDescriptor {
-	return md_Params
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastMintHeight", wireType)
+				}
+				x.LastMintHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LastMintHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockSubsidy", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BlockSubsidy = append(x.BlockSubsidy, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BlockSubsidy[len(x.BlockSubsidy)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Inflation = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AnnualProvisions = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ObservedBlockTimeSeconds", wireType)
+				}
+				x.ObservedBlockTimeSeconds = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ObservedBlockTimeSeconds |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_Params_13_list)(nil)
+
+type _Params_13_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_Params_13_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_13_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_13_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_13_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_13_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_13_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_13_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_13_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_20_list)(nil)
+
+type _Params_20_list struct {
+	list *[]*DistributionDestination
+}
+
+func (x *_Params_20_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_20_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_20_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DistributionDestination)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_20_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DistributionDestination)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_20_list) AppendMutable() protoreflect.Value {
+	v := new(DistributionDestination)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_20_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_20_list) NewElement() protoreflect.Value {
+	v := new(DistributionDestination)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_20_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_Params                              protoreflect.MessageDescriptor
+	fd_Params_mint_denom                   protoreflect.FieldDescriptor
+	fd_Params_subsidy_halving_interval     protoreflect.FieldDescriptor
+	fd_Params_goal_bonded                  protoreflect.FieldDescriptor
+	fd_Params_blocks_per_year              protoreflect.FieldDescriptor
+	fd_Params_blocks_per_minute            protoreflect.FieldDescriptor
+	fd_Params_height_offset                protoreflect.FieldDescriptor
+	fd_Params_decay_start_height           protoreflect.FieldDescriptor
+	fd_Params_decay_factor                 protoreflect.FieldDescriptor
+	fd_Params_reference_window_seconds     protoreflect.FieldDescriptor
+	fd_Params_provision_scale              protoreflect.FieldDescriptor
+	fd_Params_min_block_time_delta_seconds protoreflect.FieldDescriptor
+	fd_Params_max_block_time_delta_seconds protoreflect.FieldDescriptor
+	fd_Params_max_supply                   protoreflect.FieldDescriptor
+	fd_Params_inflation_rate_change        protoreflect.FieldDescriptor
+	fd_Params_inflation_max                protoreflect.FieldDescriptor
+	fd_Params_inflation_min                protoreflect.FieldDescriptor
+	fd_Params_bonded_adjustment_enabled    protoreflect.FieldDescriptor
+	fd_Params_below_goal_staking_share     protoreflect.FieldDescriptor
+	fd_Params_excess_destination           protoreflect.FieldDescriptor
+	fd_Params_distribution_split           protoreflect.FieldDescriptor
+	fd_Params_vesting_policy               protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_ugdmint_v1beta1_params_proto_init()
+	md_Params = File_cosmos_ugdmint_v1beta1_params_proto.Messages().ByName("Params")
+	fd_Params_mint_denom = md_Params.Fields().ByName("mint_denom")
+	fd_Params_subsidy_halving_interval = md_Params.Fields().ByName("subsidy_halving_interval")
+	fd_Params_goal_bonded = md_Params.Fields().ByName("goal_bonded")
+	fd_Params_blocks_per_year = md_Params.Fields().ByName("blocks_per_year")
+	fd_Params_blocks_per_minute = md_Params.Fields().ByName("blocks_per_minute")
+	fd_Params_height_offset = md_Params.Fields().ByName("height_offset")
+	fd_Params_decay_start_height = md_Params.Fields().ByName("decay_start_height")
+	fd_Params_decay_factor = md_Params.Fields().ByName("decay_factor")
+	fd_Params_reference_window_seconds = md_Params.Fields().ByName("reference_window_seconds")
+	fd_Params_provision_scale = md_Params.Fields().ByName("provision_scale")
+	fd_Params_min_block_time_delta_seconds = md_Params.Fields().ByName("min_block_time_delta_seconds")
+	fd_Params_max_block_time_delta_seconds = md_Params.Fields().ByName("max_block_time_delta_seconds")
+	fd_Params_max_supply = md_Params.Fields().ByName("max_supply")
+	fd_Params_inflation_rate_change = md_Params.Fields().ByName("inflation_rate_change")
+	fd_Params_inflation_max = md_Params.Fields().ByName("inflation_max")
+	fd_Params_inflation_min = md_Params.Fields().ByName("inflation_min")
+	fd_Params_bonded_adjustment_enabled = md_Params.Fields().ByName("bonded_adjustment_enabled")
+	fd_Params_below_goal_staking_share = md_Params.Fields().ByName("below_goal_staking_share")
+	fd_Params_excess_destination = md_Params.Fields().ByName("excess_destination")
+	fd_Params_distribution_split = md_Params.Fields().ByName("distribution_split")
+	fd_Params_vesting_policy = md_Params.Fields().ByName("vesting_policy")
+}
+
+var _ protoreflect.Message = (*fastReflection_Params)(nil)
+
+type fastReflection_Params Params
+
+func (x *Params) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Params)(x)
+}
+
+func (x *Params) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_ugdmint_v1beta1_params_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Params_messageType fastReflection_Params_messageType
+var _ protoreflect.MessageType = fastReflection_Params_messageType{}
+
+type fastReflection_Params_messageType struct{}
+
+func (x fastReflection_Params_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Params)(nil)
+}
+func (x fastReflection_Params_messageType) New() protoreflect.Message {
+	return new(fastReflection_Params)
+}
+func (x fastReflection_Params_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Params) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Params) Type() protoreflect.MessageType {
+	return _fastReflection_Params_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Params) New() protoreflect.Message {
+	return new(fastReflection_Params)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
+	return (*Params)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MintDenom != "" {
+		value := protoreflect.ValueOfString(x.MintDenom)
+		if !f(fd_Params_mint_denom, value) {
+			return
+		}
+	}
+	if x.SubsidyHalvingInterval != "" {
+		value := protoreflect.ValueOfString(x.SubsidyHalvingInterval)
+		if !f(fd_Params_subsidy_halving_interval, value) {
+			return
+		}
+	}
+	if x.GoalBonded != "" {
+		value := protoreflect.ValueOfString(x.GoalBonded)
+		if !f(fd_Params_goal_bonded, value) {
+			return
+		}
+	}
+	if x.BlocksPerYear != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BlocksPerYear)
+		if !f(fd_Params_blocks_per_year, value) {
+			return
+		}
+	}
+	if x.BlocksPerMinute != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BlocksPerMinute)
+		if !f(fd_Params_blocks_per_minute, value) {
+			return
+		}
+	}
+	if x.HeightOffset != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.HeightOffset)
+		if !f(fd_Params_height_offset, value) {
+			return
+		}
+	}
+	if x.DecayStartHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DecayStartHeight)
+		if !f(fd_Params_decay_start_height, value) {
+			return
+		}
+	}
+	if x.DecayFactor != "" {
+		value := protoreflect.ValueOfString(x.DecayFactor)
+		if !f(fd_Params_decay_factor, value) {
+			return
+		}
+	}
+	if x.ReferenceWindowSeconds != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ReferenceWindowSeconds)
+		if !f(fd_Params_reference_window_seconds, value) {
+			return
+		}
+	}
+	if x.ProvisionScale != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProvisionScale)
+		if !f(fd_Params_provision_scale, value) {
+			return
+		}
+	}
+	if x.MinBlockTimeDeltaSeconds != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MinBlockTimeDeltaSeconds)
+		if !f(fd_Params_min_block_time_delta_seconds, value) {
+			return
+		}
+	}
+	if x.MaxBlockTimeDeltaSeconds != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxBlockTimeDeltaSeconds)
+		if !f(fd_Params_max_block_time_delta_seconds, value) {
+			return
+		}
+	}
+	if len(x.MaxSupply) != 0 {
+		value := protoreflect.ValueOfList(&_Params_13_list{list: &x.MaxSupply})
+		if !f(fd_Params_max_supply, value) {
+			return
+		}
+	}
+	if x.InflationRateChange != "" {
+		value := protoreflect.ValueOfString(x.InflationRateChange)
+		if !f(fd_Params_inflation_rate_change, value) {
+			return
+		}
+	}
+	if x.InflationMax != "" {
+		value := protoreflect.ValueOfString(x.InflationMax)
+		if !f(fd_Params_inflation_max, value) {
+			return
+		}
+	}
+	if x.InflationMin != "" {
+		value := protoreflect.ValueOfString(x.InflationMin)
+		if !f(fd_Params_inflation_min, value) {
+			return
+		}
+	}
+	if x.BondedAdjustmentEnabled != false {
+		value := protoreflect.ValueOfBool(x.BondedAdjustmentEnabled)
+		if !f(fd_Params_bonded_adjustment_enabled, value) {
+			return
+		}
+	}
+	if x.BelowGoalStakingShare != "" {
+		value := protoreflect.ValueOfString(x.BelowGoalStakingShare)
+		if !f(fd_Params_below_goal_staking_share, value) {
+			return
+		}
+	}
+	if x.ExcessDestination != "" {
+		value := protoreflect.ValueOfString(x.ExcessDestination)
+		if !f(fd_Params_excess_destination, value) {
+			return
+		}
+	}
+	if len(x.DistributionSplit) != 0 {
+		value := protoreflect.ValueOfList(&_Params_20_list{list: &x.DistributionSplit})
+		if !f(fd_Params_distribution_split, value) {
+			return
+		}
+	}
+	if x.VestingPolicy != nil {
+		value := protoreflect.ValueOfMessage(x.VestingPolicy.ProtoReflect())
+		if !f(fd_Params_vesting_policy, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.ugdmint.v1beta1.Params.mint_denom":
+		return x.MintDenom != ""
+	case "cosmos.ugdmint.v1beta1.Params.subsidy_halving_interval":
+		return x.SubsidyHalvingInterval != ""
+	case "cosmos.ugdmint.v1beta1.Params.goal_bonded":
+		return x.GoalBonded != ""
+	case "cosmos.ugdmint.v1beta1.Params.blocks_per_year":
+		return x.BlocksPerYear != uint64(0)
+	case "cosmos.ugdmint.v1beta1.Params.blocks_per_minute":
+		return x.BlocksPerMinute != uint64(0)
+	case "cosmos.ugdmint.v1beta1.Params.height_offset":
+		return x.HeightOffset != uint64(0)
+	case "cosmos.ugdmint.v1beta1.Params.decay_start_height":
+		return x.DecayStartHeight != uint64(0)
+	case "cosmos.ugdmint.v1beta1.Params.decay_factor":
+		return x.DecayFactor != ""
+	case "cosmos.ugdmint.v1beta1.Params.reference_window_seconds":
+		return x.ReferenceWindowSeconds != uint64(0)
+	case "cosmos.ugdmint.v1beta1.Params.provision_scale":
+		return x.ProvisionScale != uint64(0)
+	case "cosmos.ugdmint.v1beta1.Params.min_block_time_delta_seconds":
+		return x.MinBlockTimeDeltaSeconds != uint64(0)
+	case "cosmos.ugdmint.v1beta1.Params.max_block_time_delta_seconds":
+		return x.MaxBlockTimeDeltaSeconds != uint64(0)
+	case "cosmos.ugdmint.v1beta1.Params.max_supply":
+		return len(x.MaxSupply) != 0
+	case "cosmos.ugdmint.v1beta1.Params.inflation_rate_change":
+		return x.InflationRateChange != ""
+	case "cosmos.ugdmint.v1beta1.Params.inflation_max":
+		return x.InflationMax != ""
+	case "cosmos.ugdmint.v1beta1.Params.inflation_min":
+		return x.InflationMin != ""
+	case "cosmos.ugdmint.v1beta1.Params.bonded_adjustment_enabled":
+		return x.BondedAdjustmentEnabled != false
+	case "cosmos.ugdmint.v1beta1.Params.below_goal_staking_share":
+		return x.BelowGoalStakingShare != ""
+	case "cosmos.ugdmint.v1beta1.Params.excess_destination":
+		return x.ExcessDestination != ""
+	case "cosmos.ugdmint.v1beta1.Params.distribution_split":
+		return len(x.DistributionSplit) != 0
+	case "cosmos.ugdmint.v1beta1.Params.vesting_policy":
+		return x.VestingPolicy != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.ugdmint.v1beta1.Params"))
+		}
+		panic(fmt.Errorf("message cosmos.ugdmint.v1beta1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.ugdmint.v1beta1.Params.mint_denom":
+		x.MintDenom = ""
+	case "cosmos.ugdmint.v1beta1.Params.subsidy_halving_interval":
+		x.SubsidyHalvingInterval = ""
+	case "cosmos.ugdmint.v1beta1.Params.goal_bonded":
+		x.GoalBonded = ""
+	case "cosmos.ugdmint.v1beta1.Params.blocks_per_year":
+		x.BlocksPerYear = uint64(0)
+	case "cosmos.ugdmint.v1beta1.Params.blocks_per_minute":
+		x.BlocksPerMinute = uint64(0)
+	case "cosmos.ugdmint.v1beta1.Params.height_offset":
+		x.HeightOffset = uint64(0)
+	case "cosmos.ugdmint.v1beta1.Params.decay_start_height":
+		x.DecayStartHeight = uint64(0)
+	case "cosmos.ugdmint.v1beta1.Params.decay_factor":
+		x.DecayFactor = ""
+	case "cosmos.ugdmint.v1beta1.Params.reference_window_seconds":
+		x.ReferenceWindowSeconds = uint64(0)
+	case "cosmos.ugdmint.v1beta1.Params.provision_scale":
+		x.ProvisionScale = uint64(0)
+	case "cosmos.ugdmint.v1beta1.Params.min_block_time_delta_seconds":
+		x.MinBlockTimeDeltaSeconds = uint64(0)
+	case "cosmos.ugdmint.v1beta1.Params.max_block_time_delta_seconds":
+		x.MaxBlockTimeDeltaSeconds = uint64(0)
+	case "cosmos.ugdmint.v1beta1.Params.max_supply":
+		x.MaxSupply = nil
+	case "cosmos.ugdmint.v1beta1.Params.inflation_rate_change":
+		x.InflationRateChange = ""
+	case "cosmos.ugdmint.v1beta1.Params.inflation_max":
+		x.InflationMax = ""
+	case "cosmos.ugdmint.v1beta1.Params.inflation_min":
+		x.InflationMin = ""
+	case "cosmos.ugdmint.v1beta1.Params.bonded_adjustment_enabled":
+		x.BondedAdjustmentEnabled = false
+	case "cosmos.ugdmint.v1beta1.Params.below_goal_staking_share":
+		x.BelowGoalStakingShare = ""
+	case "cosmos.ugdmint.v1beta1.Params.excess_destination":
+		x.ExcessDestination = ""
+	case "cosmos.ugdmint.v1beta1.Params.distribution_split":
+		x.DistributionSplit = nil
+	case "cosmos.ugdmint.v1beta1.Params.vesting_policy":
+		x.VestingPolicy = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.ugdmint.v1beta1.Params"))
+		}
+		panic(fmt.Errorf("message cosmos.ugdmint.v1beta1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.ugdmint.v1beta1.Params.mint_denom":
+		value := x.MintDenom
+		return protoreflect.ValueOfString(value)
+	case "cosmos.ugdmint.v1beta1.Params.subsidy_halving_interval":
+		value := x.SubsidyHalvingInterval
+		return protoreflect.ValueOfString(value)
+	case "cosmos.ugdmint.v1beta1.Params.goal_bonded":
+		value := x.GoalBonded
+		return protoreflect.ValueOfString(value)
+	case "cosmos.ugdmint.v1beta1.Params.blocks_per_year":
+		value := x.BlocksPerYear
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.ugdmint.v1beta1.Params.blocks_per_minute":
+		value := x.BlocksPerMinute
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.ugdmint.v1beta1.Params.height_offset":
+		value := x.HeightOffset
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.ugdmint.v1beta1.Params.decay_start_height":
+		value := x.DecayStartHeight
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.ugdmint.v1beta1.Params.decay_factor":
+		value := x.DecayFactor
+		return protoreflect.ValueOfString(value)
+	case "cosmos.ugdmint.v1beta1.Params.reference_window_seconds":
+		value := x.ReferenceWindowSeconds
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.ugdmint.v1beta1.Params.provision_scale":
+		value := x.ProvisionScale
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.ugdmint.v1beta1.Params.min_block_time_delta_seconds":
+		value := x.MinBlockTimeDeltaSeconds
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.ugdmint.v1beta1.Params.max_block_time_delta_seconds":
+		value := x.MaxBlockTimeDeltaSeconds
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.ugdmint.v1beta1.Params.max_supply":
+		if len(x.MaxSupply) == 0 {
+			return protoreflect.ValueOfList(&_Params_13_list{})
+		}
+		listValue := &_Params_13_list{list: &x.MaxSupply}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.ugdmint.v1beta1.Params.inflation_rate_change":
+		value := x.InflationRateChange
+		return protoreflect.ValueOfString(value)
+	case "cosmos.ugdmint.v1beta1.Params.inflation_max":
+		value := x.InflationMax
+		return protoreflect.ValueOfString(value)
+	case "cosmos.ugdmint.v1beta1.Params.inflation_min":
+		value := x.InflationMin
+		return protoreflect.ValueOfString(value)
+	case "cosmos.ugdmint.v1beta1.Params.bonded_adjustment_enabled":
+		value := x.BondedAdjustmentEnabled
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.ugdmint.v1beta1.Params.below_goal_staking_share":
+		value := x.BelowGoalStakingShare
+		return protoreflect.ValueOfString(value)
+	case "cosmos.ugdmint.v1beta1.Params.excess_destination":
+		value := x.ExcessDestination
+		return protoreflect.ValueOfString(value)
+	case "cosmos.ugdmint.v1beta1.Params.distribution_split":
+		if len(x.DistributionSplit) == 0 {
+			return protoreflect.ValueOfList(&_Params_20_list{})
+		}
+		listValue := &_Params_20_list{list: &x.DistributionSplit}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.ugdmint.v1beta1.Params.vesting_policy":
+		value := x.VestingPolicy
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.ugdmint.v1beta1.Params"))
+		}
+		panic(fmt.Errorf("message cosmos.ugdmint.v1beta1.Params does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.ugdmint.v1beta1.Params.mint_denom":
+		x.MintDenom = value.Interface().(string)
+	case "cosmos.ugdmint.v1beta1.Params.subsidy_halving_interval":
+		x.SubsidyHalvingInterval = value.Interface().(string)
+	case "cosmos.ugdmint.v1beta1.Params.goal_bonded":
+		x.GoalBonded = value.Interface().(string)
+	case "cosmos.ugdmint.v1beta1.Params.blocks_per_year":
+		x.BlocksPerYear = value.Uint()
+	case "cosmos.ugdmint.v1beta1.Params.blocks_per_minute":
+		x.BlocksPerMinute = value.Uint()
+	case "cosmos.ugdmint.v1beta1.Params.height_offset":
+		x.HeightOffset = value.Uint()
+	case "cosmos.ugdmint.v1beta1.Params.decay_start_height":
+		x.DecayStartHeight = value.Uint()
+	case "cosmos.ugdmint.v1beta1.Params.decay_factor":
+		x.DecayFactor = value.Interface().(string)
+	case "cosmos.ugdmint.v1beta1.Params.reference_window_seconds":
+		x.ReferenceWindowSeconds = value.Uint()
+	case "cosmos.ugdmint.v1beta1.Params.provision_scale":
+		x.ProvisionScale = value.Uint()
+	case "cosmos.ugdmint.v1beta1.Params.min_block_time_delta_seconds":
+		x.MinBlockTimeDeltaSeconds = value.Uint()
+	case "cosmos.ugdmint.v1beta1.Params.max_block_time_delta_seconds":
+		x.MaxBlockTimeDeltaSeconds = value.Uint()
+	case "cosmos.ugdmint.v1beta1.Params.max_supply":
+		lv := value.List()
+		clv := lv.(*_Params_13_list)
+		x.MaxSupply = *clv.list
+	case "cosmos.ugdmint.v1beta1.Params.inflation_rate_change":
+		x.InflationRateChange = value.Interface().(string)
+	case "cosmos.ugdmint.v1beta1.Params.inflation_max":
+		x.InflationMax = value.Interface().(string)
+	case "cosmos.ugdmint.v1beta1.Params.inflation_min":
+		x.InflationMin = value.Interface().(string)
+	case "cosmos.ugdmint.v1beta1.Params.bonded_adjustment_enabled":
+		x.BondedAdjustmentEnabled = value.Bool()
+	case "cosmos.ugdmint.v1beta1.Params.below_goal_staking_share":
+		x.BelowGoalStakingShare = value.Interface().(string)
+	case "cosmos.ugdmint.v1beta1.Params.excess_destination":
+		x.ExcessDestination = value.Interface().(string)
+	case "cosmos.ugdmint.v1beta1.Params.distribution_split":
+		lv := value.List()
+		clv := lv.(*_Params_20_list)
+		x.DistributionSplit = *clv.list
+	case "cosmos.ugdmint.v1beta1.Params.vesting_policy":
+		x.VestingPolicy = value.Message().Interface().(*VestingPolicy)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.ugdmint.v1beta1.Params"))
+		}
+		panic(fmt.Errorf("message cosmos.ugdmint.v1beta1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.ugdmint.v1beta1.Params.max_supply":
+		if x.MaxSupply == nil {
+			x.MaxSupply = []*v1beta1.Coin{}
+		}
+		value := &_Params_13_list{list: &x.MaxSupply}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.ugdmint.v1beta1.Params.distribution_split":
+		if x.DistributionSplit == nil {
+			x.DistributionSplit = []*DistributionDestination{}
+		}
+		value := &_Params_20_list{list: &x.DistributionSplit}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.ugdmint.v1beta1.Params.vesting_policy":
+		if x.VestingPolicy == nil {
+			x.VestingPolicy = new(VestingPolicy)
+		}
+		return protoreflect.ValueOfMessage(x.VestingPolicy.ProtoReflect())
+	case "cosmos.ugdmint.v1beta1.Params.mint_denom":
+		panic(fmt.Errorf("field mint_denom of message cosmos.ugdmint.v1beta1.Params is not mutable"))
+	case "cosmos.ugdmint.v1beta1.Params.subsidy_halving_interval":
+		panic(fmt.Errorf("field subsidy_halving_interval of message cosmos.ugdmint.v1beta1.Params is not mutable"))
+	case "cosmos.ugdmint.v1beta1.Params.goal_bonded":
+		panic(fmt.Errorf("field goal_bonded of message cosmos.ugdmint.v1beta1.Params is not mutable"))
+	case "cosmos.ugdmint.v1beta1.Params.blocks_per_year":
+		panic(fmt.Errorf("field blocks_per_year of message cosmos.ugdmint.v1beta1.Params is not mutable"))
+	case "cosmos.ugdmint.v1beta1.Params.blocks_per_minute":
+		panic(fmt.Errorf("field blocks_per_minute of message cosmos.ugdmint.v1beta1.Params is not mutable"))
+	case "cosmos.ugdmint.v1beta1.Params.height_offset":
+		panic(fmt.Errorf("field height_offset of message cosmos.ugdmint.v1beta1.Params is not mutable"))
+	case "cosmos.ugdmint.v1beta1.Params.decay_start_height":
+		panic(fmt.Errorf("field decay_start_height of message cosmos.ugdmint.v1beta1.Params is not mutable"))
+	case "cosmos.ugdmint.v1beta1.Params.decay_factor":
+		panic(fmt.Errorf("field decay_factor of message cosmos.ugdmint.v1beta1.Params is not mutable"))
+	case "cosmos.ugdmint.v1beta1.Params.reference_window_seconds":
+		panic(fmt.Errorf("field reference_window_seconds of message cosmos.ugdmint.v1beta1.Params is not mutable"))
+	case "cosmos.ugdmint.v1beta1.Params.provision_scale":
+		panic(fmt.Errorf("field provision_scale of message cosmos.ugdmint.v1beta1.Params is not mutable"))
+	case "cosmos.ugdmint.v1beta1.Params.min_block_time_delta_seconds":
+		panic(fmt.Errorf("field min_block_time_delta_seconds of message cosmos.ugdmint.v1beta1.Params is not mutable"))
+	case "cosmos.ugdmint.v1beta1.Params.max_block_time_delta_seconds":
+		panic(fmt.Errorf("field max_block_time_delta_seconds of message cosmos.ugdmint.v1beta1.Params is not mutable"))
+	case "cosmos.ugdmint.v1beta1.Params.inflation_rate_change":
+		panic(fmt.Errorf("field inflation_rate_change of message cosmos.ugdmint.v1beta1.Params is not mutable"))
+	case "cosmos.ugdmint.v1beta1.Params.inflation_max":
+		panic(fmt.Errorf("field inflation_max of message cosmos.ugdmint.v1beta1.Params is not mutable"))
+	case "cosmos.ugdmint.v1beta1.Params.inflation_min":
+		panic(fmt.Errorf("field inflation_min of message cosmos.ugdmint.v1beta1.Params is not mutable"))
+	case "cosmos.ugdmint.v1beta1.Params.bonded_adjustment_enabled":
+		panic(fmt.Errorf("field bonded_adjustment_enabled of message cosmos.ugdmint.v1beta1.Params is not mutable"))
+	case "cosmos.ugdmint.v1beta1.Params.below_goal_staking_share":
+		panic(fmt.Errorf("field below_goal_staking_share of message cosmos.ugdmint.v1beta1.Params is not mutable"))
+	case "cosmos.ugdmint.v1beta1.Params.excess_destination":
+		panic(fmt.Errorf("field excess_destination of message cosmos.ugdmint.v1beta1.Params is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.ugdmint.v1beta1.Params"))
+		}
+		panic(fmt.Errorf("message cosmos.ugdmint.v1beta1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.ugdmint.v1beta1.Params.mint_denom":
+		return protoreflect.ValueOfString("")
+	case "cosmos.ugdmint.v1beta1.Params.subsidy_halving_interval":
+		return protoreflect.ValueOfString("")
+	case "cosmos.ugdmint.v1beta1.Params.goal_bonded":
+		return protoreflect.ValueOfString("")
+	case "cosmos.ugdmint.v1beta1.Params.blocks_per_year":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.ugdmint.v1beta1.Params.blocks_per_minute":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.ugdmint.v1beta1.Params.height_offset":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.ugdmint.v1beta1.Params.decay_start_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.ugdmint.v1beta1.Params.decay_factor":
+		return protoreflect.ValueOfString("")
+	case "cosmos.ugdmint.v1beta1.Params.reference_window_seconds":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.ugdmint.v1beta1.Params.provision_scale":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.ugdmint.v1beta1.Params.min_block_time_delta_seconds":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.ugdmint.v1beta1.Params.max_block_time_delta_seconds":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.ugdmint.v1beta1.Params.max_supply":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_Params_13_list{list: &list})
+	case "cosmos.ugdmint.v1beta1.Params.inflation_rate_change":
+		return protoreflect.ValueOfString("")
+	case "cosmos.ugdmint.v1beta1.Params.inflation_max":
+		return protoreflect.ValueOfString("")
+	case "cosmos.ugdmint.v1beta1.Params.inflation_min":
+		return protoreflect.ValueOfString("")
+	case "cosmos.ugdmint.v1beta1.Params.bonded_adjustment_enabled":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.ugdmint.v1beta1.Params.below_goal_staking_share":
+		return protoreflect.ValueOfString("")
+	case "cosmos.ugdmint.v1beta1.Params.excess_destination":
+		return protoreflect.ValueOfString("")
+	case "cosmos.ugdmint.v1beta1.Params.distribution_split":
+		list := []*DistributionDestination{}
+		return protoreflect.ValueOfList(&_Params_20_list{list: &list})
+	case "cosmos.ugdmint.v1beta1.Params.vesting_policy":
+		m := new(VestingPolicy)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.ugdmint.v1beta1.Params"))
+		}
+		panic(fmt.Errorf("message cosmos.ugdmint.v1beta1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.ugdmint.v1beta1.Params", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Params) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.MintDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.SubsidyHalvingInterval)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.GoalBonded)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BlocksPerYear != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlocksPerYear))
+		}
+		if x.BlocksPerMinute != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlocksPerMinute))
+		}
+		if x.HeightOffset != 0 {
+			n += 1 + runtime.Sov(uint64(x.HeightOffset))
+		}
+		if x.DecayStartHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.DecayStartHeight))
+		}
+		l = len(x.DecayFactor)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ReferenceWindowSeconds != 0 {
+			n += 1 + runtime.Sov(uint64(x.ReferenceWindowSeconds))
+		}
+		if x.ProvisionScale != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProvisionScale))
+		}
+		if x.MinBlockTimeDeltaSeconds != 0 {
+			n += 1 + runtime.Sov(uint64(x.MinBlockTimeDeltaSeconds))
+		}
+		if x.MaxBlockTimeDeltaSeconds != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxBlockTimeDeltaSeconds))
+		}
+		if len(x.MaxSupply) > 0 {
+			for _, e := range x.MaxSupply {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.InflationRateChange)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.InflationMax)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.InflationMin)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.BondedAdjustmentEnabled {
+			n += 3
+		}
+		l = len(x.BelowGoalStakingShare)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ExcessDestination)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.DistributionSplit) > 0 {
+			for _, e := range x.DistributionSplit {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.VestingPolicy != nil {
+			l = options.Size(x.VestingPolicy)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.VestingPolicy != nil {
+			encoded, err := options.Marshal(x.VestingPolicy)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+		if len(x.DistributionSplit) > 0 {
+			for iNdEx := len(x.DistributionSplit) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DistributionSplit[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xa2
+			}
+		}
+		if len(x.ExcessDestination) > 0 {
+			i -= len(x.ExcessDestination)
+			copy(dAtA[i:], x.ExcessDestination)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExcessDestination)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+		if len(x.BelowGoalStakingShare) > 0 {
+			i -= len(x.BelowGoalStakingShare)
+			copy(dAtA[i:], x.BelowGoalStakingShare)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BelowGoalStakingShare)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+		if x.BondedAdjustmentEnabled {
+			i--
+			if x.BondedAdjustmentEnabled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x88
+		}
+		if len(x.InflationMin) > 0 {
+			i -= len(x.InflationMin)
+			copy(dAtA[i:], x.InflationMin)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InflationMin)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+		if len(x.InflationMax) > 0 {
+			i -= len(x.InflationMax)
+			copy(dAtA[i:], x.InflationMax)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InflationMax)))
+			i--
+			dAtA[i] = 0x7a
+		}
+		if len(x.InflationRateChange) > 0 {
+			i -= len(x.InflationRateChange)
+			copy(dAtA[i:], x.InflationRateChange)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InflationRateChange)))
+			i--
+			dAtA[i] = 0x72
+		}
+		if len(x.MaxSupply) > 0 {
+			for iNdEx := len(x.MaxSupply) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MaxSupply[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x6a
+			}
+		}
+		if x.MaxBlockTimeDeltaSeconds != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxBlockTimeDeltaSeconds))
+			i--
+			dAtA[i] = 0x60
+		}
+		if x.MinBlockTimeDeltaSeconds != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinBlockTimeDeltaSeconds))
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.ProvisionScale != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProvisionScale))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.ReferenceWindowSeconds != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ReferenceWindowSeconds))
+			i--
+			dAtA[i] = 0x48
+		}
+		if len(x.DecayFactor) > 0 {
+			i -= len(x.DecayFactor)
+			copy(dAtA[i:], x.DecayFactor)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DecayFactor)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.DecayStartHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DecayStartHeight))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.HeightOffset != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.HeightOffset))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.BlocksPerMinute != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlocksPerMinute))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.BlocksPerYear != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlocksPerYear))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.GoalBonded) > 0 {
+			i -= len(x.GoalBonded)
+			copy(dAtA[i:], x.GoalBonded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GoalBonded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.SubsidyHalvingInterval) > 0 {
+			i -= len(x.SubsidyHalvingInterval)
+			copy(dAtA[i:], x.SubsidyHalvingInterval)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SubsidyHalvingInterval)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.MintDenom) > 0 {
+			i -= len(x.MintDenom)
+			copy(dAtA[i:], x.MintDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MintDenom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MintDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MintDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SubsidyHalvingInterval", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SubsidyHalvingInterval = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GoalBonded", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.GoalBonded = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlocksPerYear", wireType)
+				}
+				x.BlocksPerYear = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlocksPerYear |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlocksPerMinute", wireType)
+				}
+				x.BlocksPerMinute = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlocksPerMinute |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HeightOffset", wireType)
+				}
+				x.HeightOffset = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.HeightOffset |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DecayStartHeight", wireType)
+				}
+				x.DecayStartHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DecayStartHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DecayFactor", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DecayFactor = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReferenceWindowSeconds", wireType)
+				}
+				x.ReferenceWindowSeconds = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ReferenceWindowSeconds |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProvisionScale", wireType)
+				}
+				x.ProvisionScale = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProvisionScale |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinBlockTimeDeltaSeconds", wireType)
+				}
+				x.MinBlockTimeDeltaSeconds = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MinBlockTimeDeltaSeconds |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxBlockTimeDeltaSeconds", wireType)
+				}
+				x.MaxBlockTimeDeltaSeconds = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxBlockTimeDeltaSeconds |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxSupply = append(x.MaxSupply, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MaxSupply[len(x.MaxSupply)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InflationRateChange", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.InflationRateChange = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InflationMax", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.InflationMax = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InflationMin", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.InflationMin = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 17:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BondedAdjustmentEnabled", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.BondedAdjustmentEnabled = bool(v != 0)
+			case 18:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BelowGoalStakingShare", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BelowGoalStakingShare = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 19:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExcessDestination", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ExcessDestination = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 20:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DistributionSplit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DistributionSplit = append(x.DistributionSplit, &DistributionDestination{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DistributionSplit[len(x.DistributionSplit)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 21:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VestingPolicy", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.VestingPolicy == nil {
+					x.VestingPolicy = &VestingPolicy{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VestingPolicy); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_VestingPolicy                  protoreflect.MessageDescriptor
+	fd_VestingPolicy_kind             protoreflect.FieldDescriptor
+	fd_VestingPolicy_duration_seconds protoreflect.FieldDescriptor
+	fd_VestingPolicy_period_count     protoreflect.FieldDescriptor
+	fd_VestingPolicy_cliff_seconds    protoreflect.FieldDescriptor
+	fd_VestingPolicy_escrow           protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_ugdmint_v1beta1_params_proto_init()
+	md_VestingPolicy = File_cosmos_ugdmint_v1beta1_params_proto.Messages().ByName("VestingPolicy")
+	fd_VestingPolicy_kind = md_VestingPolicy.Fields().ByName("kind")
+	fd_VestingPolicy_duration_seconds = md_VestingPolicy.Fields().ByName("duration_seconds")
+	fd_VestingPolicy_period_count = md_VestingPolicy.Fields().ByName("period_count")
+	fd_VestingPolicy_cliff_seconds = md_VestingPolicy.Fields().ByName("cliff_seconds")
+	fd_VestingPolicy_escrow = md_VestingPolicy.Fields().ByName("escrow")
+}
+
+var _ protoreflect.Message = (*fastReflection_VestingPolicy)(nil)
+
+type fastReflection_VestingPolicy VestingPolicy
+
+func (x *VestingPolicy) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_VestingPolicy)(x)
+}
+
+func (x *VestingPolicy) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_ugdmint_v1beta1_params_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_VestingPolicy_messageType fastReflection_VestingPolicy_messageType
+var _ protoreflect.MessageType = fastReflection_VestingPolicy_messageType{}
+
+type fastReflection_VestingPolicy_messageType struct{}
+
+func (x fastReflection_VestingPolicy_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_VestingPolicy)(nil)
+}
+func (x fastReflection_VestingPolicy_messageType) New() protoreflect.Message {
+	return new(fastReflection_VestingPolicy)
+}
+func (x fastReflection_VestingPolicy_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_VestingPolicy
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_VestingPolicy) Descriptor() protoreflect.MessageDescriptor {
+	return md_VestingPolicy
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_VestingPolicy) Type() protoreflect.MessageType {
+	return _fastReflection_VestingPolicy_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_VestingPolicy) New() protoreflect.Message {
+	return new(fastReflection_VestingPolicy)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_VestingPolicy) Interface() protoreflect.ProtoMessage {
+	return (*VestingPolicy)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_VestingPolicy) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Kind != "" {
+		value := protoreflect.ValueOfString(x.Kind)
+		if !f(fd_VestingPolicy_kind, value) {
+			return
+		}
+	}
+	if x.DurationSeconds != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DurationSeconds)
+		if !f(fd_VestingPolicy_duration_seconds, value) {
+			return
+		}
+	}
+	if x.PeriodCount != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.PeriodCount)
+		if !f(fd_VestingPolicy_period_count, value) {
+			return
+		}
+	}
+	if x.CliffSeconds != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.CliffSeconds)
+		if !f(fd_VestingPolicy_cliff_seconds, value) {
+			return
+		}
+	}
+	if x.Escrow != false {
+		value := protoreflect.ValueOfBool(x.Escrow)
+		if !f(fd_VestingPolicy_escrow, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_VestingPolicy) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.ugdmint.v1beta1.VestingPolicy.kind":
+		return x.Kind != ""
+	case "cosmos.ugdmint.v1beta1.VestingPolicy.duration_seconds":
+		return x.DurationSeconds != uint64(0)
+	case "cosmos.ugdmint.v1beta1.VestingPolicy.period_count":
+		return x.PeriodCount != uint32(0)
+	case "cosmos.ugdmint.v1beta1.VestingPolicy.cliff_seconds":
+		return x.CliffSeconds != uint64(0)
+	case "cosmos.ugdmint.v1beta1.VestingPolicy.escrow":
+		return x.Escrow != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.ugdmint.v1beta1.VestingPolicy"))
+		}
+		panic(fmt.Errorf("message cosmos.ugdmint.v1beta1.VestingPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VestingPolicy) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.ugdmint.v1beta1.VestingPolicy.kind":
+		x.Kind = ""
+	case "cosmos.ugdmint.v1beta1.VestingPolicy.duration_seconds":
+		x.DurationSeconds = uint64(0)
+	case "cosmos.ugdmint.v1beta1.VestingPolicy.period_count":
+		x.PeriodCount = uint32(0)
+	case "cosmos.ugdmint.v1beta1.VestingPolicy.cliff_seconds":
+		x.CliffSeconds = uint64(0)
+	case "cosmos.ugdmint.v1beta1.VestingPolicy.escrow":
+		x.Escrow = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.ugdmint.v1beta1.VestingPolicy"))
+		}
+		panic(fmt.Errorf("message cosmos.ugdmint.v1beta1.VestingPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_VestingPolicy) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.ugdmint.v1beta1.VestingPolicy.kind":
+		value := x.Kind
+		return protoreflect.ValueOfString(value)
+	case "cosmos.ugdmint.v1beta1.VestingPolicy.duration_seconds":
+		value := x.DurationSeconds
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.ugdmint.v1beta1.VestingPolicy.period_count":
+		value := x.PeriodCount
+		return protoreflect.ValueOfUint32(value)
+	case "cosmos.ugdmint.v1beta1.VestingPolicy.cliff_seconds":
+		value := x.CliffSeconds
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.ugdmint.v1beta1.VestingPolicy.escrow":
+		value := x.Escrow
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.ugdmint.v1beta1.VestingPolicy"))
+		}
+		panic(fmt.Errorf("message cosmos.ugdmint.v1beta1.VestingPolicy does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VestingPolicy) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.ugdmint.v1beta1.VestingPolicy.kind":
+		x.Kind = value.Interface().(string)
+	case "cosmos.ugdmint.v1beta1.VestingPolicy.duration_seconds":
+		x.DurationSeconds = value.Uint()
+	case "cosmos.ugdmint.v1beta1.VestingPolicy.period_count":
+		x.PeriodCount = uint32(value.Uint())
+	case "cosmos.ugdmint.v1beta1.VestingPolicy.cliff_seconds":
+		x.CliffSeconds = value.Uint()
+	case "cosmos.ugdmint.v1beta1.VestingPolicy.escrow":
+		x.Escrow = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.ugdmint.v1beta1.VestingPolicy"))
+		}
+		panic(fmt.Errorf("message cosmos.ugdmint.v1beta1.VestingPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VestingPolicy) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.ugdmint.v1beta1.VestingPolicy.kind":
+		panic(fmt.Errorf("field kind of message cosmos.ugdmint.v1beta1.VestingPolicy is not mutable"))
+	case "cosmos.ugdmint.v1beta1.VestingPolicy.duration_seconds":
+		panic(fmt.Errorf("field duration_seconds of message cosmos.ugdmint.v1beta1.VestingPolicy is not mutable"))
+	case "cosmos.ugdmint.v1beta1.VestingPolicy.period_count":
+		panic(fmt.Errorf("field period_count of message cosmos.ugdmint.v1beta1.VestingPolicy is not mutable"))
+	case "cosmos.ugdmint.v1beta1.VestingPolicy.cliff_seconds":
+		panic(fmt.Errorf("field cliff_seconds of message cosmos.ugdmint.v1beta1.VestingPolicy is not mutable"))
+	case "cosmos.ugdmint.v1beta1.VestingPolicy.escrow":
+		panic(fmt.Errorf("field escrow of message cosmos.ugdmint.v1beta1.VestingPolicy is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.ugdmint.v1beta1.VestingPolicy"))
+		}
+		panic(fmt.Errorf("message cosmos.ugdmint.v1beta1.VestingPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_VestingPolicy) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.ugdmint.v1beta1.VestingPolicy.kind":
+		return protoreflect.ValueOfString("")
+	case "cosmos.ugdmint.v1beta1.VestingPolicy.duration_seconds":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.ugdmint.v1beta1.VestingPolicy.period_count":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "cosmos.ugdmint.v1beta1.VestingPolicy.cliff_seconds":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.ugdmint.v1beta1.VestingPolicy.escrow":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.ugdmint.v1beta1.VestingPolicy"))
+		}
+		panic(fmt.Errorf("message cosmos.ugdmint.v1beta1.VestingPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_VestingPolicy) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.ugdmint.v1beta1.VestingPolicy", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_VestingPolicy) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VestingPolicy) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_VestingPolicy) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_VestingPolicy) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*VestingPolicy)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Kind)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DurationSeconds != 0 {
+			n += 1 + runtime.Sov(uint64(x.DurationSeconds))
+		}
+		if x.PeriodCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.PeriodCount))
+		}
+		if x.CliffSeconds != 0 {
+			n += 1 + runtime.Sov(uint64(x.CliffSeconds))
+		}
+		if x.Escrow {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*VestingPolicy)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Escrow {
+			i--
+			if x.Escrow {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.CliffSeconds != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CliffSeconds))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.PeriodCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PeriodCount))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.DurationSeconds != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DurationSeconds))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Kind) > 0 {
+			i -= len(x.Kind)
+			copy(dAtA[i:], x.Kind)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Kind)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*VestingPolicy)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VestingPolicy: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VestingPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Kind = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DurationSeconds", wireType)
+				}
+				x.DurationSeconds = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DurationSeconds |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PeriodCount", wireType)
+				}
+				x.PeriodCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PeriodCount |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CliffSeconds", wireType)
+				}
+				x.CliffSeconds = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CliffSeconds |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Escrow", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Escrow = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_DistributionDestination           protoreflect.MessageDescriptor
+	fd_DistributionDestination_kind      protoreflect.FieldDescriptor
+	fd_DistributionDestination_recipient protoreflect.FieldDescriptor
+	fd_DistributionDestination_weight    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_ugdmint_v1beta1_params_proto_init()
+	md_DistributionDestination = File_cosmos_ugdmint_v1beta1_params_proto.Messages().ByName("DistributionDestination")
+	fd_DistributionDestination_kind = md_DistributionDestination.Fields().ByName("kind")
+	fd_DistributionDestination_recipient = md_DistributionDestination.Fields().ByName("recipient")
+	fd_DistributionDestination_weight = md_DistributionDestination.Fields().ByName("weight")
+}
+
+var _ protoreflect.Message = (*fastReflection_DistributionDestination)(nil)
+
+type fastReflection_DistributionDestination DistributionDestination
+
+func (x *DistributionDestination) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DistributionDestination)(x)
+}
+
+func (x *DistributionDestination) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_ugdmint_v1beta1_params_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_DistributionDestination_messageType fastReflection_DistributionDestination_messageType
+var _ protoreflect.MessageType = fastReflection_DistributionDestination_messageType{}
+
+type fastReflection_DistributionDestination_messageType struct{}
+
+func (x fastReflection_DistributionDestination_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DistributionDestination)(nil)
+}
+func (x fastReflection_DistributionDestination_messageType) New() protoreflect.Message {
+	return new(fastReflection_DistributionDestination)
+}
+func (x fastReflection_DistributionDestination_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DistributionDestination
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DistributionDestination) Descriptor() protoreflect.MessageDescriptor {
+	return md_DistributionDestination
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DistributionDestination) Type() protoreflect.MessageType {
+	return _fastReflection_DistributionDestination_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DistributionDestination) New() protoreflect.Message {
+	return new(fastReflection_DistributionDestination)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DistributionDestination) Interface() protoreflect.ProtoMessage {
+	return (*DistributionDestination)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DistributionDestination) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Kind != "" {
+		value := protoreflect.ValueOfString(x.Kind)
+		if !f(fd_DistributionDestination_kind, value) {
+			return
+		}
+	}
+	if x.Recipient != "" {
+		value := protoreflect.ValueOfString(x.Recipient)
+		if !f(fd_DistributionDestination_recipient, value) {
+			return
+		}
+	}
+	if x.Weight != "" {
+		value := protoreflect.ValueOfString(x.Weight)
+		if !f(fd_DistributionDestination_weight, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DistributionDestination) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.ugdmint.v1beta1.DistributionDestination.kind":
+		return x.Kind != ""
+	case "cosmos.ugdmint.v1beta1.DistributionDestination.recipient":
+		return x.Recipient != ""
+	case "cosmos.ugdmint.v1beta1.DistributionDestination.weight":
+		return x.Weight != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.ugdmint.v1beta1.DistributionDestination"))
+		}
+		panic(fmt.Errorf("message cosmos.ugdmint.v1beta1.DistributionDestination does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DistributionDestination) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.ugdmint.v1beta1.DistributionDestination.kind":
+		x.Kind = ""
+	case "cosmos.ugdmint.v1beta1.DistributionDestination.recipient":
+		x.Recipient = ""
+	case "cosmos.ugdmint.v1beta1.DistributionDestination.weight":
+		x.Weight = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.ugdmint.v1beta1.DistributionDestination"))
+		}
+		panic(fmt.Errorf("message cosmos.ugdmint.v1beta1.DistributionDestination does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DistributionDestination) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.ugdmint.v1beta1.DistributionDestination.kind":
+		value := x.Kind
+		return protoreflect.ValueOfString(value)
+	case "cosmos.ugdmint.v1beta1.DistributionDestination.recipient":
+		value := x.Recipient
+		return protoreflect.ValueOfString(value)
+	case "cosmos.ugdmint.v1beta1.DistributionDestination.weight":
+		value := x.Weight
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.ugdmint.v1beta1.DistributionDestination"))
+		}
+		panic(fmt.Errorf("message cosmos.ugdmint.v1beta1.DistributionDestination does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DistributionDestination) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.ugdmint.v1beta1.DistributionDestination.kind":
+		x.Kind = value.Interface().(string)
+	case "cosmos.ugdmint.v1beta1.DistributionDestination.recipient":
+		x.Recipient = value.Interface().(string)
+	case "cosmos.ugdmint.v1beta1.DistributionDestination.weight":
+		x.Weight = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.ugdmint.v1beta1.DistributionDestination"))
+		}
+		panic(fmt.Errorf("message cosmos.ugdmint.v1beta1.DistributionDestination does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DistributionDestination) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.ugdmint.v1beta1.DistributionDestination.kind":
+		panic(fmt.Errorf("field kind of message cosmos.ugdmint.v1beta1.DistributionDestination is not mutable"))
+	case "cosmos.ugdmint.v1beta1.DistributionDestination.recipient":
+		panic(fmt.Errorf("field recipient of message cosmos.ugdmint.v1beta1.DistributionDestination is not mutable"))
+	case "cosmos.ugdmint.v1beta1.DistributionDestination.weight":
+		panic(fmt.Errorf("field weight of message cosmos.ugdmint.v1beta1.DistributionDestination is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.ugdmint.v1beta1.DistributionDestination"))
+		}
+		panic(fmt.Errorf("message cosmos.ugdmint.v1beta1.DistributionDestination does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DistributionDestination) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.ugdmint.v1beta1.DistributionDestination.kind":
+		return protoreflect.ValueOfString("")
+	case "cosmos.ugdmint.v1beta1.DistributionDestination.recipient":
+		return protoreflect.ValueOfString("")
+	case "cosmos.ugdmint.v1beta1.DistributionDestination.weight":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.ugdmint.v1beta1.DistributionDestination"))
+		}
+		panic(fmt.Errorf("message cosmos.ugdmint.v1beta1.DistributionDestination does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DistributionDestination) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.ugdmint.v1beta1.DistributionDestination", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DistributionDestination) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DistributionDestination) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DistributionDestination) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DistributionDestination) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DistributionDestination)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Kind)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Recipient)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Weight)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DistributionDestination)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Weight) > 0 {
+			i -= len(x.Weight)
+			copy(dAtA[i:], x.Weight)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Weight)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Recipient) > 0 {
+			i -= len(x.Recipient)
+			copy(dAtA[i:], x.Recipient)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Recipient)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Kind) > 0 {
+			i -= len(x.Kind)
+			copy(dAtA[i:], x.Kind)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Kind)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DistributionDestination)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DistributionDestination: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DistributionDestination: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Kind = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Recipient = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Weight = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ScheduledParamsChange                   protoreflect.MessageDescriptor
+	fd_ScheduledParamsChange_activation_height protoreflect.FieldDescriptor
+	fd_ScheduledParamsChange_params            protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_ugdmint_v1beta1_params_proto_init()
+	md_ScheduledParamsChange = File_cosmos_ugdmint_v1beta1_params_proto.Messages().ByName("ScheduledParamsChange")
+	fd_ScheduledParamsChange_activation_height = md_ScheduledParamsChange.Fields().ByName("activation_height")
+	fd_ScheduledParamsChange_params = md_ScheduledParamsChange.Fields().ByName("params")
+}
+
+var _ protoreflect.Message = (*fastReflection_ScheduledParamsChange)(nil)
+
+type fastReflection_ScheduledParamsChange ScheduledParamsChange
+
+func (x *ScheduledParamsChange) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ScheduledParamsChange)(x)
+}
+
+func (x *ScheduledParamsChange) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_ugdmint_v1beta1_params_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ScheduledParamsChange_messageType fastReflection_ScheduledParamsChange_messageType
+var _ protoreflect.MessageType = fastReflection_ScheduledParamsChange_messageType{}
+
+type fastReflection_ScheduledParamsChange_messageType struct{}
+
+func (x fastReflection_ScheduledParamsChange_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ScheduledParamsChange)(nil)
+}
+func (x fastReflection_ScheduledParamsChange_messageType) New() protoreflect.Message {
+	return new(fastReflection_ScheduledParamsChange)
+}
+func (x fastReflection_ScheduledParamsChange_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ScheduledParamsChange
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ScheduledParamsChange) Descriptor() protoreflect.MessageDescriptor {
+	return md_ScheduledParamsChange
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Params) Type() protoreflect.MessageType {
-	return _fastReflection_Params_messageType
+func (x *fastReflection_ScheduledParamsChange) Type() protoreflect.MessageType {
+	return _fastReflection_ScheduledParamsChange_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Params) New() protoreflect.Message {
-	return new(fastReflection_Params)
+func (x *fastReflection_ScheduledParamsChange) New() protoreflect.Message {
+	return new(fastReflection_ScheduledParamsChange)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
-	return (*Params)(x)
+func (x *fastReflection_ScheduledParamsChange) Interface() protoreflect.ProtoMessage {
+	return (*ScheduledParamsChange)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -516,28 +4054,16 @@ func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.MintDenom != "" {
-		value := protoreflect.ValueOfString(x.MintDenom)
-		if !f(fd_Params_mint_denom, value) {
-			return
-		}
-	}
-	if x.SubsidyHalvingInterval != "" {
-		value := protoreflect.ValueOfString(x.SubsidyHalvingInterval)
-		if !f(fd_Params_subsidy_halving_interval, value) {
-			return
-		}
-	}
-	if x.GoalBonded != "" {
-		value := protoreflect.ValueOfString(x.GoalBonded)
-		if !f(fd_Params_goal_bonded, value) {
+func (x *fastReflection_ScheduledParamsChange) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ActivationHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ActivationHeight)
+		if !f(fd_ScheduledParamsChange_activation_height, value) {
 			return
 		}
 	}
-	if x.BlocksPerYear != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.BlocksPerYear)
-		if !f(fd_Params_blocks_per_year, value) {
+	if x.Params != nil {
+		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		if !f(fd_ScheduledParamsChange_params, value) {
 			return
 		}
 	}
@@ -554,21 +4080,17 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ScheduledParamsChange) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.ugdmint.v1beta1.Params.mint_denom":
-		return x.MintDenom != ""
-	case "cosmos.ugdmint.v1beta1.Params.subsidy_halving_interval":
-		return x.SubsidyHalvingInterval != ""
-	case "cosmos.ugdmint.v1beta1.Params.goal_bonded":
-		return x.GoalBonded != ""
-	case "cosmos.ugdmint.v1beta1.Params.blocks_per_year":
-		return x.BlocksPerYear != uint64(0)
+	case "cosmos.ugdmint.v1beta1.ScheduledParamsChange.activation_height":
+		return x.ActivationHeight != int64(0)
+	case "cosmos.ugdmint.v1beta1.ScheduledParamsChange.params":
+		return x.Params != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.ugdmint.v1beta1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.ugdmint.v1beta1.ScheduledParamsChange"))
 		}
-		panic(fmt.Errorf("message cosmos.ugdmint.v1beta1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.ugdmint.v1beta1.ScheduledParamsChange does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -578,21 +4100,17 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ScheduledParamsChange) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.ugdmint.v1beta1.Params.mint_denom":
-		x.MintDenom = ""
-	case "cosmos.ugdmint.v1beta1.Params.subsidy_halving_interval":
-		x.SubsidyHalvingInterval = ""
-	case "cosmos.ugdmint.v1beta1.Params.goal_bonded":
-		x.GoalBonded = ""
-	case "cosmos.ugdmint.v1beta1.Params.blocks_per_year":
-		x.BlocksPerYear = uint64(0)
+	case "cosmos.ugdmint.v1beta1.ScheduledParamsChange.activation_height":
+		x.ActivationHeight = int64(0)
+	case "cosmos.ugdmint.v1beta1.ScheduledParamsChange.params":
+		x.Params = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.ugdmint.v1beta1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.ugdmint.v1beta1.ScheduledParamsChange"))
 		}
-		panic(fmt.Errorf("message cosmos.ugdmint.v1beta1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.ugdmint.v1beta1.ScheduledParamsChange does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -602,25 +4120,19 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ScheduledParamsChange) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.ugdmint.v1beta1.Params.mint_denom":
-		value := x.MintDenom
-		return protoreflect.ValueOfString(value)
-	case "cosmos.ugdmint.v1beta1.Params.subsidy_halving_interval":
-		value := x.SubsidyHalvingInterval
-		return protoreflect.ValueOfString(value)
-	case "cosmos.ugdmint.v1beta1.Params.goal_bonded":
-		value := x.GoalBonded
-		return protoreflect.ValueOfString(value)
-	case "cosmos.ugdmint.v1beta1.Params.blocks_per_year":
-		value := x.BlocksPerYear
-		return protoreflect.ValueOfUint64(value)
+	case "cosmos.ugdmint.v1beta1.ScheduledParamsChange.activation_height":
+		value := x.ActivationHeight
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.ugdmint.v1beta1.ScheduledParamsChange.params":
+		value := x.Params
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.ugdmint.v1beta1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.ugdmint.v1beta1.ScheduledParamsChange"))
 		}
-		panic(fmt.Errorf("message cosmos.ugdmint.v1beta1.Params does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.ugdmint.v1beta1.ScheduledParamsChange does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -634,21 +4146,17 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ScheduledParamsChange) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.ugdmint.v1beta1.Params.mint_denom":
-		x.MintDenom = value.Interface().(string)
-	case "cosmos.ugdmint.v1beta1.Params.subsidy_halving_interval":
-		x.SubsidyHalvingInterval = value.Interface().(string)
-	case "cosmos.ugdmint.v1beta1.Params.goal_bonded":
-		x.GoalBonded = value.Interface().(string)
-	case "cosmos.ugdmint.v1beta1.Params.blocks_per_year":
-		x.BlocksPerYear = value.Uint()
+	case "cosmos.ugdmint.v1beta1.ScheduledParamsChange.activation_height":
+		x.ActivationHeight = value.Int()
+	case "cosmos.ugdmint.v1beta1.ScheduledParamsChange.params":
+		x.Params = value.Message().Interface().(*Params)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.ugdmint.v1beta1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.ugdmint.v1beta1.ScheduledParamsChange"))
 		}
-		panic(fmt.Errorf("message cosmos.ugdmint.v1beta1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.ugdmint.v1beta1.ScheduledParamsChange does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -662,52 +4170,48 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ScheduledParamsChange) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.ugdmint.v1beta1.Params.mint_denom":
-		panic(fmt.Errorf("field mint_denom of message cosmos.ugdmint.v1beta1.Params is not mutable"))
-	case "cosmos.ugdmint.v1beta1.Params.subsidy_halving_interval":
-		panic(fmt.Errorf("field subsidy_halving_interval of message cosmos.ugdmint.v1beta1.Params is not mutable"))
-	case "cosmos.ugdmint.v1beta1.Params.goal_bonded":
-		panic(fmt.Errorf("field goal_bonded of message cosmos.ugdmint.v1beta1.Params is not mutable"))
-	case "cosmos.ugdmint.v1beta1.Params.blocks_per_year":
-		panic(fmt.Errorf("field blocks_per_year of message cosmos.ugdmint.v1beta1.Params is not mutable"))
+	case "cosmos.ugdmint.v1beta1.ScheduledParamsChange.params":
+		if x.Params == nil {
+			x.Params = new(Params)
+		}
+		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "cosmos.ugdmint.v1beta1.ScheduledParamsChange.activation_height":
+		panic(fmt.Errorf("field activation_height of message cosmos.ugdmint.v1beta1.ScheduledParamsChange is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.ugdmint.v1beta1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.ugdmint.v1beta1.ScheduledParamsChange"))
 		}
-		panic(fmt.Errorf("message cosmos.ugdmint.v1beta1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.ugdmint.v1beta1.ScheduledParamsChange does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ScheduledParamsChange) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.ugdmint.v1beta1.Params.mint_denom":
-		return protoreflect.ValueOfString("")
-	case "cosmos.ugdmint.v1beta1.Params.subsidy_halving_interval":
-		return protoreflect.ValueOfString("")
-	case "cosmos.ugdmint.v1beta1.Params.goal_bonded":
-		return protoreflect.ValueOfString("")
-	case "cosmos.ugdmint.v1beta1.Params.blocks_per_year":
-		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.ugdmint.v1beta1.ScheduledParamsChange.activation_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.ugdmint.v1beta1.ScheduledParamsChange.params":
+		m := new(Params)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.ugdmint.v1beta1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.ugdmint.v1beta1.ScheduledParamsChange"))
 		}
-		panic(fmt.Errorf("message cosmos.ugdmint.v1beta1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.ugdmint.v1beta1.ScheduledParamsChange does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ScheduledParamsChange) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.ugdmint.v1beta1.Params", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.ugdmint.v1beta1.ScheduledParamsChange", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -715,7 +4219,7 @@ func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) proto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ScheduledParamsChange) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -726,7 +4230,7 @@ func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ScheduledParamsChange) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -738,7 +4242,7 @@ func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Params) IsValid() bool {
+func (x *fastReflection_ScheduledParamsChange) IsValid() bool {
 	return x != nil
 }
 
@@ -748,9 +4252,9 @@ func (x *fastReflection_Params) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ScheduledParamsChange) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*ScheduledParamsChange)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -762,21 +4266,13 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.MintDenom)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.SubsidyHalvingInterval)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.ActivationHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.ActivationHeight))
 		}
-		l = len(x.GoalBonded)
-		if l > 0 {
+		if x.Params != nil {
+			l = options.Size(x.Params)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.BlocksPerYear != 0 {
-			n += 1 + runtime.Sov(uint64(x.BlocksPerYear))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -787,7 +4283,7 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*ScheduledParamsChange)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -806,31 +4302,24 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.BlocksPerYear != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlocksPerYear))
-			i--
-			dAtA[i] = 0x20
-		}
-		if len(x.GoalBonded) > 0 {
-			i -= len(x.GoalBonded)
-			copy(dAtA[i:], x.GoalBonded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GoalBonded)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.SubsidyHalvingInterval) > 0 {
-			i -= len(x.SubsidyHalvingInterval)
-			copy(dAtA[i:], x.SubsidyHalvingInterval)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SubsidyHalvingInterval)))
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.MintDenom) > 0 {
-			i -= len(x.MintDenom)
-			copy(dAtA[i:], x.MintDenom)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MintDenom)))
+		if x.ActivationHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ActivationHeight))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -843,7 +4332,7 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*ScheduledParamsChange)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -875,17 +4364,17 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ScheduledParamsChange: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ScheduledParamsChange: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MintDenom", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
 				}
-				var stringLen uint64
+				x.ActivationHeight = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -895,61 +4384,16 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.ActivationHeight |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.MintDenom = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SubsidyHalvingInterval", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.SubsidyHalvingInterval = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GoalBonded", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -959,43 +4403,28 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.GoalBonded = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlocksPerYear", wireType)
-				}
-				x.BlocksPerYear = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.BlocksPerYear |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Params == nil {
+					x.Params = &Params{}
 				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1052,6 +4481,20 @@ type Minter struct {
 
 	// current subsidy halving interval
 	SubsidyHalvingInterval string `protobuf:"bytes,1,opt,name=subsidy_halving_interval,json=subsidyHalvingInterval,proto3" json:"subsidy_halving_interval,omitempty"`
+	// total amount minted through block provisions
+	BlockProvisionMinted []*v1beta1.Coin `protobuf:"bytes,2,rep,name=block_provision_minted,json=blockProvisionMinted,proto3" json:"block_provision_minted,omitempty"`
+	// total amount minted through Hedgehog mints
+	HedgehogMinted []*v1beta1.Coin `protobuf:"bytes,3,rep,name=hedgehog_minted,json=hedgehogMinted,proto3" json:"hedgehog_minted,omitempty"`
+	// height of the last block the module minted coins at
+	LastMintHeight int64 `protobuf:"varint,4,opt,name=last_mint_height,json=lastMintHeight,proto3" json:"last_mint_height,omitempty"`
+	// block provision minted at the last block
+	BlockSubsidy []*v1beta1.Coin `protobuf:"bytes,5,rep,name=block_subsidy,json=blockSubsidy,proto3" json:"block_subsidy,omitempty"`
+	// current annual inflation rate of the bonded-ratio emission strategy
+	Inflation string `protobuf:"bytes,6,opt,name=inflation,proto3" json:"inflation,omitempty"`
+	// current annual expected provisions of the bonded-ratio emission strategy
+	AnnualProvisions string `protobuf:"bytes,7,opt,name=annual_provisions,json=annualProvisions,proto3" json:"annual_provisions,omitempty"`
+	// seconds between the last block and the block before it
+	ObservedBlockTimeSeconds uint64 `protobuf:"varint,8,opt,name=observed_block_time_seconds,json=observedBlockTimeSeconds,proto3" json:"observed_block_time_seconds,omitempty"`
 }
 
 func (x *Minter) Reset() {
@@ -1081,6 +4524,55 @@ func (x *Minter) GetSubsidyHalvingInterval() string {
 	return ""
 }
 
+func (x *Minter) GetBlockProvisionMinted() []*v1beta1.Coin {
+	if x != nil {
+		return x.BlockProvisionMinted
+	}
+	return nil
+}
+
+func (x *Minter) GetHedgehogMinted() []*v1beta1.Coin {
+	if x != nil {
+		return x.HedgehogMinted
+	}
+	return nil
+}
+
+func (x *Minter) GetLastMintHeight() int64 {
+	if x != nil {
+		return x.LastMintHeight
+	}
+	return 0
+}
+
+func (x *Minter) GetBlockSubsidy() []*v1beta1.Coin {
+	if x != nil {
+		return x.BlockSubsidy
+	}
+	return nil
+}
+
+func (x *Minter) GetInflation() string {
+	if x != nil {
+		return x.Inflation
+	}
+	return ""
+}
+
+func (x *Minter) GetAnnualProvisions() string {
+	if x != nil {
+		return x.AnnualProvisions
+	}
+	return ""
+}
+
+func (x *Minter) GetObservedBlockTimeSeconds() uint64 {
+	if x != nil {
+		return x.ObservedBlockTimeSeconds
+	}
+	return 0
+}
+
 // Params defines the parameters for the module.
 type Params struct {
 	state         protoimpl.MessageState
@@ -1095,6 +4587,44 @@ type Params struct {
 	GoalBonded string `protobuf:"bytes,3,opt,name=goal_bonded,json=goalBonded,proto3" json:"goal_bonded,omitempty"`
 	// expected blocks per year
 	BlocksPerYear uint64 `protobuf:"varint,4,opt,name=blocks_per_year,json=blocksPerYear,proto3" json:"blocks_per_year,omitempty"`
+	// number of blocks per minute the block provision is divided by
+	BlocksPerMinute uint64 `protobuf:"varint,5,opt,name=blocks_per_minute,json=blocksPerMinute,proto3" json:"blocks_per_minute,omitempty"`
+	// offset added to the block height before the decay is applied
+	HeightOffset uint64 `protobuf:"varint,6,opt,name=height_offset,json=heightOffset,proto3" json:"height_offset,omitempty"`
+	// adjusted height from which on the subsidy decays
+	DecayStartHeight uint64 `protobuf:"varint,7,opt,name=decay_start_height,json=decayStartHeight,proto3" json:"decay_start_height,omitempty"`
+	// factor the subsidy is multiplied with every subsidy halving interval
+	DecayFactor string `protobuf:"bytes,8,opt,name=decay_factor,json=decayFactor,proto3" json:"decay_factor,omitempty"`
+	// length in seconds of the block time window the subsidy is paid for
+	ReferenceWindowSeconds uint64 `protobuf:"varint,9,opt,name=reference_window_seconds,json=referenceWindowSeconds,proto3" json:"reference_window_seconds,omitempty"`
+	// number of units of the mint denom in one whole coin
+	ProvisionScale uint64 `protobuf:"varint,10,opt,name=provision_scale,json=provisionScale,proto3" json:"provision_scale,omitempty"`
+	// lower bound in seconds of the block time a block is paid for
+	MinBlockTimeDeltaSeconds uint64 `protobuf:"varint,11,opt,name=min_block_time_delta_seconds,json=minBlockTimeDeltaSeconds,proto3" json:"min_block_time_delta_seconds,omitempty"`
+	// upper bound in seconds of the block time a block is paid for
+	MaxBlockTimeDeltaSeconds uint64 `protobuf:"varint,12,opt,name=max_block_time_delta_seconds,json=maxBlockTimeDeltaSeconds,proto3" json:"max_block_time_delta_seconds,omitempty"`
+	// maximum amount of every listed denom the module mints in total, denoms
+	// that are not listed are not capped
+	MaxSupply []*v1beta1.Coin `protobuf:"bytes,13,rep,name=max_supply,json=maxSupply,proto3" json:"max_supply,omitempty"`
+	// maximum annual change in inflation rate of the bonded-ratio emission
+	// strategy
+	InflationRateChange string `protobuf:"bytes,14,opt,name=inflation_rate_change,json=inflationRateChange,proto3" json:"inflation_rate_change,omitempty"`
+	// maximum inflation rate of the bonded-ratio emission strategy
+	InflationMax string `protobuf:"bytes,15,opt,name=inflation_max,json=inflationMax,proto3" json:"inflation_max,omitempty"`
+	// minimum inflation rate of the bonded-ratio emission strategy
+	InflationMin string `protobuf:"bytes,16,opt,name=inflation_min,json=inflationMin,proto3" json:"inflation_min,omitempty"`
+	// whether the block provision is adjusted by the bonded ratio
+	BondedAdjustmentEnabled bool `protobuf:"varint,17,opt,name=bonded_adjustment_enabled,json=bondedAdjustmentEnabled,proto3" json:"bonded_adjustment_enabled,omitempty"`
+	// share of the block provision additionally paid to the stakers while the
+	// bonded ratio is below goal_bonded
+	BelowGoalStakingShare string `protobuf:"bytes,18,opt,name=below_goal_staking_share,json=belowGoalStakingShare,proto3" json:"below_goal_staking_share,omitempty"`
+	// destination of the excess provision while the bonded ratio is above
+	// goal_bonded, either community_pool or burn
+	ExcessDestination string `protobuf:"bytes,19,opt,name=excess_destination,json=excessDestination,proto3" json:"excess_destination,omitempty"`
+	// destinations the block provision is paid to, the weights sum to 1
+	DistributionSplit []*DistributionDestination `protobuf:"bytes,20,rep,name=distribution_split,json=distributionSplit,proto3" json:"distribution_split,omitempty"`
+	// vesting terms of the coins paid out by Hedgehog mints
+	VestingPolicy *VestingPolicy `protobuf:"bytes,21,opt,name=vesting_policy,json=vestingPolicy,proto3" json:"vesting_policy,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -1145,6 +4675,305 @@ func (x *Params) GetBlocksPerYear() uint64 {
 	return 0
 }
 
+func (x *Params) GetBlocksPerMinute() uint64 {
+	if x != nil {
+		return x.BlocksPerMinute
+	}
+	return 0
+}
+
+func (x *Params) GetHeightOffset() uint64 {
+	if x != nil {
+		return x.HeightOffset
+	}
+	return 0
+}
+
+func (x *Params) GetDecayStartHeight() uint64 {
+	if x != nil {
+		return x.DecayStartHeight
+	}
+	return 0
+}
+
+func (x *Params) GetDecayFactor() string {
+	if x != nil {
+		return x.DecayFactor
+	}
+	return ""
+}
+
+func (x *Params) GetReferenceWindowSeconds() uint64 {
+	if x != nil {
+		return x.ReferenceWindowSeconds
+	}
+	return 0
+}
+
+func (x *Params) GetProvisionScale() uint64 {
+	if x != nil {
+		return x.ProvisionScale
+	}
+	return 0
+}
+
+func (x *Params) GetMinBlockTimeDeltaSeconds() uint64 {
+	if x != nil {
+		return x.MinBlockTimeDeltaSeconds
+	}
+	return 0
+}
+
+func (x *Params) GetMaxBlockTimeDeltaSeconds() uint64 {
+	if x != nil {
+		return x.MaxBlockTimeDeltaSeconds
+	}
+	return 0
+}
+
+func (x *Params) GetMaxSupply() []*v1beta1.Coin {
+	if x != nil {
+		return x.MaxSupply
+	}
+	return nil
+}
+
+func (x *Params) GetInflationRateChange() string {
+	if x != nil {
+		return x.InflationRateChange
+	}
+	return ""
+}
+
+func (x *Params) GetInflationMax() string {
+	if x != nil {
+		return x.InflationMax
+	}
+	return ""
+}
+
+func (x *Params) GetInflationMin() string {
+	if x != nil {
+		return x.InflationMin
+	}
+	return ""
+}
+
+func (x *Params) GetBondedAdjustmentEnabled() bool {
+	if x != nil {
+		return x.BondedAdjustmentEnabled
+	}
+	return false
+}
+
+func (x *Params) GetBelowGoalStakingShare() string {
+	if x != nil {
+		return x.BelowGoalStakingShare
+	}
+	return ""
+}
+
+func (x *Params) GetExcessDestination() string {
+	if x != nil {
+		return x.ExcessDestination
+	}
+	return ""
+}
+
+func (x *Params) GetDistributionSplit() []*DistributionDestination {
+	if x != nil {
+		return x.DistributionSplit
+	}
+	return nil
+}
+
+func (x *Params) GetVestingPolicy() *VestingPolicy {
+	if x != nil {
+		return x.VestingPolicy
+	}
+	return nil
+}
+
+// VestingPolicy defines how the coins paid out by a Hedgehog mint vest.
+type VestingPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// kind of vesting, one of none, delayed, continuous or periodic
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// seconds from the mint until the coins are fully vested
+	DurationSeconds uint64 `protobuf:"varint,2,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	// number of equal periods the coins vest in, only set for periodic vesting
+	PeriodCount uint32 `protobuf:"varint,3,opt,name=period_count,json=periodCount,proto3" json:"period_count,omitempty"`
+	// seconds from the mint before continuous or periodic vesting starts
+	CliffSeconds uint64 `protobuf:"varint,4,opt,name=cliff_seconds,json=cliffSeconds,proto3" json:"cliff_seconds,omitempty"`
+	// whether the coins are held in the ugdmint_escrow module account and
+	// claimed by the recipient as they unlock, instead of vesting in the
+	// account of the recipient
+	Escrow bool `protobuf:"varint,5,opt,name=escrow,proto3" json:"escrow,omitempty"`
+}
+
+func (x *VestingPolicy) Reset() {
+	*x = VestingPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_ugdmint_v1beta1_params_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VestingPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VestingPolicy) ProtoMessage() {}
+
+// Deprecated: Use VestingPolicy.ProtoReflect.Descriptor instead.
+func (*VestingPolicy) Descriptor() ([]byte, []int) {
+	return file_cosmos_ugdmint_v1beta1_params_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *VestingPolicy) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *VestingPolicy) GetDurationSeconds() uint64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *VestingPolicy) GetPeriodCount() uint32 {
+	if x != nil {
+		return x.PeriodCount
+	}
+	return 0
+}
+
+func (x *VestingPolicy) GetCliffSeconds() uint64 {
+	if x != nil {
+		return x.CliffSeconds
+	}
+	return 0
+}
+
+func (x *VestingPolicy) GetEscrow() bool {
+	if x != nil {
+		return x.Escrow
+	}
+	return false
+}
+
+// DistributionDestination is a destination of the block provision along with
+// the share of the provision it is paid.
+type DistributionDestination struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// kind of destination, one of fee_collector, community_pool,
+	// module_account, address or burn
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// name of the module account or bech32 address that is paid, only set for
+	// the module_account and address types
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// share of the block provision paid to the destination
+	Weight string `protobuf:"bytes,3,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (x *DistributionDestination) Reset() {
+	*x = DistributionDestination{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_ugdmint_v1beta1_params_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DistributionDestination) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DistributionDestination) ProtoMessage() {}
+
+// Deprecated: Use DistributionDestination.ProtoReflect.Descriptor instead.
+func (*DistributionDestination) Descriptor() ([]byte, []int) {
+	return file_cosmos_ugdmint_v1beta1_params_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DistributionDestination) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *DistributionDestination) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *DistributionDestination) GetWeight() string {
+	if x != nil {
+		return x.Weight
+	}
+	return ""
+}
+
+// ScheduledParamsChange is a set of params that replaces the params of the
+// module at the beginning of the block at activation_height.
+type ScheduledParamsChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// activation_height is the height of the block that applies the params.
+	ActivationHeight int64 `protobuf:"varint,1,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	// params are the params applied at activation_height.
+	Params *Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (x *ScheduledParamsChange) Reset() {
+	*x = ScheduledParamsChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_ugdmint_v1beta1_params_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduledParamsChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledParamsChange) ProtoMessage() {}
+
+// Deprecated: Use ScheduledParamsChange.ProtoReflect.Descriptor instead.
+func (*ScheduledParamsChange) Descriptor() ([]byte, []int) {
+	return file_cosmos_ugdmint_v1beta1_params_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ScheduledParamsChange) GetActivationHeight() int64 {
+	if x != nil {
+		return x.ActivationHeight
+	}
+	return 0
+}
+
+func (x *ScheduledParamsChange) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
 var File_cosmos_ugdmint_v1beta1_params_proto protoreflect.FileDescriptor
 
 var file_cosmos_ugdmint_v1beta1_params_proto_rawDesc = []byte{
@@ -1156,48 +4985,203 @@ var file_cosmos_ugdmint_v1beta1_params_proto_rawDesc = []byte{
 	0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11,
 	0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0x75, 0x0a, 0x06, 0x4d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x6b, 0x0a, 0x18, 0x73,
-	0x75, 0x62, 0x73, 0x69, 0x64, 0x79, 0x5f, 0x68, 0x61, 0x6c, 0x76, 0x69, 0x6e, 0x67, 0x5f, 0x69,
-	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8,
-	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
-	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44,
-	0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63,
-	0x52, 0x16, 0x73, 0x75, 0x62, 0x73, 0x69, 0x64, 0x79, 0x48, 0x61, 0x6c, 0x76, 0x69, 0x6e, 0x67,
-	0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x22, 0xb6, 0x02, 0x0a, 0x06, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x64, 0x65, 0x6e, 0x6f,
-	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x74, 0x44, 0x65, 0x6e,
-	0x6f, 0x6d, 0x12, 0x6b, 0x0a, 0x18, 0x73, 0x75, 0x62, 0x73, 0x69, 0x64, 0x79, 0x5f, 0x68, 0x61,
-	0x6c, 0x76, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
-	0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x16, 0x73, 0x75, 0x62, 0x73, 0x69, 0x64, 0x79,
-	0x48, 0x61, 0x6c, 0x76, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12,
-	0x52, 0x0a, 0x0b, 0x67, 0x6f, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
-	0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0a, 0x67, 0x6f, 0x61, 0x6c, 0x42, 0x6f, 0x6e,
-	0x64, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x5f, 0x70, 0x65,
-	0x72, 0x5f, 0x79, 0x65, 0x61, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x73, 0x50, 0x65, 0x72, 0x59, 0x65, 0x61, 0x72, 0x3a, 0x24, 0x98, 0xa0, 0x1f,
-	0x00, 0x8a, 0xe7, 0xb0, 0x2a, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b,
-	0x2f, 0x78, 0x2f, 0x75, 0x67, 0x64, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x42, 0xdb, 0x01, 0x0a, 0x1a, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x75, 0x67, 0x64, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
-	0x36, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x75, 0x67, 0x64, 0x6d, 0x69, 0x6e, 0x74,
-	0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x75, 0x67, 0x64, 0x6d, 0x69, 0x6e, 0x74,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x55, 0x58, 0xaa, 0x02, 0x16,
-	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x55, 0x67, 0x64, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x56,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x16, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c,
-	0x55, 0x67, 0x64, 0x6d, 0x69, 0x6e, 0x74, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2,
-	0x02, 0x22, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x55, 0x67, 0x64, 0x6d, 0x69, 0x6e, 0x74,
-	0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x18, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x55,
-	0x67, 0x64, 0x6d, 0x69, 0x6e, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0xbf, 0x06, 0x0a, 0x06, 0x4d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x6b, 0x0a, 0x18,
+	0x73, 0x75, 0x62, 0x73, 0x69, 0x64, 0x79, 0x5f, 0x68, 0x61, 0x6c, 0x76, 0x69, 0x6e, 0x67, 0x5f,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31,
+	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79,
+	0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65,
+	0x63, 0x52, 0x16, 0x73, 0x75, 0x62, 0x73, 0x69, 0x64, 0x79, 0x48, 0x61, 0x6c, 0x76, 0x69, 0x6e,
+	0x67, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x97, 0x01, 0x0a, 0x16, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x69,
+	0x6e, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61,
+	0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x14, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x6e,
+	0x74, 0x65, 0x64, 0x12, 0x8a, 0x01, 0x0a, 0x0f, 0x68, 0x65, 0x64, 0x67, 0x65, 0x68, 0x6f, 0x67,
+	0x5f, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf,
+	0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c,
+	0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x0e, 0x68, 0x65, 0x64, 0x67, 0x65, 0x68, 0x6f, 0x67, 0x4d, 0x69, 0x6e, 0x74, 0x65, 0x64,
+	0x12, 0x28, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74,
+	0x4d, 0x69, 0x6e, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x86, 0x01, 0x0a, 0x0d, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x69, 0x64, 0x79, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x46, 0xc8,
+	0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d,
+	0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a,
+	0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x75, 0x62, 0x73,
+	0x69, 0x64, 0x79, 0x12, 0x4f, 0x0a, 0x09, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
+	0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x09, 0x69, 0x6e, 0x66, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5e, 0x0a, 0x11, 0x61, 0x6e, 0x6e, 0x75, 0x61, 0x6c, 0x5f, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63,
+	0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44,
+	0x65, 0x63, 0x52, 0x10, 0x61, 0x6e, 0x6e, 0x75, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3d, 0x0a, 0x1b, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x18, 0x6f, 0x62, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x22, 0xa3, 0x0c, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x74, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x6b, 0x0a,
+	0x18, 0x73, 0x75, 0x62, 0x73, 0x69, 0x64, 0x79, 0x5f, 0x68, 0x61, 0x6c, 0x76, 0x69, 0x6e, 0x67,
+	0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63,
+	0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44,
+	0x65, 0x63, 0x52, 0x16, 0x73, 0x75, 0x62, 0x73, 0x69, 0x64, 0x79, 0x48, 0x61, 0x6c, 0x76, 0x69,
+	0x6e, 0x67, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x52, 0x0a, 0x0b, 0x67, 0x6f,
+	0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63,
+	0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44,
+	0x65, 0x63, 0x52, 0x0a, 0x67, 0x6f, 0x61, 0x6c, 0x42, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x26,
+	0x0a, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x79, 0x65, 0x61,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x50,
+	0x65, 0x72, 0x59, 0x65, 0x61, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x50, 0x65, 0x72, 0x4d, 0x69, 0x6e, 0x75,
+	0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x64, 0x65, 0x63, 0x61, 0x79,
+	0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x10, 0x64, 0x65, 0x63, 0x61, 0x79, 0x53, 0x74, 0x61, 0x72, 0x74, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x54, 0x0a, 0x0c, 0x64, 0x65, 0x63, 0x61, 0x79, 0x5f, 0x66,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63,
+	0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0b,
+	0x64, 0x65, 0x63, 0x61, 0x79, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x38, 0x0a, 0x18, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f,
+	0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x3e,
+	0x0a, 0x1c, 0x6d, 0x69, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x18, 0x6d, 0x69, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69,
+	0x6d, 0x65, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x3e,
+	0x0a, 0x1c, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x18, 0x6d, 0x61, 0x78, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69,
+	0x6d, 0x65, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x80,
+	0x01, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x0d, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x46,
+	0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73,
+	0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e,
+	0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x53, 0x75, 0x70, 0x70, 0x6c,
+	0x79, 0x12, 0x65, 0x0a, 0x15, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72,
+	0x61, 0x74, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61,
+	0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x44, 0x65, 0x63, 0x52, 0x13, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61,
+	0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x56, 0x0a, 0x0d, 0x69, 0x6e, 0x66, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x61, 0x78, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63,
+	0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44,
+	0x65, 0x63, 0x52, 0x0c, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x78,
+	0x12, 0x56, 0x0a, 0x0d, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x69,
+	0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
+	0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0c, 0x69, 0x6e, 0x66, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x6e, 0x12, 0x3a, 0x0a, 0x19, 0x62, 0x6f, 0x6e, 0x64,
+	0x65, 0x64, 0x5f, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x17, 0x62, 0x6f, 0x6e,
+	0x64, 0x65, 0x64, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x12, 0x6a, 0x0a, 0x18, 0x62, 0x65, 0x6c, 0x6f, 0x77, 0x5f, 0x67, 0x6f,
+	0x61, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
+	0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x15, 0x62, 0x65, 0x6c, 0x6f, 0x77,
+	0x47, 0x6f, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x68, 0x61, 0x72, 0x65,
+	0x12, 0x2d, 0x0a, 0x12, 0x65, 0x78, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x65, 0x78,
+	0x63, 0x65, 0x73, 0x73, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x69, 0x0a, 0x12, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x73, 0x70, 0x6c, 0x69, 0x74, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x75, 0x67, 0x64, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09, 0xc8, 0xde,
+	0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x11, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x12, 0x57, 0x0a, 0x0e, 0x76, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x15, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x75, 0x67, 0x64, 0x6d,
+	0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x56, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x3a, 0x24, 0x98, 0xa0, 0x1f, 0x00, 0x8a, 0xe7, 0xb0, 0x2a, 0x1b, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x78, 0x2f, 0x75, 0x67, 0x64, 0x6d, 0x69,
+	0x6e, 0x74, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0xae, 0x01, 0x0a, 0x0d, 0x56, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6b,
+	0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12,
+	0x29, 0x0a, 0x10, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0b, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a,
+	0x0d, 0x63, 0x6c, 0x69, 0x66, 0x66, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x63, 0x6c, 0x69, 0x66, 0x66, 0x53, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x22, 0x96, 0x01, 0x0a, 0x17, 0x44,
+	0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65,
+	0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72,
+	0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x49, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
+	0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
+	0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d,
+	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x06, 0x77, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x22, 0x87, 0x01, 0x0a, 0x15, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x2b, 0x0a,
+	0x11, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x41, 0x0a, 0x06, 0x70, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x75, 0x67, 0x64, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0xdb, 0x01,
+	0x0a, 0x1a, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x75, 0x67, 0x64,
+	0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0b, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x36, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x75, 0x67, 0x64, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x3b, 0x75, 0x67, 0x64, 0x6d, 0x69, 0x6e, 0x74, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x55, 0x58, 0xaa, 0x02, 0x16, 0x43, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x55, 0x67, 0x64, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0xca, 0x02, 0x16, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x55, 0x67, 0x64, 0x6d,
+	0x69, 0x6e, 0x74, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x22, 0x43, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x55, 0x67, 0x64, 0x6d, 0x69, 0x6e, 0x74, 0x5c, 0x56, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0xea, 0x02, 0x18, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x55, 0x67, 0x64, 0x6d, 0x69,
+	0x6e, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1212,17 +5196,28 @@ func file_cosmos_ugdmint_v1beta1_params_proto_rawDescGZIP() []byte {
 	return file_cosmos_ugdmint_v1beta1_params_proto_rawDescData
 }
 
-var file_cosmos_ugdmint_v1beta1_params_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_cosmos_ugdmint_v1beta1_params_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_cosmos_ugdmint_v1beta1_params_proto_goTypes = []interface{}{
-	(*Minter)(nil), // 0: cosmos.ugdmint.v1beta1.Minter
-	(*Params)(nil), // 1: cosmos.ugdmint.v1beta1.Params
+	(*Minter)(nil),                  // 0: cosmos.ugdmint.v1beta1.Minter
+	(*Params)(nil),                  // 1: cosmos.ugdmint.v1beta1.Params
+	(*VestingPolicy)(nil),           // 2: cosmos.ugdmint.v1beta1.VestingPolicy
+	(*DistributionDestination)(nil), // 3: cosmos.ugdmint.v1beta1.DistributionDestination
+	(*ScheduledParamsChange)(nil),   // 4: cosmos.ugdmint.v1beta1.ScheduledParamsChange
+	(*v1beta1.Coin)(nil),            // 5: cosmos.base.v1beta1.Coin
 }
 var file_cosmos_ugdmint_v1beta1_params_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	5, // 0: cosmos.ugdmint.v1beta1.Minter.block_provision_minted:type_name -> cosmos.base.v1beta1.Coin
+	5, // 1: cosmos.ugdmint.v1beta1.Minter.hedgehog_minted:type_name -> cosmos.base.v1beta1.Coin
+	5, // 2: cosmos.ugdmint.v1beta1.Minter.block_subsidy:type_name -> cosmos.base.v1beta1.Coin
+	5, // 3: cosmos.ugdmint.v1beta1.Params.max_supply:type_name -> cosmos.base.v1beta1.Coin
+	3, // 4: cosmos.ugdmint.v1beta1.Params.distribution_split:type_name -> cosmos.ugdmint.v1beta1.DistributionDestination
+	2, // 5: cosmos.ugdmint.v1beta1.Params.vesting_policy:type_name -> cosmos.ugdmint.v1beta1.VestingPolicy
+	1, // 6: cosmos.ugdmint.v1beta1.ScheduledParamsChange.params:type_name -> cosmos.ugdmint.v1beta1.Params
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_ugdmint_v1beta1_params_proto_init() }
@@ -1255,6 +5250,42 @@ func file_cosmos_ugdmint_v1beta1_params_proto_init() {
 				return nil
 			}
 		}
+		file_cosmos_ugdmint_v1beta1_params_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VestingPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_ugdmint_v1beta1_params_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DistributionDestination); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_ugdmint_v1beta1_params_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduledParamsChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1262,7 +5293,7 @@ func file_cosmos_ugdmint_v1beta1_params_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_ugdmint_v1beta1_params_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
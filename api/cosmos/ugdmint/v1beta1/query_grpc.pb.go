@@ -22,6 +22,18 @@ const (
 	Query_Params_FullMethodName                 = "/cosmos.ugdmint.v1beta1.Query/Params"
 	Query_SubsidyHalvingInterval_FullMethodName = "/cosmos.ugdmint.v1beta1.Query/SubsidyHalvingInterval"
 	Query_AllMintRecords_FullMethodName         = "/cosmos.ugdmint.v1beta1.Query/AllMintRecords"
+	Query_ProcessedMints_FullMethodName         = "/cosmos.ugdmint.v1beta1.Query/ProcessedMints"
+	Query_PreviousBlockTime_FullMethodName      = "/cosmos.ugdmint.v1beta1.Query/PreviousBlockTime"
+	Query_Minter_FullMethodName                 = "/cosmos.ugdmint.v1beta1.Query/Minter"
+	Query_SupplyHeadroom_FullMethodName         = "/cosmos.ugdmint.v1beta1.Query/SupplyHeadroom"
+	Query_EmissionProjection_FullMethodName     = "/cosmos.ugdmint.v1beta1.Query/EmissionProjection"
+	Query_MintingPause_FullMethodName           = "/cosmos.ugdmint.v1beta1.Query/MintingPause"
+	Query_ScheduledParamsChanges_FullMethodName = "/cosmos.ugdmint.v1beta1.Query/ScheduledParamsChanges"
+	Query_Inflation_FullMethodName              = "/cosmos.ugdmint.v1beta1.Query/Inflation"
+	Query_AnnualProvisions_FullMethodName       = "/cosmos.ugdmint.v1beta1.Query/AnnualProvisions"
+	Query_Claimable_FullMethodName              = "/cosmos.ugdmint.v1beta1.Query/Claimable"
+	Query_EscrowSchedules_FullMethodName        = "/cosmos.ugdmint.v1beta1.Query/EscrowSchedules"
+	Query_MintRecipient_FullMethodName          = "/cosmos.ugdmint.v1beta1.Query/MintRecipient"
 )
 
 // QueryClient is the client API for Query service.
@@ -34,6 +46,36 @@ type QueryClient interface {
 	SubsidyHalvingInterval(ctx context.Context, in *QuerySubsidyHalvingIntervalRequest, opts ...grpc.CallOption) (*QuerySubsidyHalvingIntervalResponse, error)
 	// AllMintRecords queries all mint records stored by the module.
 	AllMintRecords(ctx context.Context, in *QueryAllMintRecordsRequest, opts ...grpc.CallOption) (*QueryAllMintRecordsResponse, error)
+	// ProcessedMints queries the Hedgehog mints that have already been paid out.
+	ProcessedMints(ctx context.Context, in *QueryProcessedMintsRequest, opts ...grpc.CallOption) (*QueryProcessedMintsResponse, error)
+	// PreviousBlockTime queries the time of the last block the module has seen.
+	PreviousBlockTime(ctx context.Context, in *QueryPreviousBlockTimeRequest, opts ...grpc.CallOption) (*QueryPreviousBlockTimeResponse, error)
+	// Minter queries the minting state, including the running emission totals.
+	Minter(ctx context.Context, in *QueryMinterRequest, opts ...grpc.CallOption) (*QueryMinterResponse, error)
+	// SupplyHeadroom queries how much of every capped denom may still be minted.
+	SupplyHeadroom(ctx context.Context, in *QuerySupplyHeadroomRequest, opts ...grpc.CallOption) (*QuerySupplyHeadroomResponse, error)
+	// EmissionProjection projects the block provisions and the supply of the
+	// mint denom over a range of future heights without changing any state.
+	EmissionProjection(ctx context.Context, in *QueryEmissionProjectionRequest, opts ...grpc.CallOption) (*QueryEmissionProjectionResponse, error)
+	// MintingPause queries the kinds of minting paused by governance.
+	MintingPause(ctx context.Context, in *QueryMintingPauseRequest, opts ...grpc.CallOption) (*QueryMintingPauseResponse, error)
+	// ScheduledParamsChanges queries the params changes that are still to be
+	// applied, ordered by activation height.
+	ScheduledParamsChanges(ctx context.Context, in *QueryScheduledParamsChangesRequest, opts ...grpc.CallOption) (*QueryScheduledParamsChangesResponse, error)
+	// Inflation queries the current annual inflation rate of the mint denom,
+	// like the Query/Inflation RPC method of x/mint.
+	Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error)
+	// AnnualProvisions queries the current annual provisions of the mint denom,
+	// like the Query/AnnualProvisions RPC method of x/mint.
+	AnnualProvisions(ctx context.Context, in *QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualProvisionsResponse, error)
+	// Claimable queries the escrowed coins of a recipient, along with the coins
+	// that can be claimed.
+	Claimable(ctx context.Context, in *QueryClaimableRequest, opts ...grpc.CallOption) (*QueryClaimableResponse, error)
+	// EscrowSchedules queries the escrowed coins of every recipient.
+	EscrowSchedules(ctx context.Context, in *QueryEscrowSchedulesRequest, opts ...grpc.CallOption) (*QueryEscrowSchedulesResponse, error)
+	// MintRecipient queries the Hedgehog mints an address received and how
+	// much of them has vested.
+	MintRecipient(ctx context.Context, in *QueryMintRecipientRequest, opts ...grpc.CallOption) (*QueryMintRecipientResponse, error)
 }
 
 type queryClient struct {
@@ -71,6 +113,114 @@ func (c *queryClient) AllMintRecords(ctx context.Context, in *QueryAllMintRecord
 	return out, nil
 }
 
+func (c *queryClient) ProcessedMints(ctx context.Context, in *QueryProcessedMintsRequest, opts ...grpc.CallOption) (*QueryProcessedMintsResponse, error) {
+	out := new(QueryProcessedMintsResponse)
+	err := c.cc.Invoke(ctx, Query_ProcessedMints_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PreviousBlockTime(ctx context.Context, in *QueryPreviousBlockTimeRequest, opts ...grpc.CallOption) (*QueryPreviousBlockTimeResponse, error) {
+	out := new(QueryPreviousBlockTimeResponse)
+	err := c.cc.Invoke(ctx, Query_PreviousBlockTime_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Minter(ctx context.Context, in *QueryMinterRequest, opts ...grpc.CallOption) (*QueryMinterResponse, error) {
+	out := new(QueryMinterResponse)
+	err := c.cc.Invoke(ctx, Query_Minter_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SupplyHeadroom(ctx context.Context, in *QuerySupplyHeadroomRequest, opts ...grpc.CallOption) (*QuerySupplyHeadroomResponse, error) {
+	out := new(QuerySupplyHeadroomResponse)
+	err := c.cc.Invoke(ctx, Query_SupplyHeadroom_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EmissionProjection(ctx context.Context, in *QueryEmissionProjectionRequest, opts ...grpc.CallOption) (*QueryEmissionProjectionResponse, error) {
+	out := new(QueryEmissionProjectionResponse)
+	err := c.cc.Invoke(ctx, Query_EmissionProjection_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MintingPause(ctx context.Context, in *QueryMintingPauseRequest, opts ...grpc.CallOption) (*QueryMintingPauseResponse, error) {
+	out := new(QueryMintingPauseResponse)
+	err := c.cc.Invoke(ctx, Query_MintingPause_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ScheduledParamsChanges(ctx context.Context, in *QueryScheduledParamsChangesRequest, opts ...grpc.CallOption) (*QueryScheduledParamsChangesResponse, error) {
+	out := new(QueryScheduledParamsChangesResponse)
+	err := c.cc.Invoke(ctx, Query_ScheduledParamsChanges_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error) {
+	out := new(QueryInflationResponse)
+	err := c.cc.Invoke(ctx, Query_Inflation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AnnualProvisions(ctx context.Context, in *QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualProvisionsResponse, error) {
+	out := new(QueryAnnualProvisionsResponse)
+	err := c.cc.Invoke(ctx, Query_AnnualProvisions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Claimable(ctx context.Context, in *QueryClaimableRequest, opts ...grpc.CallOption) (*QueryClaimableResponse, error) {
+	out := new(QueryClaimableResponse)
+	err := c.cc.Invoke(ctx, Query_Claimable_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EscrowSchedules(ctx context.Context, in *QueryEscrowSchedulesRequest, opts ...grpc.CallOption) (*QueryEscrowSchedulesResponse, error) {
+	out := new(QueryEscrowSchedulesResponse)
+	err := c.cc.Invoke(ctx, Query_EscrowSchedules_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MintRecipient(ctx context.Context, in *QueryMintRecipientRequest, opts ...grpc.CallOption) (*QueryMintRecipientResponse, error) {
+	out := new(QueryMintRecipientResponse)
+	err := c.cc.Invoke(ctx, Query_MintRecipient_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -81,6 +231,36 @@ type QueryServer interface {
 	SubsidyHalvingInterval(context.Context, *QuerySubsidyHalvingIntervalRequest) (*QuerySubsidyHalvingIntervalResponse, error)
 	// AllMintRecords queries all mint records stored by the module.
 	AllMintRecords(context.Context, *QueryAllMintRecordsRequest) (*QueryAllMintRecordsResponse, error)
+	// ProcessedMints queries the Hedgehog mints that have already been paid out.
+	ProcessedMints(context.Context, *QueryProcessedMintsRequest) (*QueryProcessedMintsResponse, error)
+	// PreviousBlockTime queries the time of the last block the module has seen.
+	PreviousBlockTime(context.Context, *QueryPreviousBlockTimeRequest) (*QueryPreviousBlockTimeResponse, error)
+	// Minter queries the minting state, including the running emission totals.
+	Minter(context.Context, *QueryMinterRequest) (*QueryMinterResponse, error)
+	// SupplyHeadroom queries how much of every capped denom may still be minted.
+	SupplyHeadroom(context.Context, *QuerySupplyHeadroomRequest) (*QuerySupplyHeadroomResponse, error)
+	// EmissionProjection projects the block provisions and the supply of the
+	// mint denom over a range of future heights without changing any state.
+	EmissionProjection(context.Context, *QueryEmissionProjectionRequest) (*QueryEmissionProjectionResponse, error)
+	// MintingPause queries the kinds of minting paused by governance.
+	MintingPause(context.Context, *QueryMintingPauseRequest) (*QueryMintingPauseResponse, error)
+	// ScheduledParamsChanges queries the params changes that are still to be
+	// applied, ordered by activation height.
+	ScheduledParamsChanges(context.Context, *QueryScheduledParamsChangesRequest) (*QueryScheduledParamsChangesResponse, error)
+	// Inflation queries the current annual inflation rate of the mint denom,
+	// like the Query/Inflation RPC method of x/mint.
+	Inflation(context.Context, *QueryInflationRequest) (*QueryInflationResponse, error)
+	// AnnualProvisions queries the current annual provisions of the mint denom,
+	// like the Query/AnnualProvisions RPC method of x/mint.
+	AnnualProvisions(context.Context, *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error)
+	// Claimable queries the escrowed coins of a recipient, along with the coins
+	// that can be claimed.
+	Claimable(context.Context, *QueryClaimableRequest) (*QueryClaimableResponse, error)
+	// EscrowSchedules queries the escrowed coins of every recipient.
+	EscrowSchedules(context.Context, *QueryEscrowSchedulesRequest) (*QueryEscrowSchedulesResponse, error)
+	// MintRecipient queries the Hedgehog mints an address received and how
+	// much of them has vested.
+	MintRecipient(context.Context, *QueryMintRecipientRequest) (*QueryMintRecipientResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -97,6 +277,42 @@ func (UnimplementedQueryServer) SubsidyHalvingInterval(context.Context, *QuerySu
 func (UnimplementedQueryServer) AllMintRecords(context.Context, *QueryAllMintRecordsRequest) (*QueryAllMintRecordsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllMintRecords not implemented")
 }
+func (UnimplementedQueryServer) ProcessedMints(context.Context, *QueryProcessedMintsRequest) (*QueryProcessedMintsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProcessedMints not implemented")
+}
+func (UnimplementedQueryServer) PreviousBlockTime(context.Context, *QueryPreviousBlockTimeRequest) (*QueryPreviousBlockTimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviousBlockTime not implemented")
+}
+func (UnimplementedQueryServer) Minter(context.Context, *QueryMinterRequest) (*QueryMinterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Minter not implemented")
+}
+func (UnimplementedQueryServer) SupplyHeadroom(context.Context, *QuerySupplyHeadroomRequest) (*QuerySupplyHeadroomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplyHeadroom not implemented")
+}
+func (UnimplementedQueryServer) EmissionProjection(context.Context, *QueryEmissionProjectionRequest) (*QueryEmissionProjectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmissionProjection not implemented")
+}
+func (UnimplementedQueryServer) MintingPause(context.Context, *QueryMintingPauseRequest) (*QueryMintingPauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintingPause not implemented")
+}
+func (UnimplementedQueryServer) ScheduledParamsChanges(context.Context, *QueryScheduledParamsChangesRequest) (*QueryScheduledParamsChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledParamsChanges not implemented")
+}
+func (UnimplementedQueryServer) Inflation(context.Context, *QueryInflationRequest) (*QueryInflationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Inflation not implemented")
+}
+func (UnimplementedQueryServer) AnnualProvisions(context.Context, *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnnualProvisions not implemented")
+}
+func (UnimplementedQueryServer) Claimable(context.Context, *QueryClaimableRequest) (*QueryClaimableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Claimable not implemented")
+}
+func (UnimplementedQueryServer) EscrowSchedules(context.Context, *QueryEscrowSchedulesRequest) (*QueryEscrowSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EscrowSchedules not implemented")
+}
+func (UnimplementedQueryServer) MintRecipient(context.Context, *QueryMintRecipientRequest) (*QueryMintRecipientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintRecipient not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -164,6 +380,222 @@ func _Query_AllMintRecords_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ProcessedMints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProcessedMintsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProcessedMints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProcessedMints_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProcessedMints(ctx, req.(*QueryProcessedMintsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PreviousBlockTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPreviousBlockTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PreviousBlockTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PreviousBlockTime_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PreviousBlockTime(ctx, req.(*QueryPreviousBlockTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Minter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMinterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Minter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Minter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Minter(ctx, req.(*QueryMinterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SupplyHeadroom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySupplyHeadroomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SupplyHeadroom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SupplyHeadroom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SupplyHeadroom(ctx, req.(*QuerySupplyHeadroomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EmissionProjection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEmissionProjectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EmissionProjection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EmissionProjection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EmissionProjection(ctx, req.(*QueryEmissionProjectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MintingPause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintingPauseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MintingPause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MintingPause_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MintingPause(ctx, req.(*QueryMintingPauseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScheduledParamsChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledParamsChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledParamsChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ScheduledParamsChanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledParamsChanges(ctx, req.(*QueryScheduledParamsChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Inflation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInflationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Inflation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Inflation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Inflation(ctx, req.(*QueryInflationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AnnualProvisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAnnualProvisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AnnualProvisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AnnualProvisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AnnualProvisions(ctx, req.(*QueryAnnualProvisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Claimable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClaimableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Claimable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Claimable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Claimable(ctx, req.(*QueryClaimableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EscrowSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEscrowSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EscrowSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EscrowSchedules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EscrowSchedules(ctx, req.(*QueryEscrowSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MintRecipient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintRecipientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MintRecipient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MintRecipient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MintRecipient(ctx, req.(*QueryMintRecipientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -183,6 +615,54 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AllMintRecords",
 			Handler:    _Query_AllMintRecords_Handler,
 		},
+		{
+			MethodName: "ProcessedMints",
+			Handler:    _Query_ProcessedMints_Handler,
+		},
+		{
+			MethodName: "PreviousBlockTime",
+			Handler:    _Query_PreviousBlockTime_Handler,
+		},
+		{
+			MethodName: "Minter",
+			Handler:    _Query_Minter_Handler,
+		},
+		{
+			MethodName: "SupplyHeadroom",
+			Handler:    _Query_SupplyHeadroom_Handler,
+		},
+		{
+			MethodName: "EmissionProjection",
+			Handler:    _Query_EmissionProjection_Handler,
+		},
+		{
+			MethodName: "MintingPause",
+			Handler:    _Query_MintingPause_Handler,
+		},
+		{
+			MethodName: "ScheduledParamsChanges",
+			Handler:    _Query_ScheduledParamsChanges_Handler,
+		},
+		{
+			MethodName: "Inflation",
+			Handler:    _Query_Inflation_Handler,
+		},
+		{
+			MethodName: "AnnualProvisions",
+			Handler:    _Query_AnnualProvisions_Handler,
+		},
+		{
+			MethodName: "Claimable",
+			Handler:    _Query_Claimable_Handler,
+		},
+		{
+			MethodName: "EscrowSchedules",
+			Handler:    _Query_EscrowSchedules_Handler,
+		},
+		{
+			MethodName: "MintRecipient",
+			Handler:    _Query_MintRecipient_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/ugdmint/v1beta1/query.proto",
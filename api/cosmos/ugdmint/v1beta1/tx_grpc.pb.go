@@ -19,7 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_UpdateParams_FullMethodName = "/cosmos.ugdmint.v1beta1.Msg/UpdateParams"
+	Msg_UpdateParams_FullMethodName         = "/cosmos.ugdmint.v1beta1.Msg/UpdateParams"
+	Msg_SetMintingPaused_FullMethodName     = "/cosmos.ugdmint.v1beta1.Msg/SetMintingPaused"
+	Msg_ScheduleParamsChange_FullMethodName = "/cosmos.ugdmint.v1beta1.Msg/ScheduleParamsChange"
+	Msg_CancelParamsChange_FullMethodName   = "/cosmos.ugdmint.v1beta1.Msg/CancelParamsChange"
+	Msg_ClaimVested_FullMethodName          = "/cosmos.ugdmint.v1beta1.Msg/ClaimVested"
+	Msg_ClawbackMint_FullMethodName         = "/cosmos.ugdmint.v1beta1.Msg/ClawbackMint"
 )
 
 // MsgClient is the client API for Msg service.
@@ -31,6 +36,21 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SetMintingPaused defines a governance operation for pausing and resuming
+	// the block provisions and the Hedgehog mints.
+	SetMintingPaused(ctx context.Context, in *MsgSetMintingPaused, opts ...grpc.CallOption) (*MsgSetMintingPausedResponse, error)
+	// ScheduleParamsChange defines a governance operation for replacing the
+	// x/ugdmint module parameters at a future height.
+	ScheduleParamsChange(ctx context.Context, in *MsgScheduleParamsChange, opts ...grpc.CallOption) (*MsgScheduleParamsChangeResponse, error)
+	// CancelParamsChange defines a governance operation for cancelling a
+	// scheduled params change.
+	CancelParamsChange(ctx context.Context, in *MsgCancelParamsChange, opts ...grpc.CallOption) (*MsgCancelParamsChangeResponse, error)
+	// ClaimVested pays a recipient the escrowed coins of its Hedgehog mints
+	// that have unlocked.
+	ClaimVested(ctx context.Context, in *MsgClaimVested, opts ...grpc.CallOption) (*MsgClaimVestedResponse, error)
+	// ClawbackMint defines a governance operation for taking back the coins of
+	// a Hedgehog mint that have not vested yet.
+	ClawbackMint(ctx context.Context, in *MsgClawbackMint, opts ...grpc.CallOption) (*MsgClawbackMintResponse, error)
 }
 
 type msgClient struct {
@@ -50,6 +70,51 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) SetMintingPaused(ctx context.Context, in *MsgSetMintingPaused, opts ...grpc.CallOption) (*MsgSetMintingPausedResponse, error) {
+	out := new(MsgSetMintingPausedResponse)
+	err := c.cc.Invoke(ctx, Msg_SetMintingPaused_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ScheduleParamsChange(ctx context.Context, in *MsgScheduleParamsChange, opts ...grpc.CallOption) (*MsgScheduleParamsChangeResponse, error) {
+	out := new(MsgScheduleParamsChangeResponse)
+	err := c.cc.Invoke(ctx, Msg_ScheduleParamsChange_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelParamsChange(ctx context.Context, in *MsgCancelParamsChange, opts ...grpc.CallOption) (*MsgCancelParamsChangeResponse, error) {
+	out := new(MsgCancelParamsChangeResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelParamsChange_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimVested(ctx context.Context, in *MsgClaimVested, opts ...grpc.CallOption) (*MsgClaimVestedResponse, error) {
+	out := new(MsgClaimVestedResponse)
+	err := c.cc.Invoke(ctx, Msg_ClaimVested_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClawbackMint(ctx context.Context, in *MsgClawbackMint, opts ...grpc.CallOption) (*MsgClawbackMintResponse, error) {
+	out := new(MsgClawbackMintResponse)
+	err := c.cc.Invoke(ctx, Msg_ClawbackMint_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -59,6 +124,21 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SetMintingPaused defines a governance operation for pausing and resuming
+	// the block provisions and the Hedgehog mints.
+	SetMintingPaused(context.Context, *MsgSetMintingPaused) (*MsgSetMintingPausedResponse, error)
+	// ScheduleParamsChange defines a governance operation for replacing the
+	// x/ugdmint module parameters at a future height.
+	ScheduleParamsChange(context.Context, *MsgScheduleParamsChange) (*MsgScheduleParamsChangeResponse, error)
+	// CancelParamsChange defines a governance operation for cancelling a
+	// scheduled params change.
+	CancelParamsChange(context.Context, *MsgCancelParamsChange) (*MsgCancelParamsChangeResponse, error)
+	// ClaimVested pays a recipient the escrowed coins of its Hedgehog mints
+	// that have unlocked.
+	ClaimVested(context.Context, *MsgClaimVested) (*MsgClaimVestedResponse, error)
+	// ClawbackMint defines a governance operation for taking back the coins of
+	// a Hedgehog mint that have not vested yet.
+	ClawbackMint(context.Context, *MsgClawbackMint) (*MsgClawbackMintResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -69,6 +149,21 @@ type UnimplementedMsgServer struct {
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) SetMintingPaused(context.Context, *MsgSetMintingPaused) (*MsgSetMintingPausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMintingPaused not implemented")
+}
+func (UnimplementedMsgServer) ScheduleParamsChange(context.Context, *MsgScheduleParamsChange) (*MsgScheduleParamsChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleParamsChange not implemented")
+}
+func (UnimplementedMsgServer) CancelParamsChange(context.Context, *MsgCancelParamsChange) (*MsgCancelParamsChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelParamsChange not implemented")
+}
+func (UnimplementedMsgServer) ClaimVested(context.Context, *MsgClaimVested) (*MsgClaimVestedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimVested not implemented")
+}
+func (UnimplementedMsgServer) ClawbackMint(context.Context, *MsgClawbackMint) (*MsgClawbackMintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClawbackMint not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -100,6 +195,96 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetMintingPaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMintingPaused)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMintingPaused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetMintingPaused_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMintingPaused(ctx, req.(*MsgSetMintingPaused))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ScheduleParamsChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleParamsChange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleParamsChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ScheduleParamsChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleParamsChange(ctx, req.(*MsgScheduleParamsChange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelParamsChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelParamsChange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelParamsChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelParamsChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelParamsChange(ctx, req.(*MsgCancelParamsChange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimVested_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimVested)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimVested(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ClaimVested_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimVested(ctx, req.(*MsgClaimVested))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClawbackMint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClawbackMint)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClawbackMint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ClawbackMint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClawbackMint(ctx, req.(*MsgClawbackMint))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -111,6 +296,26 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "SetMintingPaused",
+			Handler:    _Msg_SetMintingPaused_Handler,
+		},
+		{
+			MethodName: "ScheduleParamsChange",
+			Handler:    _Msg_ScheduleParamsChange_Handler,
+		},
+		{
+			MethodName: "CancelParamsChange",
+			Handler:    _Msg_CancelParamsChange_Handler,
+		},
+		{
+			MethodName: "ClaimVested",
+			Handler:    _Msg_ClaimVested_Handler,
+		},
+		{
+			MethodName: "ClawbackMint",
+			Handler:    _Msg_ClawbackMint_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/ugdmint/v1beta1/tx.proto",
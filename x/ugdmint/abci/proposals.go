@@ -0,0 +1,195 @@
+package abci
+
+import (
+	"fmt"
+	"sort"
+
+	"cosmossdk.io/log"
+
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/unigrid-project/cosmos-ugdmint/x/ugdmint/keeper"
+	"github.com/unigrid-project/cosmos-ugdmint/x/ugdmint/types"
+)
+
+// ProposalHandler moves the Hedgehog mints voted on through vote extensions
+// into consensus state. The proposer injects the extended commit info of the
+// previous height as the first transaction of its proposal, every validator
+// verifies it in ProcessProposal, and the PreBlocker stores the mints that more
+// than 2/3 of the voting power agreed on so BeginBlocker can execute them.
+//
+// The injected commit info is not a transaction; FinalizeBlock reports it as a
+// failed transaction and moves on.
+type ProposalHandler struct {
+	logger          log.Logger
+	keeper          keeper.Keeper
+	valStore        baseapp.ValidatorStore
+	prepareProposal sdk.PrepareProposalHandler
+	processProposal sdk.ProcessProposalHandler
+}
+
+// NewProposalHandler returns a ProposalHandler wrapping the application's
+// regular prepare and process proposal handlers.
+func NewProposalHandler(
+	logger log.Logger,
+	k keeper.Keeper,
+	valStore baseapp.ValidatorStore,
+	prepareProposal sdk.PrepareProposalHandler,
+	processProposal sdk.ProcessProposalHandler,
+) *ProposalHandler {
+	return &ProposalHandler{
+		logger:          logger.With("module", "x/"+types.ModuleName),
+		keeper:          k,
+		valStore:        valStore,
+		prepareProposal: prepareProposal,
+		processProposal: processProposal,
+	}
+}
+
+// PrepareProposalHandler returns the handler that injects the extended commit
+// info of the previous height in front of the proposed transactions.
+func (h *ProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
+	return func(ctx sdk.Context, req *cmtabci.RequestPrepareProposal) (*cmtabci.ResponsePrepareProposal, error) {
+		if !voteExtensionsEnabled(ctx, req.Height) {
+			return h.prepareProposal(ctx, req)
+		}
+
+		if err := baseapp.ValidateVoteExtensions(ctx, h.valStore, req.Height, ctx.ChainID(), req.LocalLastCommit); err != nil {
+			return nil, fmt.Errorf("invalid vote extensions in local last commit: %w", err)
+		}
+
+		bz, err := req.LocalLastCommit.Marshal()
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal extended commit info: %w", err)
+		}
+
+		// leave room for the injected commit info
+		inner := *req
+		inner.MaxTxBytes -= int64(len(bz))
+
+		resp, err := h.prepareProposal(ctx, &inner)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Txs = append([][]byte{bz}, resp.Txs...)
+		return resp, nil
+	}
+}
+
+// ProcessProposalHandler returns the handler that rejects proposals whose
+// injected commit info is missing or carries invalid vote extensions.
+func (h *ProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
+	return func(ctx sdk.Context, req *cmtabci.RequestProcessProposal) (*cmtabci.ResponseProcessProposal, error) {
+		if !voteExtensionsEnabled(ctx, req.Height) {
+			return h.processProposal(ctx, req)
+		}
+
+		if len(req.Txs) == 0 {
+			h.logger.Error("rejecting proposal without extended commit info", "height", req.Height)
+			return &cmtabci.ResponseProcessProposal{Status: cmtabci.ResponseProcessProposal_REJECT}, nil
+		}
+
+		var extCommit cmtabci.ExtendedCommitInfo
+		if err := extCommit.Unmarshal(req.Txs[0]); err != nil {
+			h.logger.Error("rejecting proposal with undecodable extended commit info", "height", req.Height, "err", err)
+			return &cmtabci.ResponseProcessProposal{Status: cmtabci.ResponseProcessProposal_REJECT}, nil
+		}
+
+		if err := baseapp.ValidateVoteExtensions(ctx, h.valStore, req.Height, ctx.ChainID(), extCommit); err != nil {
+			h.logger.Error("rejecting proposal with invalid vote extensions", "height", req.Height, "err", err)
+			return &cmtabci.ResponseProcessProposal{Status: cmtabci.ResponseProcessProposal_REJECT}, nil
+		}
+
+		inner := *req
+		inner.Txs = req.Txs[1:]
+
+		return h.processProposal(ctx, &inner)
+	}
+}
+
+// PreBlocker returns a PreBlocker that stores the agreed mints of the block
+// before calling next, which is typically the module manager's PreBlocker.
+func (h *ProposalHandler) PreBlocker(next sdk.PreBlocker) sdk.PreBlocker {
+	return func(ctx sdk.Context, req *cmtabci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+		if voteExtensionsEnabled(ctx, req.Height) && len(req.Txs) > 0 {
+			var extCommit cmtabci.ExtendedCommitInfo
+			if err := extCommit.Unmarshal(req.Txs[0]); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal extended commit info: %w", err)
+			}
+
+			agreed := AggregateMints(extCommit, req.Height)
+			if len(agreed.Mints) > 0 {
+				h.keeper.SetAgreedMints(ctx, agreed)
+			}
+		}
+
+		if next == nil {
+			return &sdk.ResponsePreBlock{}, nil
+		}
+		return next(ctx, req)
+	}
+}
+
+// AggregateMints tallies the mint vote extensions of a commit and returns the
+// mints for height that are backed by more than 2/3 of the total voting power.
+// Vote extensions that fail to decode are counted as empty votes. Validators
+// may have read a mint from different Hedgehog payloads, so the payload
+// timestamp is not part of the vote; the earliest timestamp reported by the
+// supporting validators is kept. The result is sorted so that every node
+// executes the mints in the same order.
+func AggregateMints(extCommit cmtabci.ExtendedCommitInfo, height int64) types.AgreedMints {
+	var totalPower int64
+	power := make(map[types.HedgehogMint]int64)
+	timestamps := make(map[types.HedgehogMint]string)
+
+	for _, vote := range extCommit.Votes {
+		totalPower += vote.Validator.Power
+
+		if vote.BlockIdFlag != cmtproto.BlockIDFlagCommit || len(vote.VoteExtension) == 0 {
+			continue
+		}
+
+		ve, err := decodeVoteExtension(vote.VoteExtension, height)
+		if err != nil {
+			continue
+		}
+
+		for _, mint := range ve.Mints {
+			timestamp := mint.Timestamp
+			mint.Timestamp = ""
+
+			power[mint] += vote.Validator.Power
+			if earliest, ok := timestamps[mint]; !ok || timestamp < earliest {
+				timestamps[mint] = timestamp
+			}
+		}
+	}
+
+	agreed := types.AgreedMints{Height: height}
+	for mint, p := range power {
+		if p*3 > totalPower*2 {
+			mint.Timestamp = timestamps[mint]
+			agreed.Mints = append(agreed.Mints, mint)
+		}
+	}
+
+	sort.Slice(agreed.Mints, func(i, j int) bool {
+		a, b := agreed.Mints[i], agreed.Mints[j]
+		if a.Address != b.Address {
+			return a.Address < b.Address
+		}
+		return a.Amount < b.Amount
+	})
+
+	return agreed
+}
+
+// voteExtensionsEnabled reports whether the proposal for height carries the
+// vote extensions of the previous height.
+func voteExtensionsEnabled(ctx sdk.Context, height int64) bool {
+	cp := ctx.ConsensusParams()
+	return cp.Abci != nil && cp.Abci.VoteExtensionsEnableHeight != 0 && height > cp.Abci.VoteExtensionsEnableHeight
+}
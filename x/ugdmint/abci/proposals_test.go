@@ -0,0 +1,91 @@
+package abci
+
+import (
+	"testing"
+
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/unigrid-project/cosmos-ugdmint/x/ugdmint/types"
+)
+
+func voteWithMints(t *testing.T, power int64, height int64, mints ...types.HedgehogMint) cmtabci.ExtendedVoteInfo {
+	ve := types.MintVoteExtension{Height: height, Mints: mints}
+	bz, err := ve.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return cmtabci.ExtendedVoteInfo{
+		Validator:     cmtabci.Validator{Power: power},
+		VoteExtension: bz,
+		BlockIdFlag:   cmtproto.BlockIDFlagCommit,
+	}
+}
+
+func TestAggregateMints(t *testing.T) {
+	const height = 42
+
+	alice := types.HedgehogMint{Address: sdk.AccAddress("alice_______________").String(), Height: height, Amount: 100}
+	bob := types.HedgehogMint{Address: sdk.AccAddress("bob_________________").String(), Height: height, Amount: 200}
+	bobTampered := bob
+	bobTampered.Amount = 2000
+	aliceLater := alice
+	aliceLater.Timestamp = "2023-06-16T19:03:33.104Z"
+	alice.Timestamp = "2023-06-16T19:03:01.836Z"
+
+	extCommit := cmtabci.ExtendedCommitInfo{
+		Votes: []cmtabci.ExtendedVoteInfo{
+			voteWithMints(t, 25, height, bob, alice),
+			voteWithMints(t, 25, height, alice, bob),
+			voteWithMints(t, 25, height, aliceLater, bobTampered),
+			{
+				Validator:     cmtabci.Validator{Power: 25},
+				VoteExtension: []byte("not a vote extension"),
+				BlockIdFlag:   cmtproto.BlockIDFlagCommit,
+			},
+		},
+	}
+
+	agreed := AggregateMints(extCommit, height)
+	if agreed.Height != height {
+		t.Fatalf("expected agreed mints for height %d, got %d", height, agreed.Height)
+	}
+	if len(agreed.Mints) != 1 || agreed.Mints[0] != alice {
+		t.Fatalf("expected only the mint backed by 75%% of the power to be agreed with its earliest timestamp, got %v", agreed.Mints)
+	}
+
+	// votes for another height never count
+	if agreed := AggregateMints(extCommit, height+1); len(agreed.Mints) != 0 {
+		t.Fatalf("expected no agreed mints for height %d, got %v", height+1, agreed.Mints)
+	}
+
+	// absent validators still count towards the total voting power
+	extCommit.Votes[2].BlockIdFlag = cmtproto.BlockIDFlagAbsent
+	if agreed := AggregateMints(extCommit, height); len(agreed.Mints) != 0 {
+		t.Fatalf("expected no agreed mints with only 50%% of the power voting, got %v", agreed.Mints)
+	}
+}
+
+func TestAggregateMintsIsSorted(t *testing.T) {
+	const height = 7
+
+	var mints []types.HedgehogMint
+	for _, name := range []string{"carol", "alice", "bob"} {
+		mints = append(mints, types.HedgehogMint{Address: sdk.AccAddress(name).String(), Height: height, Amount: 1})
+	}
+
+	extCommit := cmtabci.ExtendedCommitInfo{
+		Votes: []cmtabci.ExtendedVoteInfo{voteWithMints(t, 10, height, mints...)},
+	}
+
+	agreed := AggregateMints(extCommit, height)
+	if len(agreed.Mints) != len(mints) {
+		t.Fatalf("expected %d agreed mints, got %d", len(mints), len(agreed.Mints))
+	}
+	for i := 1; i < len(agreed.Mints); i++ {
+		if agreed.Mints[i-1].Address > agreed.Mints[i].Address {
+			t.Fatalf("agreed mints are not sorted: %v", agreed.Mints)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package abci
+
+import (
+	"fmt"
+
+	"cosmossdk.io/log"
+
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/unigrid-project/cosmos-ugdmint/x/ugdmint/keeper"
+	"github.com/unigrid-project/cosmos-ugdmint/x/ugdmint/types"
+)
+
+// VoteExtensionHandler lets validators vote on the Hedgehog mints they observed.
+// Every validator attaches the mints it sees for the next block height to its
+// pre-commit, so that the proposer of that height can aggregate them.
+type VoteExtensionHandler struct {
+	logger log.Logger
+	keeper keeper.Keeper
+}
+
+// NewVoteExtensionHandler returns a new VoteExtensionHandler that reads the
+// observed mints from the keeper's MintSource.
+func NewVoteExtensionHandler(logger log.Logger, k keeper.Keeper) *VoteExtensionHandler {
+	return &VoteExtensionHandler{
+		logger: logger.With("module", "x/"+types.ModuleName),
+		keeper: k,
+	}
+}
+
+// ExtendVoteHandler returns the handler that puts the locally observed
+// Hedgehog mints for the next height into the vote extension.
+func (h *VoteExtensionHandler) ExtendVoteHandler() sdk.ExtendVoteHandler {
+	return func(ctx sdk.Context, req *cmtabci.RequestExtendVote) (*cmtabci.ResponseExtendVote, error) {
+		ve := types.MintVoteExtension{Height: req.Height + 1}
+
+		// An empty vote extension is a valid vote: the validator simply did not
+		// observe any mint for the next height.
+		if source := h.keeper.MintSource(); source != nil {
+			mints, _ := source.Read(uint64(ve.Height))
+			seen := make(map[string]bool, len(mints))
+			for _, mint := range mints {
+				hm := types.HedgehogMint{
+					Address:   mint.Address,
+					Height:    uint64(ve.Height),
+					Amount:    int64(mint.Amount),
+					Timestamp: mint.Timestamp,
+				}
+
+				// An invalid mint is dropped on its own, so that a single bad
+				// entry does not keep the other mints of the height from
+				// being voted on
+				if err := hm.Validate(); err != nil {
+					h.logger.Error("dropping invalid hedgehog mint from vote extension", "height", ve.Height, "err", err)
+					continue
+				}
+				if seen[hm.Key()] || h.keeper.HasProcessedMint(ctx, hm.Key()) {
+					continue
+				}
+				if len(ve.Mints) == types.MaxMintsPerVoteExtension {
+					h.logger.Error("dropping hedgehog mints in excess of the vote extension limit", "height", ve.Height, "limit", types.MaxMintsPerVoteExtension)
+					break
+				}
+				seen[hm.Key()] = true
+				ve.Mints = append(ve.Mints, hm)
+			}
+		}
+
+		bz, err := ve.Marshal()
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal mint vote extension: %w", err)
+		}
+
+		return &cmtabci.ResponseExtendVote{VoteExtension: bz}, nil
+	}
+}
+
+// VerifyVoteExtensionHandler returns the handler that rejects vote extensions
+// which cannot be decoded or which list invalid mints.
+func (h *VoteExtensionHandler) VerifyVoteExtensionHandler() sdk.VerifyVoteExtensionHandler {
+	return func(ctx sdk.Context, req *cmtabci.RequestVerifyVoteExtension) (*cmtabci.ResponseVerifyVoteExtension, error) {
+		if _, err := decodeVoteExtension(req.VoteExtension, req.Height+1); err != nil {
+			h.logger.Error("rejecting mint vote extension", "height", req.Height, "validator", fmt.Sprintf("%X", req.ValidatorAddress), "err", err)
+			return &cmtabci.ResponseVerifyVoteExtension{Status: cmtabci.ResponseVerifyVoteExtension_REJECT}, nil
+		}
+
+		return &cmtabci.ResponseVerifyVoteExtension{Status: cmtabci.ResponseVerifyVoteExtension_ACCEPT}, nil
+	}
+}
+
+// decodeVoteExtension decodes and validates a mint vote extension that is
+// expected to carry the mints for the given height.
+func decodeVoteExtension(bz []byte, height int64) (types.MintVoteExtension, error) {
+	var ve types.MintVoteExtension
+	if err := ve.Unmarshal(bz); err != nil {
+		return ve, fmt.Errorf("failed to unmarshal mint vote extension: %w", err)
+	}
+	if ve.Height != height {
+		return ve, fmt.Errorf("vote extension is for height %d, expected %d", ve.Height, height)
+	}
+	if err := ve.Validate(); err != nil {
+		return ve, err
+	}
+
+	return ve, nil
+}
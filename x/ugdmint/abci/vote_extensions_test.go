@@ -0,0 +1,74 @@
+package abci
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/unigrid-project/cosmos-ugdmint/x/ugdmint/keeper"
+	"github.com/unigrid-project/cosmos-ugdmint/x/ugdmint/types"
+)
+
+// accountKeeper knows the module addresses the keeper asks for on
+// construction; every other method panics.
+type accountKeeper struct {
+	types.AccountKeeper
+}
+
+func (accountKeeper) GetModuleAddress(name string) sdk.AccAddress {
+	return authtypes.NewModuleAddress(name)
+}
+
+func TestExtendVoteSkipsInvalidMints(t *testing.T) {
+	const height = 42
+
+	alice := sdk.AccAddress("alice_______________").String()
+	bob := sdk.AccAddress("bob_________________").String()
+	carol := sdk.AccAddress("carol_______________").String()
+	source := types.NewStaticMintSource(
+		types.Mint{Address: alice, Height: height, Amount: 100},
+		types.Mint{Address: "not an address", Height: height, Amount: 100},
+		types.Mint{Address: bob, Height: height, Amount: 0},
+		types.Mint{Address: bob, Height: height, Amount: -5},
+		types.Mint{Address: carol, Height: height, Amount: 300},
+		types.Mint{Address: carol, Height: height, Amount: 300},
+	)
+
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	k := keeper.NewKeeper(
+		codec.NewProtoCodec(codectypes.NewInterfaceRegistry()),
+		runtime.NewKVStoreService(key),
+		nil,
+		accountKeeper{},
+		nil,
+		nil,
+		source,
+		authtypes.FeeCollectorName,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	res, err := NewVoteExtensionHandler(log.NewNopLogger(), k).ExtendVoteHandler()(testCtx.Ctx, &cmtabci.RequestExtendVote{Height: height - 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ve, err := decodeVoteExtension(res.VoteExtension, height)
+	if err != nil {
+		t.Fatalf("the vote extension does not verify: %v", err)
+	}
+
+	// the invalid mints are dropped one by one, the valid ones are voted on
+	// once
+	if len(ve.Mints) != 2 || ve.Mints[0].Address != alice || ve.Mints[1].Address != carol {
+		t.Fatalf("expected the mints of alice and carol, got %v", ve.Mints)
+	}
+}
@@ -0,0 +1,30 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+
+	"github.com/unigrid-project/cosmos-ugdmint/x/ugdmint/types"
+)
+
+// GetClientCmd returns the commands of this module that run offline, without
+// connecting to a node. They are neither queries nor transactions, so apps add
+// the command to their root command next to the query and tx commands.
+func GetClientCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Offline commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetSimulateEmissionCmd(),
+	)
+
+	return cmd
+}
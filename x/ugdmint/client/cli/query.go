@@ -26,6 +26,18 @@ func GetQueryCmd() *cobra.Command {
 		CmdQueryParams(),
 		cmdQuerySubsidyHalvingInterval(),
 		cmdQueryMints(),
+		cmdQueryProcessedMints(),
+		cmdQueryPreviousBlockTime(),
+		cmdQuerySupplyHeadroom(),
+		cmdQueryMinter(),
+		cmdQueryEmissionProjection(),
+		cmdQueryMintingPause(),
+		cmdQueryScheduledParamsChanges(),
+		cmdQueryInflation(),
+		cmdQueryAnnualProvisions(),
+		cmdQueryClaimable(),
+		cmdQueryEscrowSchedules(),
+		cmdQueryMintRecipient(),
 	)
 	// this line is used by starport scaffolding # 1
 
@@ -0,0 +1,36 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"github.com/unigrid-project/cosmos-ugdmint/x/ugdmint/types"
+)
+
+func cmdQueryAnnualProvisions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "annual-provisions",
+		Short: "Query the current annual provisions of the mint denom",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAnnualProvisionsRequest{}
+			res, err := queryClient.AnnualProvisions(cmd.Context(), params)
+
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,36 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"github.com/unigrid-project/cosmos-ugdmint/x/ugdmint/types"
+)
+
+func cmdQueryClaimable() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claimable [address]",
+		Short: "Query the escrowed coins of a recipient and the coins it can claim",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryClaimableRequest{Address: args[0]}
+			res, err := queryClient.Claimable(cmd.Context(), params)
+
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
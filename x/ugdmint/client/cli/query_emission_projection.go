@@ -0,0 +1,83 @@
+package cli
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"github.com/unigrid-project/cosmos-ugdmint/x/ugdmint/types"
+)
+
+const (
+	flagStartHeight = "start-height"
+	flagEndHeight   = "end-height"
+	flagStartTime   = "start-time"
+	flagEndTime     = "end-time"
+	flagBlockTime   = "block-time"
+)
+
+func cmdQueryEmissionProjection() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "emission-projection",
+		Short: "Project the block provisions and the supply of the mint denom over a range of heights or times",
+		Example: "emission-projection --end-height 10000000\n" +
+			"emission-projection --start-time 2027-01-01T00:00:00Z --end-time 2028-01-01T00:00:00Z --block-time 5",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryEmissionProjectionRequest{}
+			if params.StartHeight, err = cmd.Flags().GetUint64(flagStartHeight); err != nil {
+				return err
+			}
+			if params.EndHeight, err = cmd.Flags().GetUint64(flagEndHeight); err != nil {
+				return err
+			}
+			if params.BlockTimeSeconds, err = cmd.Flags().GetUint64(flagBlockTime); err != nil {
+				return err
+			}
+			if params.StartTime, err = readTimeFlag(cmd, flagStartTime); err != nil {
+				return err
+			}
+			if params.EndTime, err = readTimeFlag(cmd, flagEndTime); err != nil {
+				return err
+			}
+
+			res, err := queryClient.EmissionProjection(cmd.Context(), params)
+
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64(flagStartHeight, 0, "First projected height, defaults to the next block")
+	cmd.Flags().Uint64(flagEndHeight, 0, "Last projected height")
+	cmd.Flags().String(flagStartTime, "", "Project from the first block produced at or after this RFC 3339 time")
+	cmd.Flags().String(flagEndTime, "", "Project up to the last block produced at or before this RFC 3339 time")
+	cmd.Flags().Uint64(flagBlockTime, 0, "Assumed seconds between two blocks, defaults to the block time the params are calibrated for")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// readTimeFlag returns the RFC 3339 time of flag, or nil when it is unset.
+func readTimeFlag(cmd *cobra.Command, flag string) (*time.Time, error) {
+	value, err := cmd.Flags().GetString(flag)
+	if err != nil || value == "" {
+		return nil, err
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
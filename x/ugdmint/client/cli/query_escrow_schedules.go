@@ -0,0 +1,42 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"github.com/unigrid-project/cosmos-ugdmint/x/ugdmint/types"
+)
+
+func cmdQueryEscrowSchedules() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "escrow-schedules",
+		Short: "Query the escrowed coins of every recipient",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryEscrowSchedulesRequest{Pagination: pageReq}
+			res, err := queryClient.EscrowSchedules(cmd.Context(), params)
+
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "escrow-schedules")
+
+	return cmd
+}
@@ -0,0 +1,421 @@
+package cli
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/spf13/cobra"
+	"github.com/unigrid-project/cosmos-ugdmint/x/ugdmint/types"
+)
+
+const (
+	flagParams        = "params"
+	flagJitter        = "jitter"
+	flagSeed          = "seed"
+	flagBlockTimesCSV = "block-times-csv"
+	flagGroupBy       = "group-by"
+	flagFormat        = "format"
+
+	groupByBlock = "block"
+	groupByDay   = "day"
+
+	formatTable = "table"
+	formatJSON  = "json"
+	formatCSV   = "csv"
+
+	secondsPerDay = 24 * 60 * 60
+
+	// tableCellWidth is the minimum width of a column of the table format,
+	// wide enough for the cumulative provisions of a long simulation
+	tableCellWidth = 20
+)
+
+// GetSimulateEmissionCmd returns the command that simulates the emission of the
+// module offline. It does not query a node, so it is not part of the query
+// commands but of the offline commands of GetClientCmd.
+func GetSimulateEmissionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-emission",
+		Short: "Simulate the block provisions of a range of heights offline",
+		Long: `Simulate the block provisions of a range of heights by running the block provision
+of the module locally, block by block, without connecting to a node.
+
+The params are read from a JSON file in the format of the params query, or the default
+params are used. Every block takes --block-time seconds, varied by up to --jitter seconds
+either way, or the block times are replayed from a CSV file of real block timestamps, one
+block per row with the timestamp, in RFC 3339 or unix seconds, in the last column. The
+replayed block times repeat when the simulation outlasts the file.
+
+The max_supply param and Hedgehog mints are not part of the simulation.`,
+		Example: "simulate-emission --params params.json --start-height 1 --end-height 100000 --group-by day\n" +
+			"simulate-emission --end-height 100000 --block-time 5 --jitter 2 --seed 7 --format csv\n" +
+			"simulate-emission --end-height 100000 --block-times-csv block_times.csv --format json",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			params, err := readSimulationParams(cmd)
+			if err != nil {
+				return err
+			}
+
+			startHeight, err := cmd.Flags().GetUint64(flagStartHeight)
+			if err != nil {
+				return err
+			}
+			endHeight, err := cmd.Flags().GetUint64(flagEndHeight)
+			if err != nil {
+				return err
+			}
+			if startHeight == 0 {
+				return fmt.Errorf("start height must be positive")
+			}
+
+			blockTime, err := readBlockTimeModel(cmd, params)
+			if err != nil {
+				return err
+			}
+
+			groupBy, err := cmd.Flags().GetString(flagGroupBy)
+			if err != nil {
+				return err
+			}
+			format, err := cmd.Flags().GetString(flagFormat)
+			if err != nil {
+				return err
+			}
+
+			var header []string
+			switch groupBy {
+			case groupByBlock:
+				header = []string{"height", "block_time_seconds", "elapsed_seconds", "provision", "cumulative"}
+			case groupByDay:
+				header = []string{"day", "first_height", "last_height", "blocks", "provisions", "cumulative"}
+			default:
+				return fmt.Errorf("unknown grouping %s, expected %s or %s", groupBy, groupByBlock, groupByDay)
+			}
+
+			// Simulations of long ranges have millions of blocks, so every row
+			// is written as soon as it is complete
+			out, err := newSimulationWriter(cmd.OutOrStdout(), format, header)
+			if err != nil {
+				return err
+			}
+
+			var day *simulationDay
+			err = types.DefaultInitialMinter().SimulateEmission(params, startHeight, endHeight, blockTime, func(block types.SimulatedBlock) error {
+				if groupBy == groupByBlock {
+					return out.writeRow(simulationRow{
+						"height":             block.Height,
+						"block_time_seconds": block.BlockTimeSeconds,
+						"elapsed_seconds":    block.ElapsedSeconds,
+						"provision":          block.Provision,
+						"cumulative":         block.Cumulative,
+					})
+				}
+
+				// the first day starts with the first simulated block
+				n := block.ElapsedSeconds/secondsPerDay + 1
+				if day != nil && day.day != n {
+					if err := out.writeRow(day.row()); err != nil {
+						return err
+					}
+					day = nil
+				}
+				if day == nil {
+					day = &simulationDay{day: n, firstHeight: block.Height, provisions: math.ZeroInt()}
+				}
+				day.add(block)
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			if day != nil {
+				if err := out.writeRow(day.row()); err != nil {
+					return err
+				}
+			}
+
+			return out.close()
+		},
+	}
+
+	cmd.Flags().String(flagParams, "", "JSON file holding the params to simulate, defaults to the default params")
+	cmd.Flags().Uint64(flagStartHeight, 1, "First simulated height")
+	cmd.Flags().Uint64(flagEndHeight, 0, "Last simulated height")
+	cmd.Flags().Uint64(flagBlockTime, 0, "Seconds between two blocks, defaults to the block time the params are calibrated for")
+	cmd.Flags().Uint64(flagJitter, 0, "Maximum number of seconds every block time is randomly varied by")
+	cmd.Flags().Int64(flagSeed, 1, "Seed of the random block time jitter")
+	cmd.Flags().String(flagBlockTimesCSV, "", "CSV file of block timestamps to replay the block times of")
+	cmd.Flags().String(flagGroupBy, groupByBlock, "Print the totals of every block or of every simulated day (block|day)")
+	cmd.Flags().String(flagFormat, formatTable, "Output format (table|json|csv)")
+	cmd.MarkFlagsMutuallyExclusive(flagBlockTimesCSV, flagJitter)
+	cmd.MarkFlagsMutuallyExclusive(flagBlockTimesCSV, flagBlockTime)
+
+	return cmd
+}
+
+// simulationRow is a row of the simulation output keyed by column.
+type simulationRow map[string]interface{}
+
+// simulationDay adds up the simulated blocks of a day.
+type simulationDay struct {
+	day         int64
+	firstHeight uint64
+	lastHeight  uint64
+	blocks      uint64
+	provisions  math.Int
+	cumulative  math.Int
+}
+
+func (d *simulationDay) add(block types.SimulatedBlock) {
+	d.lastHeight = block.Height
+	d.blocks++
+	d.provisions = d.provisions.Add(block.Provision)
+	d.cumulative = block.Cumulative
+}
+
+func (d *simulationDay) row() simulationRow {
+	return simulationRow{
+		"day":          d.day,
+		"first_height": d.firstHeight,
+		"last_height":  d.lastHeight,
+		"blocks":       d.blocks,
+		"provisions":   d.provisions,
+		"cumulative":   d.cumulative,
+	}
+}
+
+// readSimulationParams returns the params of the params flag, or the default
+// params when it is unset.
+func readSimulationParams(cmd *cobra.Command) (types.Params, error) {
+	path, err := cmd.Flags().GetString(flagParams)
+	if err != nil || path == "" {
+		return types.DefaultParams(), err
+	}
+
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return types.Params{}, err
+	}
+
+	// accept the output of the params query as well as bare params
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	var res types.QueryParamsResponse
+	if err := cdc.UnmarshalJSON(bz, &res); err != nil || res.Params.MintDenom == "" {
+		if err := cdc.UnmarshalJSON(bz, &res.Params); err != nil {
+			return types.Params{}, fmt.Errorf("failed to parse params file %s: %w", path, err)
+		}
+	}
+
+	if err := res.Params.Validate(); err != nil {
+		return types.Params{}, fmt.Errorf("invalid params in %s: %w", path, err)
+	}
+	return res.Params, nil
+}
+
+// readBlockTimeModel returns the seconds between a height and the previous
+// block according to the block time flags.
+func readBlockTimeModel(cmd *cobra.Command, params types.Params) (func(uint64) int64, error) {
+	path, err := cmd.Flags().GetString(flagBlockTimesCSV)
+	if err != nil {
+		return nil, err
+	}
+	if path != "" {
+		deltas, err := readBlockTimesCSV(path)
+		if err != nil {
+			return nil, err
+		}
+
+		i := 0
+		return func(uint64) int64 {
+			delta := deltas[i%len(deltas)]
+			i++
+			return delta
+		}, nil
+	}
+
+	blockTime, err := cmd.Flags().GetUint64(flagBlockTime)
+	if err != nil {
+		return nil, err
+	}
+	if blockTime == 0 {
+		blockTime = types.DefaultBlockTimeSeconds(params)
+	}
+	jitter, err := cmd.Flags().GetUint64(flagJitter)
+	if err != nil {
+		return nil, err
+	}
+	if jitter == 0 {
+		return func(uint64) int64 { return int64(blockTime) }, nil
+	}
+
+	seed, err := cmd.Flags().GetInt64(flagSeed)
+	if err != nil {
+		return nil, err
+	}
+	rng := rand.New(rand.NewSource(seed))
+	return func(uint64) int64 {
+		return int64(blockTime) - int64(jitter) + rng.Int63n(2*int64(jitter)+1)
+	}, nil
+}
+
+// readBlockTimesCSV returns the seconds between the consecutive block
+// timestamps in the last column of a CSV file. Rows whose timestamp does not
+// parse, such as a header, are skipped.
+func readBlockTimesCSV(path string) ([]int64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	reader := csv.NewReader(f)
+	reader.FieldsPerRecord = -1
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read block times from %s: %w", path, err)
+	}
+
+	var times []int64
+	for _, record := range records {
+		if len(record) == 0 {
+			continue
+		}
+		value := strings.TrimSpace(record[len(record)-1])
+		if t, err := time.Parse(time.RFC3339Nano, value); err == nil {
+			times = append(times, t.Unix())
+		} else if unix, err := strconv.ParseInt(value, 10, 64); err == nil {
+			times = append(times, unix)
+		}
+	}
+	if len(times) < 2 {
+		return nil, fmt.Errorf("%s must hold at least two block timestamps", path)
+	}
+
+	deltas := make([]int64, len(times)-1)
+	for i := range deltas {
+		deltas[i] = times[i+1] - times[i]
+	}
+	return deltas, nil
+}
+
+// simulationWriter writes the rows of a simulation as they are produced.
+type simulationWriter interface {
+	writeRow(row simulationRow) error
+	close() error
+}
+
+// newSimulationWriter returns a writer of rows in format, with the columns of
+// header, that writes to w.
+func newSimulationWriter(w io.Writer, format string, header []string) (simulationWriter, error) {
+	switch format {
+	case formatJSON:
+		return &jsonSimulationWriter{w: w}, nil
+
+	case formatCSV:
+		writer := csv.NewWriter(w)
+		if err := writer.Write(header); err != nil {
+			return nil, err
+		}
+		return &csvSimulationWriter{writer: writer, header: header}, nil
+
+	case formatTable:
+		// Every row is flushed on its own, the minimum cell width keeps the
+		// columns aligned across rows
+		writer := tabwriter.NewWriter(w, tableCellWidth, 0, 2, ' ', tabwriter.AlignRight)
+		table := &tableSimulationWriter{writer: writer, header: header}
+		if err := table.writeLine(header); err != nil {
+			return nil, err
+		}
+		return table, nil
+	}
+
+	return nil, fmt.Errorf("unknown format %s, expected %s, %s or %s", format, formatTable, formatJSON, formatCSV)
+}
+
+// jsonSimulationWriter writes the rows as the elements of a JSON array.
+type jsonSimulationWriter struct {
+	w    io.Writer
+	rows int
+}
+
+func (j *jsonSimulationWriter) writeRow(row simulationRow) error {
+	bz, err := json.MarshalIndent(row, "  ", "  ")
+	if err != nil {
+		return err
+	}
+
+	separator := ",\n  "
+	if j.rows == 0 {
+		separator = "[\n  "
+	}
+	j.rows++
+	_, err = fmt.Fprintf(j.w, "%s%s", separator, bz)
+	return err
+}
+
+func (j *jsonSimulationWriter) close() error {
+	if j.rows == 0 {
+		_, err := fmt.Fprintln(j.w, "[]")
+		return err
+	}
+	_, err := fmt.Fprintln(j.w, "\n]")
+	return err
+}
+
+// csvSimulationWriter writes the rows as CSV records.
+type csvSimulationWriter struct {
+	writer *csv.Writer
+	header []string
+}
+
+func (c *csvSimulationWriter) writeRow(row simulationRow) error {
+	return c.writer.Write(row.values(c.header))
+}
+
+func (c *csvSimulationWriter) close() error {
+	c.writer.Flush()
+	return c.writer.Error()
+}
+
+// tableSimulationWriter writes the rows as a table of right aligned columns.
+type tableSimulationWriter struct {
+	writer *tabwriter.Writer
+	header []string
+}
+
+func (t *tableSimulationWriter) writeRow(row simulationRow) error {
+	return t.writeLine(row.values(t.header))
+}
+
+func (t *tableSimulationWriter) writeLine(values []string) error {
+	if _, err := fmt.Fprintln(t.writer, strings.Join(values, "\t")+"\t"); err != nil {
+		return err
+	}
+	return t.writer.Flush()
+}
+
+func (t *tableSimulationWriter) close() error {
+	return t.writer.Flush()
+}
+
+// values returns the values of the columns of header.
+func (r simulationRow) values(header []string) []string {
+	values := make([]string, len(header))
+	for i, column := range header {
+		values[i] = fmt.Sprint(r[column])
+	}
+	return values
+}
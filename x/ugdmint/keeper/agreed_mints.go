@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"context"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/unigrid-project/cosmos-ugdmint/x/ugdmint/types"
+)
+
+// SetAgreedMints stores the Hedgehog mints that the validator set agreed on
+// for a block height.
+func (k Keeper) SetAgreedMints(ctx context.Context, agreed types.AgreedMints) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	b := k.cdc.MustMarshal(&agreed)
+	store.Set(types.AgreedMintsKey(agreed.Height), b)
+}
+
+// GetAgreedMints returns the Hedgehog mints agreed on for a block height.
+func (k Keeper) GetAgreedMints(ctx context.Context, height int64) (agreed types.AgreedMints, found bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	b := store.Get(types.AgreedMintsKey(height))
+	if b == nil {
+		return agreed, false
+	}
+
+	k.cdc.MustUnmarshal(b, &agreed)
+	return agreed, true
+}
+
+// DeleteAgreedMints removes the agreed mints of a block height once they have
+// been executed.
+func (k Keeper) DeleteAgreedMints(ctx context.Context, height int64) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Delete(types.AgreedMintsKey(height))
+}
+
+// GetAllAgreedMints returns the agreed mints of every block height that have
+// not been executed yet, ordered by height.
+func (k Keeper) GetAllAgreedMints(ctx context.Context) (all []types.AgreedMints) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, types.AgreedMintsKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var agreed types.AgreedMints
+		k.cdc.MustUnmarshal(iterator.Value(), &agreed)
+		all = append(all, agreed)
+	}
+
+	return all
+}
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/unigrid-project/cosmos-ugdmint/x/ugdmint/types"
+)
+
+// SetPreviousBlockTime stores the time of the last block seen by the module.
+func (k Keeper) SetPreviousBlockTime(ctx context.Context, blockTime time.Time) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Set(types.PreviousBlockTimeKey, sdk.FormatTimeBytes(blockTime))
+}
+
+// GetPreviousBlockTime returns the time of the last block seen by the module.
+// It returns false before the module has seen its first block.
+func (k Keeper) GetPreviousBlockTime(ctx context.Context) (time.Time, bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	b := store.Get(types.PreviousBlockTimeKey)
+	if b == nil {
+		return time.Time{}, false
+	}
+
+	blockTime, err := sdk.ParseTimeBytes(b)
+	if err != nil {
+		panic(err)
+	}
+	return blockTime, true
+}
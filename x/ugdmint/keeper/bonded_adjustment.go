@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/unigrid-project/cosmos-ugdmint/x/ugdmint/types"
+)
+
+// AdjustBlockProvision splits the block provision by the bonded ratio into the
+// boost paid to the stakers directly, the coins of the stakers, which are paid
+// out through the distribution split, the coins sent to the community pool
+// and the coins that are not minted at all. The parts always add up to the
+// provision, the adjustment never mints more than the provision.
+//
+// While the bonded ratio is below goal_bonded the below_goal_staking_share of
+// the provision is the boost, which is paid to the fee collector ahead of the
+// distribution split, so the other destinations of the split give up their
+// part of it. While it is above, the share (bondedRatio - goalBonded) /
+// (1 - goalBonded) of the provision is excess and goes to the
+// excess_destination instead of the stakers. The stakers are paid the
+// provision as is when the adjustment is disabled.
+func (k Keeper) AdjustBlockProvision(ctx context.Context, provision sdk.Coins, bondedRatio math.LegacyDec) (boost, stakers, communityPool, burned sdk.Coins) {
+	params := k.GetParams(ctx)
+	if !params.BondedAdjustmentEnabled {
+		return sdk.NewCoins(), provision, sdk.NewCoins(), sdk.NewCoins()
+	}
+
+	switch {
+	case bondedRatio.LT(params.GoalBonded):
+		boost = mulCoins(provision, params.BelowGoalStakingShare)
+		return boost, provision.Sub(boost...), sdk.NewCoins(), sdk.NewCoins()
+
+	case bondedRatio.GT(params.GoalBonded):
+		share := bondedRatio.Sub(params.GoalBonded).Quo(math.LegacyOneDec().Sub(params.GoalBonded))
+		excess := mulCoins(provision, math.LegacyMinDec(share, math.LegacyOneDec()))
+		stakers = provision.Sub(excess...)
+		if params.ExcessDestination == types.ExcessDestinationBurn {
+			return sdk.NewCoins(), stakers, sdk.NewCoins(), excess
+		}
+		return sdk.NewCoins(), stakers, excess, sdk.NewCoins()
+	}
+
+	return sdk.NewCoins(), provision, sdk.NewCoins(), sdk.NewCoins()
+}
+
+// mulCoins returns every coin multiplied by share, truncated.
+func mulCoins(coins sdk.Coins, share math.LegacyDec) sdk.Coins {
+	result := sdk.NewCoins()
+	for _, coin := range coins {
+		result = result.Add(sdk.NewCoin(coin.Denom, share.MulInt(coin.Amount).TruncateInt()))
+	}
+
+	return result
+}
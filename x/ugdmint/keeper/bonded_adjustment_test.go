@@ -0,0 +1,75 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/unigrid-project/cosmos-ugdmint/x/ugdmint/types"
+)
+
+func TestAdjustBlockProvision(t *testing.T) {
+	provision := sdk.NewCoins(sdk.NewInt64Coin("ugd", 1000))
+	none := sdk.NewCoins()
+
+	cases := map[string]struct {
+		enabled       bool
+		destination   string
+		bondedRatio   math.LegacyDec
+		boost         sdk.Coins
+		stakers       sdk.Coins
+		communityPool sdk.Coins
+		burned        sdk.Coins
+	}{
+		"disabled below goal": {false, types.ExcessDestinationCommunityPool, math.LegacyNewDecWithPrec(5, 1), none, provision, none, none},
+		"disabled above goal": {false, types.ExcessDestinationCommunityPool, math.LegacyOneDec(), none, provision, none, none},
+		"below goal": {
+			true, types.ExcessDestinationCommunityPool, math.LegacyNewDecWithPrec(5, 1),
+			sdk.NewCoins(sdk.NewInt64Coin("ugd", 100)), sdk.NewCoins(sdk.NewInt64Coin("ugd", 900)), none, none,
+		},
+		"at goal": {true, types.ExcessDestinationCommunityPool, math.LegacyNewDecWithPrec(67, 2), none, provision, none, none},
+		"above goal to the community pool": {
+			true, types.ExcessDestinationCommunityPool, math.LegacyNewDecWithPrec(835, 3),
+			none, sdk.NewCoins(sdk.NewInt64Coin("ugd", 500)), sdk.NewCoins(sdk.NewInt64Coin("ugd", 500)), none,
+		},
+		"above goal burned": {
+			true, types.ExcessDestinationBurn, math.LegacyNewDecWithPrec(835, 3),
+			none, sdk.NewCoins(sdk.NewInt64Coin("ugd", 500)), none, sdk.NewCoins(sdk.NewInt64Coin("ugd", 500)),
+		},
+		"fully bonded": {
+			true, types.ExcessDestinationCommunityPool, math.LegacyOneDec(),
+			none, none, provision, none,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			k, ctx := setupKeeper(t)
+
+			params := k.GetParams(ctx)
+			params.BondedAdjustmentEnabled = c.enabled
+			params.ExcessDestination = c.destination
+			if err := k.SetParams(ctx, params); err != nil {
+				t.Fatal(err)
+			}
+
+			boost, stakers, communityPool, burned := k.AdjustBlockProvision(ctx, provision, c.bondedRatio)
+			if !boost.Add(stakers...).Add(communityPool...).Add(burned...).Equal(provision) {
+				t.Errorf("expected the parts to add up to %s, got %s, %s, %s and %s", provision, boost, stakers, communityPool, burned)
+			}
+			if !boost.Equal(c.boost) {
+				t.Errorf("expected a boost of %s, got %s", c.boost, boost)
+			}
+			if !stakers.Equal(c.stakers) {
+				t.Errorf("expected %s for the stakers, got %s", c.stakers, stakers)
+			}
+			if !communityPool.Equal(c.communityPool) {
+				t.Errorf("expected %s for the community pool, got %s", c.communityPool, communityPool)
+			}
+			if !burned.Equal(c.burned) {
+				t.Errorf("expected %s to be burned, got %s", c.burned, burned)
+			}
+		})
+	}
+}
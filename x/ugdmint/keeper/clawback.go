@@ -0,0 +1,180 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/unigrid-project/cosmos-ugdmint/x/ugdmint/types"
+)
+
+// ClawbackUnvestedMint takes the coins of the Hedgehog mint with the given key
+// that have not vested at the block time back from its recipient and sends
+// them to the destination, either the community pool or burn. Escrowed coins
+// are taken from the escrow schedule of the recipient, other coins from the
+// vesting schedule of its account. Burned coins are removed from the total
+// minted and the Hedgehog minted coins of the minter. The clawback is
+// recorded in the mint record and the clawed back coins are returned.
+func (k Keeper) ClawbackUnvestedMint(ctx sdk.Context, mintKey, destination string) (sdk.Coins, error) {
+	processed, found := k.GetProcessedMint(ctx, mintKey)
+	if !found {
+		return nil, errors.Wrapf(types.ErrMintNotFound, "mint %s has not been paid out", mintKey)
+	}
+	record, found := k.GetMintRecord(ctx, processed.BlockHeight, processed.Address)
+	if !found {
+		return nil, errors.Wrapf(types.ErrMintNotFound, "no record of mint %s at block height %d", mintKey, processed.BlockHeight)
+	}
+	if record.Clawback != nil {
+		return nil, errors.Wrapf(types.ErrMintClawedBack, "mint %s at block height %d", mintKey, record.Clawback.BlockHeight)
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(record.Account)
+	if err != nil {
+		return nil, err
+	}
+
+	now := ctx.BlockTime().Unix()
+	var clawback sdk.Coins
+	if record.Vesting != nil && record.Vesting.Escrowed {
+		clawback, err = k.clawbackEscrow(ctx, recipient, record.BlockHeight, now)
+	} else {
+		clawback, err = k.clawbackVesting(ctx, recipient, record, now)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if clawback.IsZero() {
+		return nil, errors.Wrapf(types.ErrNothingToClawback, "mint %s", mintKey)
+	}
+
+	switch destination {
+	case types.DestinationBurn:
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, clawback); err != nil {
+			return nil, err
+		}
+
+		// Burned coins no longer count as minted, neither against the
+		// max_supply param nor as minted by Hedgehog
+		k.SubTotalMinted(ctx, clawback)
+		minter := k.GetMinter(ctx)
+		minter.HedgehogMinted = minter.HedgehogMinted.Sub(clawback.Min(minter.HedgehogMinted)...)
+		k.SetMinter(ctx, minter)
+	case types.DestinationCommunityPool:
+		if k.distrKeeper == nil {
+			return nil, types.ErrNoDistributionKeeper
+		}
+		if err := k.distrKeeper.FundCommunityPool(ctx, clawback, k.authKeeper.GetModuleAddress(types.ModuleName)); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("invalid clawback destination %s", destination)
+	}
+
+	if record.Vesting == nil {
+		// none of the coins of a mint recorded before its vesting was
+		// recorded are left vesting
+		record.Vesting = &types.MintVesting{}
+	} else {
+		unlocks := make([]types.EscrowUnlock, 0, len(record.Vesting.Unlocks))
+		for _, unlock := range record.Vesting.Unlocks {
+			if unlock.UnlockTime <= now {
+				unlocks = append(unlocks, unlock)
+			}
+		}
+		record.Vesting.Unlocks = unlocks
+	}
+	record.Clawback = &types.MintClawback{
+		BlockHeight: ctx.BlockHeight(),
+		Amount:      clawback,
+		Destination: destination,
+	}
+	if err := k.SetMintRecord(ctx, record); err != nil {
+		return nil, err
+	}
+
+	return clawback, nil
+}
+
+// clawbackEscrow removes the coins of the escrow tranche of the mint paid out
+// at blockHeight that have not unlocked at now and moves them from the escrow
+// module account back to the ugdmint module account.
+func (k Keeper) clawbackEscrow(ctx sdk.Context, recipient sdk.AccAddress, blockHeight, now int64) (sdk.Coins, error) {
+	schedule, _ := k.GetEscrowSchedule(ctx, recipient)
+
+	clawback := sdk.NewCoins()
+	tranches := schedule.Tranches[:0]
+	for _, tranche := range schedule.Tranches {
+		if tranche.BlockHeight == blockHeight {
+			clawback = tranche.Locked(now)
+			unlocks := tranche.Unlocks[:0]
+			for _, unlock := range tranche.Unlocks {
+				if unlock.UnlockTime <= now {
+					unlocks = append(unlocks, unlock)
+				}
+			}
+			if len(unlocks) == 0 {
+				continue
+			}
+			tranche.Unlocks = unlocks
+		}
+		tranches = append(tranches, tranche)
+	}
+	if clawback.IsZero() {
+		return clawback, nil
+	}
+	schedule.Tranches = tranches
+	k.SetEscrowSchedule(ctx, schedule)
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.EscrowAccountName, types.ModuleName, clawback); err != nil {
+		return nil, err
+	}
+
+	return clawback, nil
+}
+
+// clawbackVesting removes the coins of the mint that have not vested at now
+// from the vesting schedule of the account of the recipient and moves them to
+// the ugdmint module account. The coins must still be in the account,
+// delegated vesting coins cannot be clawed back.
+func (k Keeper) clawbackVesting(ctx sdk.Context, recipient sdk.AccAddress, record types.MintRecord, now int64) (sdk.Coins, error) {
+	var acc vestexported.VestingAccount
+	switch account := k.GetAccount(ctx, recipient).(type) {
+	case *vestingtypes.DelayedVestingAccount, *vestingtypes.ContinuousVestingAccount, *vestingtypes.PeriodicVestingAccount:
+		acc = account.(vestexported.VestingAccount)
+	default:
+		return nil, nil
+	}
+
+	clawedback, clawback, err := types.ClawbackVestingAccount(acc, mintUnlocks(acc, record), now)
+	if err != nil {
+		return nil, err
+	}
+	if clawback.IsZero() {
+		return clawback, nil
+	}
+
+	if err := k.SetAccount(ctx, clawedback); err != nil {
+		return nil, err
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, recipient, types.ModuleName, clawback); err != nil {
+		return nil, err
+	}
+
+	return clawback, nil
+}
+
+// mintUnlocks returns the unlocks of the coins of a mint paid to acc. Mints
+// recorded before their vesting was recorded unlock at the end time of a
+// delayed vesting account and are unlocked in any other account.
+func mintUnlocks(acc sdk.AccountI, record types.MintRecord) []types.EscrowUnlock {
+	if record.Vesting != nil {
+		return record.Vesting.Unlocks
+	}
+	if delayed, ok := acc.(*vestingtypes.DelayedVestingAccount); ok {
+		return []types.EscrowUnlock{{UnlockTime: delayed.EndTime, Amount: record.Amount}}
+	}
+
+	return nil
+}
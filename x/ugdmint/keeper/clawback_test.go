@@ -0,0 +1,234 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/unigrid-project/cosmos-ugdmint/x/ugdmint/keeper"
+	"github.com/unigrid-project/cosmos-ugdmint/x/ugdmint/types"
+)
+
+// escrowMint escrows a Hedgehog mint of amount uugd scheduled for mintID and
+// records it like the BeginBlocker does.
+func escrowMint(t *testing.T, k keeper.Keeper, ctx sdk.Context, recipient sdk.AccAddress, mintID uint64, amount int64) {
+	t.Helper()
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("uugd", amount))
+	if err := k.EscrowMint(ctx, recipient, coins); err != nil {
+		t.Fatal(err)
+	}
+	schedule, _ := k.GetEscrowSchedule(ctx, recipient)
+	tranche := schedule.Tranches[len(schedule.Tranches)-1]
+
+	if err := k.SetMintRecord(ctx, types.MintRecord{
+		BlockHeight: ctx.BlockHeight(),
+		Account:     recipient.String(),
+		Amount:      coins,
+		Vesting:     &types.MintVesting{Escrowed: true, Unlocks: tranche.Unlocks},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	mint := types.HedgehogMint{Address: recipient.String(), Height: mintID, Amount: amount}
+	k.SetProcessedMint(ctx, types.ProcessedMint{
+		Key:         mint.Key(),
+		Address:     mint.Address,
+		Height:      mint.Height,
+		Amount:      mint.Amount,
+		BlockHeight: ctx.BlockHeight(),
+	})
+}
+
+func TestClawbackEscrowedMint(t *testing.T) {
+	k, ctx, bk := setupEscrowKeeper(t)
+	ms := keeper.NewMsgServerImpl(k)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	recipient := sdk.AccAddress("recipient")
+
+	escrowMint(t, k, ctx, recipient, 5, 100)
+	later := ctx.WithBlockHeight(20).WithBlockTime(ctx.BlockTime().Add(50 * time.Second))
+	escrowMint(t, k, later, recipient, 6, 40)
+
+	msg := &types.MsgClawbackMint{Authority: authority, Address: recipient.String(), MintId: 5, Destination: types.DestinationBurn}
+	if _, err := ms.ClawbackMint(later, &types.MsgClawbackMint{Authority: recipient.String(), Address: recipient.String(), MintId: 5, Destination: types.DestinationBurn}); err == nil {
+		t.Fatal("expected only the authority to claw back mints")
+	}
+
+	res, err := ms.ClawbackMint(later, msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// half of the first mint has unlocked, the other half is burned
+	if !res.Amount.Equal(sdk.NewCoins(sdk.NewInt64Coin("uugd", 50))) || !bk.burned.Equal(res.Amount) {
+		t.Fatalf("expected 50uugd to be clawed back and burned, got %s and %s", res.Amount, bk.burned)
+	}
+	if returned := bk.sent[types.ModuleName]; !returned.Equal(res.Amount) {
+		t.Fatalf("expected the clawed back coins to leave the escrow account, got %s", returned)
+	}
+
+	// the unlocked coins and the other mint are left in escrow
+	schedule, _ := k.GetEscrowSchedule(later, recipient)
+	if claimable, locked := schedule.Claimable(later.BlockTime().Unix()), schedule.Locked(later.BlockTime().Unix()); !claimable.Equal(sdk.NewCoins(sdk.NewInt64Coin("uugd", 50))) || !locked.Equal(sdk.NewCoins(sdk.NewInt64Coin("uugd", 40))) {
+		t.Fatalf("expected 50uugd claimable and 40uugd locked, got %s and %s", claimable, locked)
+	}
+
+	record, _ := k.GetMintRecord(later, ctx.BlockHeight(), recipient.String())
+	if record.Clawback == nil || record.Clawback.BlockHeight != 20 || !record.Clawback.Amount.Equal(res.Amount) || record.Clawback.Destination != types.DestinationBurn {
+		t.Fatalf("expected the clawback to be recorded, got %v", record.Clawback)
+	}
+	if len(record.Vesting.Unlocks) != 1 {
+		t.Fatalf("expected only the unlocked coins to be left in the record, got %v", record.Vesting.Unlocks)
+	}
+
+	if _, err := ms.ClawbackMint(later, msg); !errors.Is(err, types.ErrMintClawedBack) {
+		t.Fatalf("expected %v, got %v", types.ErrMintClawedBack, err)
+	}
+	msg.MintId = 7
+	if _, err := ms.ClawbackMint(later, msg); !errors.Is(err, types.ErrMintNotFound) {
+		t.Fatalf("expected %v, got %v", types.ErrMintNotFound, err)
+	}
+}
+
+func TestClawbackVestedMint(t *testing.T) {
+	k, ctx, bk, dk := setupPayoutKeeper(t)
+	params := types.DefaultParams()
+	params.VestingPolicy = types.VestingPolicy{Kind: types.VestingDelayed, DurationSeconds: 100, Escrow: true}
+	if err := k.SetParams(ctx, params); err != nil {
+		t.Fatal(err)
+	}
+	ms := keeper.NewMsgServerImpl(k)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	recipient := sdk.AccAddress("recipient")
+
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(time.Unix(1_000_000, 0))
+	escrowMint(t, k, ctx, recipient, 5, 100)
+
+	msg := &types.MsgClawbackMint{Authority: authority, Address: recipient.String(), MintId: 5, Destination: types.DestinationCommunityPool}
+	vested := ctx.WithBlockTime(ctx.BlockTime().Add(100 * time.Second))
+	if _, err := ms.ClawbackMint(vested, msg); !errors.Is(err, types.ErrNothingToClawback) {
+		t.Fatalf("expected %v, got %v", types.ErrNothingToClawback, err)
+	}
+
+	res, err := ms.ClawbackMint(ctx, msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.Amount.Equal(sdk.NewCoins(sdk.NewInt64Coin("uugd", 100))) || !dk.communityPool.Equal(res.Amount) || !bk.burned.Empty() {
+		t.Fatalf("expected 100uugd to fund the community pool, got %s", dk.communityPool)
+	}
+	if _, found := k.GetEscrowSchedule(ctx, recipient); found {
+		t.Fatal("expected the clawed back schedule to be removed")
+	}
+}
+
+func TestClawbackBurnReducesTotalMinted(t *testing.T) {
+	k, ctx, _ := setupEscrowKeeper(t)
+	ms := keeper.NewMsgServerImpl(k)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	recipient := sdk.AccAddress("recipient")
+
+	params := k.GetParams(ctx)
+	params.MaxSupply = sdk.NewCoins(sdk.NewInt64Coin("uugd", 1000))
+	if err := k.SetParams(ctx, params); err != nil {
+		t.Fatal(err)
+	}
+
+	// the mint is counted as the BeginBlocker counts it
+	escrowMint(t, k, ctx, recipient, 5, 100)
+	minted := sdk.NewCoins(sdk.NewInt64Coin("uugd", 100))
+	k.AddTotalMinted(ctx, minted)
+	minter := types.DefaultInitialMinter()
+	minter.HedgehogMinted = minted
+	k.SetMinter(ctx, minter)
+
+	later := ctx.WithBlockHeight(20).WithBlockTime(ctx.BlockTime().Add(50 * time.Second))
+	msg := &types.MsgClawbackMint{Authority: authority, Address: recipient.String(), MintId: 5, Destination: types.DestinationBurn}
+	if _, err := ms.ClawbackMint(later, msg); err != nil {
+		t.Fatal(err)
+	}
+
+	// half of the mint is burned and no longer counts as minted
+	left := sdk.NewCoins(sdk.NewInt64Coin("uugd", 50))
+	if total := k.GetTotalMinted(later); !total.Equal(left) {
+		t.Fatalf("expected %s to be counted as minted, got %s", left, total)
+	}
+	if hedgehog := k.GetMinter(later).HedgehogMinted; !hedgehog.Equal(left) {
+		t.Fatalf("expected %s to be minted by Hedgehog, got %s", left, hedgehog)
+	}
+	if headroom := k.GetSupplyHeadroom(later); !headroom.Equal(sdk.NewCoins(sdk.NewInt64Coin("uugd", 950))) {
+		t.Fatalf("expected a headroom of 950uugd, got %s", headroom)
+	}
+}
+
+func TestClawbackMergedVestingAccount(t *testing.T) {
+	k, ctx, bk, _ := setupPayoutKeeper(t)
+	ms := keeper.NewMsgServerImpl(k)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	recipient := sdk.AccAddress("recipient")
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(time.Unix(1_000_000, 0))
+	now := ctx.BlockTime().Unix()
+
+	// the recipient already vests 500uugd until 50s from now, the mint is
+	// merged into its schedule as two periods of 100s
+	existing := sdk.NewCoins(sdk.NewInt64Coin("uugd", 500))
+	delayed, err := vestingtypes.NewDelayedVestingAccount(authtypes.NewBaseAccount(recipient, nil, 7, 0), existing, now+50)
+	if err != nil {
+		t.Fatal(err)
+	}
+	policy := types.VestingPolicy{Kind: types.VestingPeriodic, DurationSeconds: 200, PeriodCount: 2}
+	coins := sdk.NewCoins(sdk.NewInt64Coin("uugd", 1000))
+	merged, err := policy.MergeVestingAccount(delayed, coins, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := k.SetAccount(ctx, merged); err != nil {
+		t.Fatal(err)
+	}
+
+	tranche, err := policy.NewEscrowTranche(ctx.BlockHeight(), coins, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := k.SetMintRecord(ctx, types.MintRecord{
+		BlockHeight: ctx.BlockHeight(),
+		Account:     recipient.String(),
+		Amount:      coins,
+		Vesting:     &types.MintVesting{Unlocks: tranche.Unlocks},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	mint := types.HedgehogMint{Address: recipient.String(), Height: 5, Amount: 1000}
+	k.SetProcessedMint(ctx, types.ProcessedMint{Key: mint.Key(), Address: mint.Address, Height: mint.Height, Amount: mint.Amount, BlockHeight: ctx.BlockHeight()})
+
+	// after 100s the first period of the mint has vested, only the second is
+	// clawed back
+	later := ctx.WithBlockHeight(20).WithBlockTime(ctx.BlockTime().Add(100 * time.Second))
+	msg := &types.MsgClawbackMint{Authority: authority, Address: recipient.String(), MintId: 5, Destination: types.DestinationCommunityPool}
+	res, err := ms.ClawbackMint(later, msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := sdk.NewCoins(sdk.NewInt64Coin("uugd", 500)); !res.Amount.Equal(want) || !bk.sent[types.ModuleName].Equal(want) {
+		t.Fatalf("expected %s to be clawed back from the account, got %s and %s", want, res.Amount, bk.sent[types.ModuleName])
+	}
+
+	// the existing tranche and the vested period are left to the recipient
+	acc, ok := k.GetAccount(later, recipient).(vestexported.VestingAccount)
+	if !ok {
+		t.Fatalf("expected a vesting account, got %T", k.GetAccount(later, recipient))
+	}
+	if want := existing.Add(sdk.NewInt64Coin("uugd", 500)); !acc.GetOriginalVesting().Equal(want) {
+		t.Fatalf("expected %s to be left vesting originally, got %s", want, acc.GetOriginalVesting())
+	}
+	if vesting := acc.GetVestingCoins(later.BlockTime()); !vesting.IsZero() {
+		t.Fatalf("expected nothing left vesting, got %s", vesting)
+	}
+	if vesting := acc.GetVestingCoins(ctx.BlockTime().Add(49 * time.Second)); !vesting.Equal(sdk.NewCoins(sdk.NewInt64Coin("uugd", 1000))) {
+		t.Fatalf("expected the existing tranche and the first period to keep vesting, got %s", vesting)
+	}
+}
@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/unigrid-project/cosmos-ugdmint/x/ugdmint/types"
+)
+
+// PayBlockProvision mints the block provision and pays it out. The boost is
+// paid to the fee collector, the coins of the stakers are paid to the
+// destinations of the distribution_split param and the community pool is
+// funded with the rest. It returns the coins that were minted, which lack the
+// shares that are burned, and the payout of every destination of the split,
+// followed by the payout of the boost if there is one.
+func (k Keeper) PayBlockProvision(ctx sdk.Context, boost, stakers, communityPool sdk.Coins) (sdk.Coins, []types.ProvisionPayout, error) {
+	split := k.GetParams(ctx).DistributionSplit
+	shares := types.SplitProvision(split, stakers)
+
+	minted := communityPool.Add(boost...)
+	fund := communityPool
+	payouts := make([]types.ProvisionPayout, len(split), len(split)+1)
+	if !boost.Empty() {
+		payouts = append(payouts, types.ProvisionPayout{
+			Destination: types.DistributionDestination{Kind: types.DestinationFeeCollector},
+			Amount:      boost,
+		})
+	}
+	for i, destination := range split {
+		payouts[i] = types.ProvisionPayout{Destination: destination, Amount: shares[i]}
+
+		switch destination.Kind {
+		case types.DestinationBurn:
+			continue
+		case types.DestinationCommunityPool:
+			fund = fund.Add(shares[i]...)
+		}
+		minted = minted.Add(shares[i]...)
+	}
+
+	if !fund.Empty() && k.distrKeeper == nil {
+		return nil, nil, types.ErrNoDistributionKeeper
+	}
+
+	if err := k.MintCoins(ctx, minted); err != nil {
+		return nil, nil, err
+	}
+	k.AddTotalMinted(ctx, minted)
+
+	for _, payout := range payouts {
+		if payout.Amount.Empty() {
+			continue
+		}
+
+		switch payout.Destination.Kind {
+		case types.DestinationFeeCollector:
+			if err := k.AddCollectedFees(ctx, payout.Amount); err != nil {
+				return nil, nil, err
+			}
+		case types.DestinationModuleAccount:
+			if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, payout.Destination.Recipient, payout.Amount); err != nil {
+				return nil, nil, err
+			}
+		case types.DestinationAddress:
+			addr, err := sdk.AccAddressFromBech32(payout.Destination.Recipient)
+			if err != nil {
+				return nil, nil, err
+			}
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, payout.Amount); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+	if !fund.Empty() {
+		if err := k.distrKeeper.FundCommunityPool(ctx, fund, k.authKeeper.GetModuleAddress(types.ModuleName)); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return minted, payouts, nil
+}
@@ -0,0 +1,103 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/unigrid-project/cosmos-ugdmint/x/ugdmint/types"
+)
+
+func TestPayBlockProvision(t *testing.T) {
+	k, ctx, bk, dk := setupPayoutKeeper(t)
+
+	developer := sdk.AccAddress("developer___________").String()
+	params := k.GetParams(ctx)
+	params.DistributionSplit = []types.DistributionDestination{
+		{Kind: types.DestinationFeeCollector, Weight: math.LegacyNewDecWithPrec(50, 2)},
+		{Kind: types.DestinationCommunityPool, Weight: math.LegacyNewDecWithPrec(20, 2)},
+		{Kind: types.DestinationModuleAccount, Recipient: "gridnode", Weight: math.LegacyNewDecWithPrec(15, 2)},
+		{Kind: types.DestinationAddress, Recipient: developer, Weight: math.LegacyNewDecWithPrec(10, 2)},
+		{Kind: types.DestinationBurn, Weight: math.LegacyNewDecWithPrec(5, 2)},
+	}
+	if err := k.SetParams(ctx, params); err != nil {
+		t.Fatal(err)
+	}
+
+	// the remainder of the truncated shares is paid to the fee collector
+	minted, payouts, err := k.PayBlockProvision(ctx, sdk.NewCoins(),
+		sdk.NewCoins(sdk.NewInt64Coin("ugd", 1001)), sdk.NewCoins(sdk.NewInt64Coin("ugd", 100)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, expected := range []int64{501, 200, 150, 100, 50} {
+		if !payouts[i].Amount.Equal(sdk.NewCoins(sdk.NewInt64Coin("ugd", expected))) {
+			t.Errorf("expected %dugd for the %s destination, got %s", expected, payouts[i].Destination.Kind, payouts[i].Amount)
+		}
+	}
+
+	// the burned share is not minted at all
+	if expected := sdk.NewCoins(sdk.NewInt64Coin("ugd", 1051)); !minted.Equal(expected) || !bk.minted.Equal(expected) {
+		t.Errorf("expected %s to be minted, got %s and %s", expected, minted, bk.minted)
+	}
+	if expected := sdk.NewCoins(sdk.NewInt64Coin("ugd", 1051)); !k.GetTotalMinted(ctx).Equal(expected) {
+		t.Errorf("expected %s to be counted as minted, got %s", expected, k.GetTotalMinted(ctx))
+	}
+
+	sent := map[string]int64{authtypes.FeeCollectorName: 501, "gridnode": 150, developer: 100}
+	for recipient, expected := range sent {
+		if !bk.sent[recipient].Equal(sdk.NewCoins(sdk.NewInt64Coin("ugd", expected))) {
+			t.Errorf("expected %dugd to be sent to %s, got %s", expected, recipient, bk.sent[recipient])
+		}
+	}
+	if expected := sdk.NewCoins(sdk.NewInt64Coin("ugd", 300)); !dk.communityPool.Equal(expected) {
+		t.Errorf("expected the community pool to be funded with %s, got %s", expected, dk.communityPool)
+	}
+}
+
+func TestPayBlockProvisionBoost(t *testing.T) {
+	k, ctx, bk, dk := setupPayoutKeeper(t)
+
+	params := k.GetParams(ctx)
+	params.DistributionSplit = []types.DistributionDestination{
+		{Kind: types.DestinationFeeCollector, Weight: math.LegacyNewDecWithPrec(50, 2)},
+		{Kind: types.DestinationCommunityPool, Weight: math.LegacyNewDecWithPrec(50, 2)},
+	}
+	if err := k.SetParams(ctx, params); err != nil {
+		t.Fatal(err)
+	}
+
+	// the boost is paid to the fee collector ahead of the split
+	minted, payouts, err := k.PayBlockProvision(ctx,
+		sdk.NewCoins(sdk.NewInt64Coin("ugd", 100)), sdk.NewCoins(sdk.NewInt64Coin("ugd", 900)), sdk.NewCoins())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := sdk.NewCoins(sdk.NewInt64Coin("ugd", 1000)); !minted.Equal(expected) || !bk.minted.Equal(expected) {
+		t.Errorf("expected %s to be minted, got %s and %s", expected, minted, bk.minted)
+	}
+	if len(payouts) != 3 || payouts[2].Destination.Kind != types.DestinationFeeCollector ||
+		!payouts[2].Amount.Equal(sdk.NewCoins(sdk.NewInt64Coin("ugd", 100))) {
+		t.Errorf("expected the boost to be the last payout, got %v", payouts)
+	}
+	if expected := sdk.NewCoins(sdk.NewInt64Coin("ugd", 550)); !bk.sent[authtypes.FeeCollectorName].Equal(expected) {
+		t.Errorf("expected %s to be sent to the fee collector, got %s", expected, bk.sent[authtypes.FeeCollectorName])
+	}
+	if expected := sdk.NewCoins(sdk.NewInt64Coin("ugd", 450)); !dk.communityPool.Equal(expected) {
+		t.Errorf("expected the community pool to be funded with %s, got %s", expected, dk.communityPool)
+	}
+}
+
+func TestPayBlockProvisionWithoutDistributionKeeper(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	_, _, err := k.PayBlockProvision(ctx, sdk.NewCoins(), sdk.NewCoins(), sdk.NewCoins(sdk.NewInt64Coin("ugd", 300)))
+	if !errors.Is(err, types.ErrNoDistributionKeeper) {
+		t.Fatalf("expected ErrNoDistributionKeeper, got %v", err)
+	}
+}
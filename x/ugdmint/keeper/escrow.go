@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/unigrid-project/cosmos-ugdmint/x/ugdmint/types"
+)
+
+// SetEscrowSchedule stores the escrowed coins of a recipient. A schedule
+// without tranches is removed from the store.
+func (k Keeper) SetEscrowSchedule(ctx context.Context, schedule types.EscrowSchedule) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	key := types.EscrowScheduleKey(sdk.MustAccAddressFromBech32(schedule.Address))
+	if len(schedule.Tranches) == 0 {
+		store.Delete(key)
+		return
+	}
+
+	b := k.cdc.MustMarshal(&schedule)
+	store.Set(key, b)
+}
+
+// GetEscrowSchedule returns the escrowed coins of a recipient.
+func (k Keeper) GetEscrowSchedule(ctx context.Context, addr sdk.AccAddress) (schedule types.EscrowSchedule, found bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	b := store.Get(types.EscrowScheduleKey(addr))
+	if b == nil {
+		return types.EscrowSchedule{Address: addr.String()}, false
+	}
+
+	k.cdc.MustUnmarshal(b, &schedule)
+	return schedule, true
+}
+
+// GetAllEscrowSchedules returns the escrowed coins of every recipient.
+func (k Keeper) GetAllEscrowSchedules(ctx context.Context) (schedules []types.EscrowSchedule) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, types.EscrowScheduleKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var schedule types.EscrowSchedule
+		k.cdc.MustUnmarshal(iterator.Value(), &schedule)
+		schedules = append(schedules, schedule)
+	}
+
+	return schedules
+}
+
+// EscrowMint moves the minted coins of a Hedgehog mint to the escrow module
+// account and adds them to the escrow schedule of the recipient, unlocking
+// under the vesting_policy param from the block time on.
+func (k Keeper) EscrowMint(ctx sdk.Context, recipient sdk.AccAddress, coins sdk.Coins) error {
+	policy := k.GetParams(ctx).VestingPolicy
+	tranche, err := policy.NewEscrowTranche(ctx.BlockHeight(), coins, ctx.BlockTime().Unix())
+	if err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.EscrowAccountName, coins); err != nil {
+		return err
+	}
+
+	schedule, _ := k.GetEscrowSchedule(ctx, recipient)
+	schedule.Tranches = append(schedule.Tranches, tranche)
+	k.SetEscrowSchedule(ctx, schedule)
+
+	return nil
+}
+
+// ClaimEscrow pays a recipient the escrowed coins that have unlocked at the
+// block time and returns them.
+func (k Keeper) ClaimEscrow(ctx sdk.Context, recipient sdk.AccAddress) (sdk.Coins, error) {
+	schedule, _ := k.GetEscrowSchedule(ctx, recipient)
+	claimed := schedule.Claim(ctx.BlockTime().Unix())
+	if claimed.IsZero() {
+		return nil, errors.Wrapf(types.ErrNothingToClaim, "recipient %s", recipient)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.EscrowAccountName, recipient, claimed); err != nil {
+		return nil, err
+	}
+	k.SetEscrowSchedule(ctx, schedule)
+
+	return claimed, nil
+}
@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/unigrid-project/cosmos-ugdmint/x/ugdmint/keeper"
+	"github.com/unigrid-project/cosmos-ugdmint/x/ugdmint/types"
+)
+
+func setupEscrowKeeper(t *testing.T) (keeper.Keeper, sdk.Context, *bankKeeper) {
+	t.Helper()
+
+	k, ctx, bk, _ := setupPayoutKeeper(t)
+	params := types.DefaultParams()
+	params.VestingPolicy = types.VestingPolicy{Kind: types.VestingPeriodic, DurationSeconds: 100, PeriodCount: 2, Escrow: true}
+	if err := k.SetParams(ctx, params); err != nil {
+		t.Fatal(err)
+	}
+	k.SetMinter(ctx, types.DefaultInitialMinter())
+
+	return k, ctx.WithBlockHeight(10).WithBlockTime(time.Unix(1_000_000, 0)), bk
+}
+
+func TestEscrowMintAndClaim(t *testing.T) {
+	k, ctx, bk := setupEscrowKeeper(t)
+	ms := keeper.NewMsgServerImpl(k)
+	recipient := sdk.AccAddress("recipient")
+
+	if err := k.EscrowMint(ctx, recipient, sdk.NewCoins(sdk.NewInt64Coin("uugd", 100))); err != nil {
+		t.Fatal(err)
+	}
+	later := ctx.WithBlockHeight(20).WithBlockTime(ctx.BlockTime().Add(50 * time.Second))
+	if err := k.EscrowMint(later, recipient, sdk.NewCoins(sdk.NewInt64Coin("uugd", 40))); err != nil {
+		t.Fatal(err)
+	}
+	if escrowed := bk.sent[types.EscrowAccountName]; !escrowed.Equal(sdk.NewCoins(sdk.NewInt64Coin("uugd", 140))) {
+		t.Fatalf("expected 140uugd to be escrowed, got %s", escrowed)
+	}
+
+	res, err := k.Claimable(later, &types.QueryClaimableRequest{Address: recipient.String()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.Claimable.Equal(sdk.NewCoins(sdk.NewInt64Coin("uugd", 50))) || !res.Locked.Equal(sdk.NewCoins(sdk.NewInt64Coin("uugd", 90))) {
+		t.Fatalf("expected 50uugd claimable and 90uugd locked, got %s and %s", res.Claimable, res.Locked)
+	}
+	if len(res.EscrowSchedule.Tranches) != 2 {
+		t.Fatalf("expected a tranche for each mint, got %v", res.EscrowSchedule.Tranches)
+	}
+
+	claimed, err := ms.ClaimVested(later, &types.MsgClaimVested{Recipient: recipient.String()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !claimed.Amount.Equal(sdk.NewCoins(sdk.NewInt64Coin("uugd", 50))) || !bk.sent[recipient.String()].Equal(claimed.Amount) {
+		t.Fatalf("expected 50uugd to be paid out, claimed %s and paid %s", claimed.Amount, bk.sent[recipient.String()])
+	}
+	if _, err := ms.ClaimVested(later, &types.MsgClaimVested{Recipient: recipient.String()}); !errors.Is(err, types.ErrNothingToClaim) {
+		t.Fatalf("expected %v, got %v", types.ErrNothingToClaim, err)
+	}
+
+	// once everything is claimed the schedule is removed
+	end := later.WithBlockTime(later.BlockTime().Add(100 * time.Second))
+	if _, err := ms.ClaimVested(end, &types.MsgClaimVested{Recipient: recipient.String()}); err != nil {
+		t.Fatal(err)
+	}
+	if paid := bk.sent[recipient.String()]; !paid.Equal(sdk.NewCoins(sdk.NewInt64Coin("uugd", 140))) {
+		t.Fatalf("expected 140uugd to be paid out, got %s", paid)
+	}
+	if _, found := k.GetEscrowSchedule(end, recipient); found {
+		t.Fatal("expected the claimed schedule to be removed")
+	}
+}
+
+func TestEscrowSchedulesGenesis(t *testing.T) {
+	k, ctx, _ := setupEscrowKeeper(t)
+
+	for _, recipient := range []sdk.AccAddress{sdk.AccAddress("first"), sdk.AccAddress("second")} {
+		if err := k.EscrowMint(ctx, recipient, sdk.NewCoins(sdk.NewInt64Coin("uugd", 100))); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	genesis := k.ExportGenesis(ctx)
+	if len(genesis.EscrowSchedules) != 2 {
+		t.Fatalf("expected 2 escrow schedules to be exported, got %d", len(genesis.EscrowSchedules))
+	}
+	if err := types.ValidateGenesis(*genesis); err != nil {
+		t.Fatal(err)
+	}
+
+	imported, importedCtx, _ := setupEscrowKeeper(t)
+	imported.InitGenesis(importedCtx, accountKeeper{}, genesis)
+	res, err := imported.EscrowSchedules(importedCtx, &types.QueryEscrowSchedulesRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.EscrowSchedules) != 2 {
+		t.Fatalf("expected 2 escrow schedules to be imported, got %d", len(res.EscrowSchedules))
+	}
+}
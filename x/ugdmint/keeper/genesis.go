@@ -12,6 +12,36 @@ func (keeper Keeper) InitGenesis(ctx sdk.Context, ak types.AccountKeeper, data *
 	if err := keeper.SetParams(ctx, data.Params); err != nil {
 		panic(err)
 	}
+
+	for _, mint := range data.ProcessedMints {
+		keeper.SetProcessedMint(ctx, mint)
+	}
+
+	if data.PreviousBlockTime != nil {
+		keeper.SetPreviousBlockTime(ctx, *data.PreviousBlockTime)
+	}
+
+	// Denoms missing from the genesis are counted from their supply, which
+	// the bank module has set up before this module
+	keeper.SetTotalMinted(ctx, data.TotalMinted)
+	keeper.SeedTotalMinted(ctx, data.Params)
+
+	if data.MintingPause != nil {
+		keeper.SetMintingPause(ctx, *data.MintingPause)
+	}
+
+	for _, change := range data.ScheduledParamsChanges {
+		keeper.SetScheduledParamsChange(ctx, change)
+	}
+
+	for _, schedule := range data.EscrowSchedules {
+		keeper.SetEscrowSchedule(ctx, schedule)
+	}
+
+	for _, agreed := range data.AgreedMints {
+		keeper.SetAgreedMints(ctx, agreed)
+	}
+
 	goCtx := sdk.UnwrapSDKContext(ctx)
 	ak.GetModuleAccount(goCtx, types.ModuleName)
 }
@@ -20,5 +50,17 @@ func (keeper Keeper) InitGenesis(ctx sdk.Context, ak types.AccountKeeper, data *
 func (keeper Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	minter := keeper.GetMinter(ctx)
 	params := keeper.GetParams(ctx)
-	return types.NewGenesisState(minter, params)
+	genesis := types.NewGenesisState(minter, params)
+	genesis.ProcessedMints = keeper.GetAllProcessedMints(ctx)
+	if blockTime, found := keeper.GetPreviousBlockTime(ctx); found {
+		genesis.PreviousBlockTime = &blockTime
+	}
+	genesis.TotalMinted = keeper.GetTotalMinted(ctx)
+	if pause := keeper.GetMintingPause(ctx); pause.IsPaused() {
+		genesis.MintingPause = &pause
+	}
+	genesis.ScheduledParamsChanges = keeper.GetAllScheduledParamsChanges(ctx)
+	genesis.EscrowSchedules = keeper.GetAllEscrowSchedules(ctx)
+	genesis.AgreedMints = keeper.GetAllAgreedMints(ctx)
+	return genesis
 }
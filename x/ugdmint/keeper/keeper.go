@@ -22,9 +22,13 @@ type (
 		storeService     store.KVStoreService
 		stakingKeeper    types.StakingKeeper
 		bankKeeper       types.BankKeeper
+		distrKeeper      types.DistributionKeeper
+		mintSource       types.MintSource
 		feeCollectorName string
 		hedgehogUrl      string
 		authKeeper       types.AccountKeeper
+		// the emission strategy the block provision is minted with
+		inflationCalculator types.InflationCalculationFn
 		// the address capable of executing a MsgUpdateParams message. Typically, this
 		// should be the x/gov module account.
 		authority string
@@ -39,6 +43,8 @@ func NewKeeper(
 
 	ak types.AccountKeeper,
 	bk types.BankKeeper,
+	dk types.DistributionKeeper,
+	mintSource types.MintSource,
 	feeCollectorName string,
 	authority string,
 ) Keeper {
@@ -53,6 +59,8 @@ func NewKeeper(
 		storeService:     storeService,
 		stakingKeeper:    sk,
 		bankKeeper:       bk,
+		distrKeeper:      dk,
+		mintSource:       mintSource,
 		feeCollectorName: feeCollectorName,
 		authority:        authority,
 		authKeeper:       ak,
@@ -64,11 +72,32 @@ func (k *Keeper) SetHedgehogUrl(url string) {
 	k.hedgehogUrl = url
 }
 
+// SetInflationCalculationFn sets the emission strategy the block provision is
+// minted with, which the Inflation and AnnualProvisions queries follow. It is
+// types.DefaultInflationCalculationFn unless set.
+func (k *Keeper) SetInflationCalculationFn(ic types.InflationCalculationFn) {
+	k.inflationCalculator = ic
+}
+
+// GetInflationCalculationFn returns the emission strategy the block provision
+// is minted with.
+func (k Keeper) GetInflationCalculationFn() types.InflationCalculationFn {
+	if k.inflationCalculator == nil {
+		return types.DefaultInflationCalculationFn
+	}
+	return k.inflationCalculator
+}
+
 // GetHedgehogUrl returns the module's hedgehog url.
 func (k *Keeper) GetHedgehogUrl() string {
 	return k.hedgehogUrl
 }
 
+// MintSource returns the source of the Hedgehog mints observed by this node.
+func (k Keeper) MintSource() types.MintSource {
+	return k.mintSource
+}
+
 // GetAuthority returns the x/mint module's authority.
 func (k Keeper) GetAuthority() string {
 	return k.authority
@@ -152,17 +181,19 @@ func (k Keeper) GetNextAccountNumber(ctx context.Context) (uint64, error) {
 	return k.authKeeper.NextAccountNumber(ctx), nil
 }
 
+// SetMintRecord stores the record of a mint. Every account minted to at a
+// block height gets its own record.
 func (k Keeper) SetMintRecord(ctx sdk.Context, record types.MintRecord) error {
 	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), []byte("MintRecordPrefix"))
-	key := []byte(fmt.Sprintf("mintRecord:%d", record.BlockHeight))
+	key := []byte(fmt.Sprintf("mintRecord:%d:%s", record.BlockHeight, record.Account))
 	value := k.cdc.MustMarshal(&record)
 	store.Set(key, value)
 	return nil
 }
 
-func (k Keeper) GetMintRecord(ctx sdk.Context, blockHeight int64) (types.MintRecord, bool) {
+func (k Keeper) GetMintRecord(ctx sdk.Context, blockHeight int64, account string) (types.MintRecord, bool) {
 	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), []byte("MintRecordPrefix"))
-	key := []byte(fmt.Sprintf("mintRecord:%d", blockHeight))
+	key := []byte(fmt.Sprintf("mintRecord:%d:%s", blockHeight, account))
 	value := store.Get(key)
 	if value == nil {
 		return types.MintRecord{}, false
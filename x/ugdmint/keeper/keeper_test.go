@@ -0,0 +1,162 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/unigrid-project/cosmos-ugdmint/x/ugdmint/keeper"
+	"github.com/unigrid-project/cosmos-ugdmint/x/ugdmint/types"
+)
+
+// unregisteredModule names a module account that the accountKeeper does not
+// know.
+const unregisteredModule = "unregistered"
+
+// accountKeeper knows the module addresses the keeper asks for on
+// construction and the module accounts asked for by InitGenesis, and keeps
+// the accounts that are set in memory; every other method panics.
+type accountKeeper struct {
+	types.AccountKeeper
+
+	accounts map[string]sdk.AccountI
+}
+
+func (accountKeeper) GetModuleAddress(name string) sdk.AccAddress {
+	if name == unregisteredModule {
+		return nil
+	}
+	return authtypes.NewModuleAddress(name)
+}
+
+func (accountKeeper) GetModuleAccount(_ context.Context, name string) sdk.ModuleAccountI {
+	return authtypes.NewEmptyModuleAccount(name)
+}
+
+func (a accountKeeper) GetAccount(_ context.Context, addr sdk.AccAddress) sdk.AccountI {
+	return a.accounts[addr.String()]
+}
+
+func (a accountKeeper) SetAccount(_ context.Context, acc sdk.AccountI) {
+	a.accounts[acc.GetAddress().String()] = acc
+}
+
+// stakingKeeper reports a bonded ratio of 67% of a staking supply of
+// stakingSupply.
+type stakingKeeper struct{}
+
+const stakingSupply = 1_000_000_000
+
+func (stakingKeeper) StakingTokenSupply(context.Context) (math.Int, error) {
+	return math.NewInt(stakingSupply), nil
+}
+
+func (stakingKeeper) BondedRatio(context.Context) (math.LegacyDec, error) {
+	return math.LegacyNewDecWithPrec(67, 2), nil
+}
+
+// bankKeeper records the coins the module mints, burns and sends to other
+// modules and accounts and reports supply as the total supply; every other
+// method panics.
+type bankKeeper struct {
+	types.BankKeeper
+
+	minted sdk.Coins
+	burned sdk.Coins
+	sent   map[string]sdk.Coins
+	supply sdk.Coins
+}
+
+func (b *bankKeeper) GetSupply(_ context.Context, denom string) sdk.Coin {
+	return sdk.NewCoin(denom, b.supply.AmountOf(denom))
+}
+
+func (b *bankKeeper) MintCoins(_ context.Context, _ string, amt sdk.Coins) error {
+	b.minted = b.minted.Add(amt...)
+	return nil
+}
+
+func (b *bankKeeper) BurnCoins(_ context.Context, _ string, amt sdk.Coins) error {
+	b.burned = b.burned.Add(amt...)
+	return nil
+}
+
+func (b *bankKeeper) SendCoinsFromModuleToModule(_ context.Context, _, recipientModule string, amt sdk.Coins) error {
+	b.sent[recipientModule] = b.sent[recipientModule].Add(amt...)
+	return nil
+}
+
+func (b *bankKeeper) SendCoinsFromAccountToModule(_ context.Context, _ sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	b.sent[recipientModule] = b.sent[recipientModule].Add(amt...)
+	return nil
+}
+
+func (b *bankKeeper) SendCoinsFromModuleToAccount(_ context.Context, _ string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	b.sent[recipientAddr.String()] = b.sent[recipientAddr.String()].Add(amt...)
+	return nil
+}
+
+// distrKeeper records the coins the community pool is funded with.
+type distrKeeper struct {
+	communityPool sdk.Coins
+}
+
+func (d *distrKeeper) FundCommunityPool(_ context.Context, amount sdk.Coins, _ sdk.AccAddress) error {
+	d.communityPool = d.communityPool.Add(amount...)
+	return nil
+}
+
+// setupKeeper returns a keeper backed by an in-memory store and its context.
+// The keeper has no distribution keeper and a bank keeper without any supply,
+// it must only be used for state that lives in the module store.
+func setupKeeper(t *testing.T) (keeper.Keeper, sdk.Context) {
+	t.Helper()
+
+	return newTestKeeper(t, &bankKeeper{sent: make(map[string]sdk.Coins)}, nil)
+}
+
+// setupPayoutKeeper returns a keeper like setupKeeper that records the coins it
+// mints and pays out in the returned bank and distribution keepers.
+func setupPayoutKeeper(t *testing.T) (keeper.Keeper, sdk.Context, *bankKeeper, *distrKeeper) {
+	t.Helper()
+
+	bk := &bankKeeper{sent: make(map[string]sdk.Coins)}
+	dk := &distrKeeper{}
+	k, ctx := newTestKeeper(t, bk, dk)
+
+	return k, ctx, bk, dk
+}
+
+func newTestKeeper(t *testing.T, bk types.BankKeeper, dk types.DistributionKeeper) (keeper.Keeper, sdk.Context) {
+	t.Helper()
+
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	k := keeper.NewKeeper(
+		cdc,
+		runtime.NewKVStoreService(key),
+		stakingKeeper{},
+		accountKeeper{accounts: make(map[string]sdk.AccountI)},
+		bk,
+		dk,
+		nil,
+		authtypes.FeeCollectorName,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	if err := k.SetParams(testCtx.Ctx, types.DefaultParams()); err != nil {
+		t.Fatal(err)
+	}
+
+	return k, testCtx.Ctx
+}
@@ -1,7 +1,10 @@
 package keeper
 
 import (
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/unigrid-project/cosmos-ugdmint/x/ugdmint/exported"
+	v2 "github.com/unigrid-project/cosmos-ugdmint/x/ugdmint/migrations/v2"
 )
 
 // Migrator is a struct for handling in-place state migrations.
@@ -17,3 +20,17 @@ func NewMigrator(k Keeper, ss exported.Subspace) Migrator {
 		legacySubspace: ss,
 	}
 }
+
+// Migrate1to2 migrates the x/ugdmint module state from the consensus version 1
+// to version 2. Specifically, it fills in the params added since version 1
+// with their defaults and starts counting the supply of the mint denom
+// against the max_supply param from its current supply.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	store := runtime.KVStoreAdapter(m.keeper.storeService.OpenKVStore(ctx))
+	if err := v2.Migrate(ctx, store, m.legacySubspace, m.keeper.cdc); err != nil {
+		return err
+	}
+
+	m.keeper.SeedTotalMinted(ctx, m.keeper.GetParams(ctx))
+	return nil
+}
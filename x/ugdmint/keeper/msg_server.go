@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
 	"cosmossdk.io/errors"
 
@@ -29,11 +30,124 @@ func (ms msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdatePara
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := ms.validateParamsAccounts(req.Params); err != nil {
+		return nil, err
+	}
 	if err := ms.SetParams(ctx, req.Params); err != nil {
 		return nil, err
 	}
+	ms.SeedTotalMinted(ctx, req.Params)
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
 
-var _ types.MsgServer = msgServer{}
+// SetMintingPaused pauses or resumes the block provisions and the Hedgehog
+// mints.
+func (ms msgServer) SetMintingPaused(goCtx context.Context, req *types.MsgSetMintingPaused) (*types.MsgSetMintingPausedResponse, error) {
+	if ms.authority != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	pause := req.Pause()
+	if err := pause.Validate(); err != nil {
+		return nil, errors.Wrap(types.ErrInvalidResumeHeight, err.Error())
+	}
+	if pause.ResumeHeight != 0 && pause.ResumeHeight <= ctx.BlockHeight() {
+		return nil, errors.Wrapf(types.ErrInvalidResumeHeight, "resume height %d must be after the current height %d", pause.ResumeHeight, ctx.BlockHeight())
+	}
+
+	ms.SetMintingPause(ctx, pause)
+
+	return &types.MsgSetMintingPausedResponse{}, nil
+}
+
+// ScheduleParamsChange schedules the params to be applied at a future height.
+func (ms msgServer) ScheduleParamsChange(goCtx context.Context, req *types.MsgScheduleParamsChange) (*types.MsgScheduleParamsChangeResponse, error) {
+	if ms.authority != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	change := req.Change()
+	if err := change.Validate(); err != nil {
+		return nil, err
+	}
+	if err := ms.validateParamsAccounts(change.Params); err != nil {
+		return nil, err
+	}
+	if change.ActivationHeight <= ctx.BlockHeight() {
+		return nil, errors.Wrapf(types.ErrInvalidActivationHeight, "activation height %d must be after the current height %d", change.ActivationHeight, ctx.BlockHeight())
+	}
+
+	ms.SetScheduledParamsChange(ctx, change)
+
+	return &types.MsgScheduleParamsChangeResponse{}, nil
+}
+
+// CancelParamsChange removes a scheduled params change before it is applied.
+func (ms msgServer) CancelParamsChange(goCtx context.Context, req *types.MsgCancelParamsChange) (*types.MsgCancelParamsChangeResponse, error) {
+	if ms.authority != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := ms.GetScheduledParamsChange(ctx, req.ActivationHeight); !found {
+		return nil, errors.Wrapf(types.ErrParamsChangeNotFound, "height %d", req.ActivationHeight)
+	}
+
+	ms.DeleteScheduledParamsChange(ctx, req.ActivationHeight)
+
+	return &types.MsgCancelParamsChangeResponse{}, nil
+}
+
+// ClaimVested pays the recipient the escrowed coins of its Hedgehog mints that
+// have unlocked.
+func (ms msgServer) ClaimVested(goCtx context.Context, req *types.MsgClaimVested) (*types.MsgClaimVestedResponse, error) {
+	recipient, err := sdk.AccAddressFromBech32(req.Recipient)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid recipient address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	claimed, err := ms.ClaimEscrow(ctx, recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEscrowClaimed,
+			sdk.NewAttribute(types.AttributeKeyRecipient, req.Recipient),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, claimed.String()),
+		),
+	)
+
+	return &types.MsgClaimVestedResponse{Amount: claimed}, nil
+}
+
+// ClawbackMint takes the unvested coins of a Hedgehog mint back from its
+// recipient and sends them to the community pool or burns them.
+func (ms msgServer) ClawbackMint(goCtx context.Context, req *types.MsgClawbackMint) (*types.MsgClawbackMintResponse, error) {
+	if ms.authority != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	clawback, err := ms.ClawbackUnvestedMint(ctx, req.MintKey(), req.Destination)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMintClawedBack,
+			sdk.NewAttribute(types.AttributeKeyRecipient, req.Address),
+			sdk.NewAttribute(types.AttributeKeyMintID, fmt.Sprint(req.MintId)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, clawback.String()),
+			sdk.NewAttribute(types.AttributeKeyDestination, req.Destination),
+		),
+	)
+
+	return &types.MsgClawbackMintResponse{Amount: clawback}, nil
+}
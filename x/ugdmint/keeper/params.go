@@ -3,6 +3,8 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/errors"
+
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/unigrid-project/cosmos-ugdmint/x/ugdmint/types"
 )
@@ -30,3 +32,32 @@ func (k Keeper) SetParams(ctx context.Context, params types.Params) error {
 
 	return nil
 }
+
+// validateParamsAccounts checks that the accounts the params pay to exist in
+// this app: every module account of the distribution split must be
+// registered with the auth module, and so must the escrow module account when
+// the vesting policy escrows the mints. Funding the community pool, through
+// the split or the excess of the bonded adjustment, needs the distribution
+// keeper. Params.Validate cannot check this as it does not know the app.
+func (k Keeper) validateParamsAccounts(params types.Params) error {
+	if params.VestingPolicy.Escrow && k.authKeeper.GetModuleAddress(types.EscrowAccountName) == nil {
+		return errors.Wrapf(types.ErrUnknownModuleAccount, "vesting policy escrows the mints in %s", types.EscrowAccountName)
+	}
+
+	fundsCommunityPool := params.BondedAdjustmentEnabled && params.ExcessDestination == types.ExcessDestinationCommunityPool
+	for _, destination := range params.DistributionSplit {
+		switch destination.Kind {
+		case types.DestinationModuleAccount:
+			if k.authKeeper.GetModuleAddress(destination.Recipient) == nil {
+				return errors.Wrapf(types.ErrUnknownModuleAccount, "distribution split pays %s", destination.Recipient)
+			}
+		case types.DestinationCommunityPool:
+			fundsCommunityPool = true
+		}
+	}
+	if fundsCommunityPool && k.distrKeeper == nil {
+		return types.ErrNoDistributionKeeper
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	"cosmossdk.io/math"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/unigrid-project/cosmos-ugdmint/x/ugdmint/keeper"
+	"github.com/unigrid-project/cosmos-ugdmint/x/ugdmint/types"
+)
+
+func TestUpdateParamsAccounts(t *testing.T) {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	split := func(kind, recipient string) []types.DistributionDestination {
+		return []types.DistributionDestination{
+			{Kind: types.DestinationFeeCollector, Weight: math.LegacyNewDecWithPrec(50, 2)},
+			{Kind: kind, Recipient: recipient, Weight: math.LegacyNewDecWithPrec(50, 2)},
+		}
+	}
+
+	cases := map[string]struct {
+		withDistrKeeper bool
+		update          func(p *types.Params)
+		err             error
+	}{
+		"registered module account": {false, func(p *types.Params) { p.DistributionSplit = split(types.DestinationModuleAccount, "gridnode") }, nil},
+		"unregistered module account": {
+			true, func(p *types.Params) { p.DistributionSplit = split(types.DestinationModuleAccount, unregisteredModule) },
+			types.ErrUnknownModuleAccount,
+		},
+		"community pool": {true, func(p *types.Params) { p.DistributionSplit = split(types.DestinationCommunityPool, "") }, nil},
+		"community pool without distribution keeper": {
+			false, func(p *types.Params) { p.DistributionSplit = split(types.DestinationCommunityPool, "") },
+			types.ErrNoDistributionKeeper,
+		},
+		"excess to the community pool without distribution keeper": {
+			false, func(p *types.Params) { p.BondedAdjustmentEnabled = true },
+			types.ErrNoDistributionKeeper,
+		},
+		"excess burned without distribution keeper": {
+			false, func(p *types.Params) {
+				p.BondedAdjustmentEnabled = true
+				p.ExcessDestination = types.ExcessDestinationBurn
+			},
+			nil,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			k, ctx := setupKeeper(t)
+			if c.withDistrKeeper {
+				k, ctx, _, _ = setupPayoutKeeper(t)
+			}
+			ctx = ctx.WithBlockHeight(100)
+			ms := keeper.NewMsgServerImpl(k)
+
+			params := types.DefaultParams()
+			c.update(&params)
+
+			_, err := ms.UpdateParams(ctx, &types.MsgUpdateParams{Authority: authority, Params: params})
+			if !errors.Is(err, c.err) {
+				t.Fatalf("expected %v updating the params, got %v", c.err, err)
+			}
+			_, err = ms.ScheduleParamsChange(ctx, &types.MsgScheduleParamsChange{Authority: authority, ActivationHeight: 200, Params: params})
+			if !errors.Is(err, c.err) {
+				t.Fatalf("expected %v scheduling the params, got %v", c.err, err)
+			}
+		})
+	}
+}
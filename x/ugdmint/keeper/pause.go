@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/unigrid-project/cosmos-ugdmint/x/ugdmint/types"
+)
+
+// SetMintingPause stores the kinds of minting that are paused. Pausing nothing
+// removes the pause from the store.
+func (k Keeper) SetMintingPause(ctx context.Context, pause types.MintingPause) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	if !pause.IsPaused() {
+		store.Delete(types.MintingPauseKey)
+		return
+	}
+
+	b := k.cdc.MustMarshal(&pause)
+	store.Set(types.MintingPauseKey, b)
+}
+
+// GetMintingPause returns the kinds of minting that are paused. Nothing is
+// paused when no pause is stored.
+func (k Keeper) GetMintingPause(ctx context.Context) (pause types.MintingPause) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	b := store.Get(types.MintingPauseKey)
+	if b == nil {
+		return pause
+	}
+
+	k.cdc.MustUnmarshal(b, &pause)
+	return pause
+}
@@ -0,0 +1,131 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/unigrid-project/cosmos-ugdmint/x/ugdmint/keeper"
+	"github.com/unigrid-project/cosmos-ugdmint/x/ugdmint/types"
+)
+
+func TestSetMintingPaused(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	ctx = ctx.WithBlockHeight(100)
+	ms := keeper.NewMsgServerImpl(k)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	msg := &types.MsgSetMintingPaused{
+		Authority:           authority,
+		HedgehogMintsPaused: true,
+		ResumeHeight:        200,
+	}
+	if _, err := ms.SetMintingPaused(ctx, msg); err != nil {
+		t.Fatal(err)
+	}
+	if pause := k.GetMintingPause(ctx); pause != msg.Pause() {
+		t.Fatalf("expected the pause %v to be stored, got %v", msg.Pause(), pause)
+	}
+
+	// pausing nothing lifts the pause
+	if _, err := ms.SetMintingPaused(ctx, &types.MsgSetMintingPaused{Authority: authority}); err != nil {
+		t.Fatal(err)
+	}
+	if pause := k.GetMintingPause(ctx); pause.IsPaused() {
+		t.Fatalf("expected nothing to be paused, got %v", pause)
+	}
+}
+
+func TestSetMintingPausedInvalid(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	ctx = ctx.WithBlockHeight(100)
+	ms := keeper.NewMsgServerImpl(k)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	cases := map[string]struct {
+		msg types.MsgSetMintingPaused
+		err error
+	}{
+		"wrong authority": {
+			types.MsgSetMintingPaused{Authority: authtypes.NewModuleAddress(types.ModuleName).String(), BlockProvisionsPaused: true},
+			govtypes.ErrInvalidSigner,
+		},
+		"resume height reached": {
+			types.MsgSetMintingPaused{Authority: authority, BlockProvisionsPaused: true, ResumeHeight: 100},
+			types.ErrInvalidResumeHeight,
+		},
+		"negative resume height": {
+			types.MsgSetMintingPaused{Authority: authority, BlockProvisionsPaused: true, ResumeHeight: -1},
+			types.ErrInvalidResumeHeight,
+		},
+		"resume height without pause": {
+			types.MsgSetMintingPaused{Authority: authority, ResumeHeight: 200},
+			types.ErrInvalidResumeHeight,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			if _, err := ms.SetMintingPaused(ctx, &c.msg); !errors.Is(err, c.err) {
+				t.Fatalf("expected %v, got %v", c.err, err)
+			}
+			if pause := k.GetMintingPause(ctx); pause.IsPaused() {
+				t.Fatalf("expected nothing to be paused, got %v", pause)
+			}
+		})
+	}
+}
+
+func TestMintingPauseGenesis(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	k.SetMinter(ctx, types.DefaultInitialMinter())
+
+	pause := types.MintingPause{BlockProvisionsPaused: true, ResumeHeight: 50}
+	k.SetMintingPause(ctx, pause)
+
+	genesis := k.ExportGenesis(ctx)
+	if genesis.MintingPause == nil || *genesis.MintingPause != pause {
+		t.Fatalf("expected the pause %v to be exported, got %v", pause, genesis.MintingPause)
+	}
+
+	k.SetMintingPause(ctx, types.MintingPause{})
+	if genesis := k.ExportGenesis(ctx); genesis.MintingPause != nil {
+		t.Fatalf("expected no pause to be exported, got %v", genesis.MintingPause)
+	}
+}
+
+func TestHeldAgreedMintsGenesis(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	k.SetMinter(ctx, types.DefaultInitialMinter())
+
+	recipient := sdk.AccAddress("recipient___________").String()
+	held := []types.AgreedMints{
+		{Height: 10, Mints: []types.HedgehogMint{{Address: recipient, Height: 10, Amount: 1000}}},
+		{Height: 11, Mints: []types.HedgehogMint{{Address: recipient, Height: 11, Amount: 500}}},
+	}
+	for _, agreed := range held {
+		k.SetAgreedMints(ctx, agreed)
+	}
+
+	genesis := k.ExportGenesis(ctx)
+	if len(genesis.AgreedMints) != 2 || genesis.AgreedMints[0].Height != 10 || genesis.AgreedMints[1].Height != 11 {
+		t.Fatalf("expected the held mints to be exported by height, got %v", genesis.AgreedMints)
+	}
+	if err := types.ValidateGenesis(*genesis); err != nil {
+		t.Fatal(err)
+	}
+
+	genesis.AgreedMints = append(genesis.AgreedMints, held[0])
+	if err := types.ValidateGenesis(*genesis); err == nil {
+		t.Fatal("expected duplicate agreed mints to be rejected")
+	}
+
+	imported, importCtx := setupKeeper(t)
+	genesis.AgreedMints = genesis.AgreedMints[:2]
+	imported.InitGenesis(importCtx, accountKeeper{}, genesis)
+	if all := imported.GetAllAgreedMints(importCtx); len(all) != 2 {
+		t.Fatalf("expected the held mints to be imported, got %v", all)
+	}
+}
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"context"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/unigrid-project/cosmos-ugdmint/x/ugdmint/types"
+)
+
+// SetProcessedMint records that a Hedgehog mint has been paid out.
+func (k Keeper) SetProcessedMint(ctx context.Context, mint types.ProcessedMint) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	b := k.cdc.MustMarshal(&mint)
+	store.Set(types.ProcessedMintKey(mint.Key), b)
+}
+
+// GetProcessedMint returns the processed mint with the given hedgehog key.
+func (k Keeper) GetProcessedMint(ctx context.Context, hedgehogKey string) (mint types.ProcessedMint, found bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	b := store.Get(types.ProcessedMintKey(hedgehogKey))
+	if b == nil {
+		return mint, false
+	}
+
+	k.cdc.MustUnmarshal(b, &mint)
+	return mint, true
+}
+
+// HasProcessedMint reports whether the Hedgehog mint with the given hedgehog
+// key has already been paid out.
+func (k Keeper) HasProcessedMint(ctx context.Context, hedgehogKey string) bool {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return store.Has(types.ProcessedMintKey(hedgehogKey))
+}
+
+// GetAllProcessedMints returns every processed mint.
+func (k Keeper) GetAllProcessedMints(ctx context.Context) (mints []types.ProcessedMint) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, types.ProcessedMintKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var mint types.ProcessedMint
+		k.cdc.MustUnmarshal(iterator.Value(), &mint)
+		mints = append(mints, mint)
+	}
+
+	return mints
+}
+
+// GetProcessedMintsOf returns the processed mints of an address.
+func (k Keeper) GetProcessedMintsOf(ctx context.Context, address string) (mints []types.ProcessedMint) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, types.ProcessedMintKey(address+"/"))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var mint types.ProcessedMint
+		k.cdc.MustUnmarshal(iterator.Value(), &mint)
+		mints = append(mints, mint)
+	}
+
+	return mints
+}
@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/unigrid-project/cosmos-ugdmint/x/ugdmint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// EmissionProjection projects the block provisions and the supply of the mint
+// denom over a range of future heights. It only reads state.
+func (k Keeper) EmissionProjection(goCtx context.Context, req *types.QueryEmissionProjectionRequest) (*types.QueryEmissionProjectionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+
+	blockTimeSeconds := req.BlockTimeSeconds
+	if blockTimeSeconds == 0 {
+		blockTimeSeconds = types.DefaultBlockTimeSeconds(params)
+	}
+
+	currentHeight := uint64(ctx.BlockHeight())
+	startHeight, endHeight := req.StartHeight, req.EndHeight
+	if req.StartTime != nil || req.EndTime != nil {
+		if startHeight != 0 || endHeight != 0 {
+			return nil, status.Error(codes.InvalidArgument, "a range is given either by heights or by times")
+		}
+		if req.EndTime == nil {
+			return nil, status.Error(codes.InvalidArgument, "end time must be set")
+		}
+
+		// Block current + n is produced n block times after the current block
+		blocksUntil := func(t time.Time, roundUp bool) (uint64, error) {
+			if !t.After(ctx.BlockTime()) {
+				return 0, status.Error(codes.InvalidArgument, fmt.Sprintf("%s is not after the current block time %s", t, ctx.BlockTime()))
+			}
+			seconds := uint64(t.Sub(ctx.BlockTime()) / time.Second)
+			blocks := seconds / blockTimeSeconds
+			if roundUp && seconds%blockTimeSeconds != 0 {
+				blocks++
+			}
+			return blocks, nil
+		}
+
+		if req.StartTime != nil {
+			blocks, err := blocksUntil(*req.StartTime, true)
+			if err != nil {
+				return nil, err
+			}
+			startHeight = currentHeight + max(blocks, 1)
+		}
+		blocks, err := blocksUntil(*req.EndTime, false)
+		if err != nil {
+			return nil, err
+		}
+		endHeight = currentHeight + blocks
+	}
+
+	if startHeight == 0 {
+		startHeight = currentHeight + 1
+	}
+	if endHeight == 0 {
+		return nil, status.Error(codes.InvalidArgument, "end height must be set")
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, params.MintDenom)
+	epochs, err := k.GetMinter(ctx).ProjectEmission(params, supply, k.GetTotalMinted(ctx),
+		currentHeight+1, startHeight, endHeight, blockTimeSeconds)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryEmissionProjectionResponse{
+		CurrentHeight:    currentHeight,
+		CurrentSupply:    supply,
+		BlockTimeSeconds: blockTimeSeconds,
+		Epochs:           epochs,
+	}, nil
+}
@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/unigrid-project/cosmos-ugdmint/x/ugdmint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Claimable returns the escrowed coins of a recipient, along with the coins
+// that have unlocked and can be claimed.
+func (k Keeper) Claimable(goCtx context.Context, req *types.QueryClaimableRequest) (*types.QueryClaimableResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	now := ctx.BlockTime().Unix()
+	schedule, _ := k.GetEscrowSchedule(ctx, addr)
+
+	return &types.QueryClaimableResponse{
+		Claimable:      schedule.Claimable(now),
+		Locked:         schedule.Locked(now),
+		EscrowSchedule: schedule,
+	}, nil
+}
+
+// EscrowSchedules returns the escrowed coins of every recipient.
+func (k Keeper) EscrowSchedules(goCtx context.Context, req *types.QueryEscrowSchedulesRequest) (*types.QueryEscrowSchedulesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.EscrowScheduleKeyPrefix)
+
+	var schedules []types.EscrowSchedule
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var schedule types.EscrowSchedule
+		if err := k.cdc.Unmarshal(value, &schedule); err != nil {
+			return err
+		}
+		schedules = append(schedules, schedule)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryEscrowSchedulesResponse{EscrowSchedules: schedules, Pagination: pageRes}, nil
+}
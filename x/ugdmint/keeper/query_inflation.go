@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/unigrid-project/cosmos-ugdmint/x/ugdmint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Inflation returns the current annual inflation rate of the mint denom under
+// the configured emission strategy.
+func (k Keeper) Inflation(goCtx context.Context, req *types.QueryInflationRequest) (*types.QueryInflationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	inflation, _, err := k.inflationAndAnnualProvisions(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryInflationResponse{Inflation: inflation}, nil
+}
+
+// AnnualProvisions returns the amount of the mint denom the block provisions
+// currently pay over a year under the configured emission strategy.
+func (k Keeper) AnnualProvisions(goCtx context.Context, req *types.QueryAnnualProvisionsRequest) (*types.QueryAnnualProvisionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	_, annualProvisions, err := k.inflationAndAnnualProvisions(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAnnualProvisionsResponse{AnnualProvisions: annualProvisions}, nil
+}
+
+// inflationAndAnnualProvisions runs the configured emission strategy on a copy
+// of the minter for a block as long as the last one observed, or as the block
+// time the params are calibrated for before the second block. A strategy that
+// keeps annual provisions in the minter, like the bonded-ratio strategy,
+// reports them along with its inflation rate. The provision of any other
+// strategy, like the UGD algorithm, is extrapolated over a year and divided by
+// the bank supply of the mint denom.
+func (k Keeper) inflationAndAnnualProvisions(ctx sdk.Context) (inflation, annualProvisions math.LegacyDec, err error) {
+	params := k.GetParams(ctx)
+	minter := k.GetMinter(ctx)
+
+	bondedRatio, err := k.BondedRatio(ctx)
+	if err != nil {
+		return inflation, annualProvisions, err
+	}
+
+	blockTimeSeconds := minter.ObservedBlockTimeSeconds
+	if blockTimeSeconds == 0 {
+		blockTimeSeconds = types.DefaultBlockTimeSeconds(params)
+	}
+	delta := min(max(blockTimeSeconds, params.MinBlockTimeDeltaSeconds), params.MaxBlockTimeDeltaSeconds)
+
+	minter.AnnualProvisions = math.LegacyDec{}
+	provision, err := k.GetInflationCalculationFn()(ctx, &minter, params, bondedRatio, delta)
+	if err != nil {
+		return inflation, annualProvisions, err
+	}
+	if !minter.AnnualProvisions.IsNil() {
+		return minter.Inflation, minter.AnnualProvisions, nil
+	}
+
+	annualProvisions = math.LegacyNewDecFromInt(provision.AmountOf(params.MintDenom)).
+		MulInt64(types.SecondsPerYear).
+		QuoInt64(int64(blockTimeSeconds))
+
+	supply := k.bankKeeper.GetSupply(ctx, params.MintDenom)
+	if !supply.Amount.IsPositive() {
+		return math.LegacyZeroDec(), annualProvisions, nil
+	}
+	return annualProvisions.QuoInt(supply.Amount), annualProvisions, nil
+}
@@ -0,0 +1,95 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/unigrid-project/cosmos-ugdmint/x/ugdmint/types"
+)
+
+func TestInflationQueries(t *testing.T) {
+	k, ctx, bk, _ := setupPayoutKeeper(t)
+	ctx = ctx.WithBlockHeight(1)
+
+	minter := types.DefaultInitialMinter()
+	minter.ObservedBlockTimeSeconds = 5
+	k.SetMinter(ctx, minter)
+
+	annual := math.LegacyNewDec(498423 * types.SecondsPerYear / 5)
+	res, err := k.AnnualProvisions(ctx, &types.QueryAnnualProvisionsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.AnnualProvisions.Equal(annual) {
+		t.Fatalf("expected annual provisions of %s, got %s", annual, res.AnnualProvisions)
+	}
+
+	// without any supply there is no inflation
+	inflation, err := k.Inflation(ctx, &types.QueryInflationRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !inflation.Inflation.IsZero() {
+		t.Fatalf("expected no inflation without supply, got %s", inflation.Inflation)
+	}
+
+	bk.supply = sdk.NewCoins(sdk.NewCoin("ugd", annual.TruncateInt().MulRaw(10)))
+	inflation, err = k.Inflation(ctx, &types.QueryInflationRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := math.LegacyNewDecWithPrec(1, 1); !inflation.Inflation.Equal(expected) {
+		t.Fatalf("expected an inflation of %s, got %s", expected, inflation.Inflation)
+	}
+
+	// annual provisions left in the minter by another strategy do not change
+	// the strategy the queries follow
+	minter.Inflation = math.LegacyNewDecWithPrec(13, 2)
+	minter.AnnualProvisions = math.LegacyNewDec(1000)
+	k.SetMinter(ctx, minter)
+	res, err = k.AnnualProvisions(ctx, &types.QueryAnnualProvisionsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.AnnualProvisions.Equal(annual) {
+		t.Fatalf("expected annual provisions of %s, got %s", annual, res.AnnualProvisions)
+	}
+}
+
+func TestInflationQueriesBondedRatioStrategy(t *testing.T) {
+	k, ctx, _, _ := setupPayoutKeeper(t)
+	ctx = ctx.WithBlockHeight(1)
+	k.SetInflationCalculationFn(types.NewBondedRatioInflationCalculationFn(func(context.Context) (math.Int, error) {
+		return math.NewInt(stakingSupply), nil
+	}))
+
+	// the rates are those the strategy computes for the next block, even
+	// before it stored any in the minter
+	minter := types.DefaultInitialMinter()
+	k.SetMinter(ctx, minter)
+	params := k.GetParams(ctx)
+	next := minter
+	next.Inflation = minter.NextInflationRate(params, math.LegacyNewDecWithPrec(67, 2))
+	annual := next.NextAnnualProvisions(params, math.NewInt(stakingSupply))
+	if !annual.IsPositive() {
+		t.Fatalf("expected positive annual provisions, got %s", annual)
+	}
+
+	inflation, err := k.Inflation(ctx, &types.QueryInflationRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !inflation.Inflation.Equal(next.Inflation) {
+		t.Fatalf("expected an inflation of %s, got %s", next.Inflation, inflation.Inflation)
+	}
+	res, err := k.AnnualProvisions(ctx, &types.QueryAnnualProvisionsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.AnnualProvisions.Equal(annual) {
+		t.Fatalf("expected annual provisions of %s, got %s", annual, res.AnnualProvisions)
+	}
+}
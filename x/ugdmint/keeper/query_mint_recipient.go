@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"context"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/unigrid-project/cosmos-ugdmint/x/ugdmint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MintRecipient returns the Hedgehog mints an address received, along with
+// their vesting and how much of them has vested at the block time.
+func (k Keeper) MintRecipient(goCtx context.Context, req *types.QueryMintRecipientRequest) (*types.QueryMintRecipientResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	now := ctx.BlockTime().Unix()
+	acc := k.GetAccount(ctx, addr)
+
+	res := &types.QueryMintRecipientResponse{
+		Mints:      []types.RecipientMint{},
+		Vested:     sdk.NewCoins(),
+		Unvested:   sdk.NewCoins(),
+		ClawedBack: sdk.NewCoins(),
+		Unlocks:    []types.EscrowUnlock{},
+	}
+	unlocks := make(map[int64]sdk.Coins)
+	for _, processed := range k.GetProcessedMintsOf(ctx, req.Address) {
+		record, found := k.GetMintRecord(ctx, processed.BlockHeight, processed.Address)
+		if !found {
+			continue
+		}
+
+		mint := types.RecipientMint{
+			Height:      processed.Height,
+			BlockHeight: processed.BlockHeight,
+			Amount:      record.Amount,
+			Timestamp:   processed.Timestamp,
+			Vesting:     record.Vesting,
+			Clawback:    record.Clawback,
+			Unvested:    sdk.NewCoins(),
+		}
+		for _, unlock := range mintUnlocks(acc, record) {
+			if unlock.UnlockTime > now {
+				mint.Unvested = mint.Unvested.Add(unlock.Amount...)
+				unlocks[unlock.UnlockTime] = unlocks[unlock.UnlockTime].Add(unlock.Amount...)
+			}
+		}
+		mint.Vested = record.Amount
+		if record.Clawback != nil {
+			mint.Vested = mint.Vested.Sub(record.Clawback.Amount...)
+			res.ClawedBack = res.ClawedBack.Add(record.Clawback.Amount...)
+		}
+		mint.Vested = mint.Vested.Sub(mint.Unvested...)
+
+		res.Mints = append(res.Mints, mint)
+		res.Vested = res.Vested.Add(mint.Vested...)
+		res.Unvested = res.Unvested.Add(mint.Unvested...)
+	}
+	sort.Slice(res.Mints, func(i, j int) bool {
+		if res.Mints[i].BlockHeight != res.Mints[j].BlockHeight {
+			return res.Mints[i].BlockHeight < res.Mints[j].BlockHeight
+		}
+		return res.Mints[i].Height < res.Mints[j].Height
+	})
+
+	for t, amount := range unlocks {
+		res.Unlocks = append(res.Unlocks, types.EscrowUnlock{UnlockTime: t, Amount: amount})
+	}
+	sort.Slice(res.Unlocks, func(i, j int) bool { return res.Unlocks[i].UnlockTime < res.Unlocks[j].UnlockTime })
+	if len(res.Unlocks) > 0 {
+		res.NextUnlockTime = res.Unlocks[0].UnlockTime
+	}
+
+	return res, nil
+}
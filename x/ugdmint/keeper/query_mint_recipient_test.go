@@ -0,0 +1,66 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/unigrid-project/cosmos-ugdmint/x/ugdmint/keeper"
+	"github.com/unigrid-project/cosmos-ugdmint/x/ugdmint/types"
+)
+
+func TestMintRecipient(t *testing.T) {
+	k, ctx, _ := setupEscrowKeeper(t)
+	recipient := sdk.AccAddress("recipient")
+
+	escrowMint(t, k, ctx, recipient, 5, 100)
+	later := ctx.WithBlockHeight(20).WithBlockTime(ctx.BlockTime().Add(50 * time.Second))
+	escrowMint(t, k, later, recipient, 6, 40)
+	escrowMint(t, k, later, sdk.AccAddress("other"), 6, 1000)
+
+	res, err := k.MintRecipient(later, &types.QueryMintRecipientRequest{Address: recipient.String()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Mints) != 2 || res.Mints[0].Height != 5 || res.Mints[1].Height != 6 || res.Mints[1].BlockHeight != 20 {
+		t.Fatalf("expected the mints of heights 5 and 6, got %v", res.Mints)
+	}
+	if !res.Mints[0].Vested.Equal(sdk.NewCoins(sdk.NewInt64Coin("uugd", 50))) || !res.Mints[1].Unvested.Equal(sdk.NewCoins(sdk.NewInt64Coin("uugd", 40))) {
+		t.Fatalf("expected 50uugd of the first mint to be vested and the second to be unvested, got %v", res.Mints)
+	}
+	if !res.Vested.Equal(sdk.NewCoins(sdk.NewInt64Coin("uugd", 50))) || !res.Unvested.Equal(sdk.NewCoins(sdk.NewInt64Coin("uugd", 90))) {
+		t.Fatalf("expected 50uugd vested and 90uugd unvested, got %s and %s", res.Vested, res.Unvested)
+	}
+	// the second unlock of the first mint and the first unlock of the second
+	// mint are at the same time
+	if len(res.Unlocks) != 2 || !res.Unlocks[0].Amount.Equal(sdk.NewCoins(sdk.NewInt64Coin("uugd", 70))) || res.NextUnlockTime != 1_000_100 {
+		t.Fatalf("expected 70uugd to unlock next at 1000100, got %v and %d", res.Unlocks, res.NextUnlockTime)
+	}
+
+	ms := keeper.NewMsgServerImpl(k)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	if _, err := ms.ClawbackMint(later, &types.MsgClawbackMint{Authority: authority, Address: recipient.String(), MintId: 5, Destination: types.DestinationBurn}); err != nil {
+		t.Fatal(err)
+	}
+	res, err = k.MintRecipient(later, &types.QueryMintRecipientRequest{Address: recipient.String()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.ClawedBack.Equal(sdk.NewCoins(sdk.NewInt64Coin("uugd", 50))) || !res.Vested.Equal(sdk.NewCoins(sdk.NewInt64Coin("uugd", 50))) || !res.Unvested.Equal(sdk.NewCoins(sdk.NewInt64Coin("uugd", 40))) {
+		t.Fatalf("expected 50uugd clawed back, 50uugd vested and 40uugd unvested, got %s, %s and %s", res.ClawedBack, res.Vested, res.Unvested)
+	}
+
+	res, err = k.MintRecipient(later, &types.QueryMintRecipientRequest{Address: sdk.AccAddress("nobody").String()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Mints) != 0 || res.NextUnlockTime != 0 {
+		t.Fatalf("expected no mints, got %v", res.Mints)
+	}
+
+	if _, err := k.MintRecipient(later, &types.QueryMintRecipientRequest{Address: "invalid"}); err == nil {
+		t.Fatal("expected an invalid address to be rejected")
+	}
+}
@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/unigrid-project/cosmos-ugdmint/x/ugdmint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Minter returns the minting state, including the running emission totals.
+func (k Keeper) Minter(goCtx context.Context, req *types.QueryMinterRequest) (*types.QueryMinterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryMinterResponse{Minter: k.GetMinter(ctx)}, nil
+}
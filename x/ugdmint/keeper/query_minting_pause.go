@@ -0,0 +1,20 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/unigrid-project/cosmos-ugdmint/x/ugdmint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MintingPause returns the kinds of minting paused by governance.
+func (k Keeper) MintingPause(goCtx context.Context, req *types.QueryMintingPauseRequest) (*types.QueryMintingPauseResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryMintingPauseResponse{MintingPause: k.GetMintingPause(ctx)}, nil
+}
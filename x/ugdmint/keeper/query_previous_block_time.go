@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/unigrid-project/cosmos-ugdmint/x/ugdmint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// PreviousBlockTime returns the time of the last block seen by the module.
+func (k Keeper) PreviousBlockTime(goCtx context.Context, req *types.QueryPreviousBlockTimeRequest) (*types.QueryPreviousBlockTimeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	res := &types.QueryPreviousBlockTimeResponse{}
+	if blockTime, found := k.GetPreviousBlockTime(ctx); found {
+		res.PreviousBlockTime = &blockTime
+	}
+
+	return res, nil
+}
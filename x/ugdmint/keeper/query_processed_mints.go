@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/unigrid-project/cosmos-ugdmint/x/ugdmint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ProcessedMints returns the Hedgehog mints that have already been paid out.
+func (k Keeper) ProcessedMints(goCtx context.Context, req *types.QueryProcessedMintsRequest) (*types.QueryProcessedMintsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.ProcessedMintKeyPrefix)
+
+	var mints []types.ProcessedMint
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var mint types.ProcessedMint
+		if err := k.cdc.Unmarshal(value, &mint); err != nil {
+			return err
+		}
+		mints = append(mints, mint)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryProcessedMintsResponse{ProcessedMints: mints, Pagination: pageRes}, nil
+}
@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/unigrid-project/cosmos-ugdmint/x/ugdmint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ScheduledParamsChanges returns the params changes that are still to be
+// applied, ordered by activation height.
+func (k Keeper) ScheduledParamsChanges(goCtx context.Context, req *types.QueryScheduledParamsChangesRequest) (*types.QueryScheduledParamsChangesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.ScheduledParamsChangeKeyPrefix)
+
+	var changes []types.ScheduledParamsChange
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var change types.ScheduledParamsChange
+		if err := k.cdc.Unmarshal(value, &change); err != nil {
+			return err
+		}
+		changes = append(changes, change)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryScheduledParamsChangesResponse{ScheduledParamsChanges: changes, Pagination: pageRes}, nil
+}
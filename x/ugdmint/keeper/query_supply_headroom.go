@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/unigrid-project/cosmos-ugdmint/x/ugdmint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SupplyHeadroom returns how much of every capped denom may still be minted.
+func (k Keeper) SupplyHeadroom(goCtx context.Context, req *types.QuerySupplyHeadroomRequest) (*types.QuerySupplyHeadroomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QuerySupplyHeadroomResponse{
+		MaxSupply:   k.GetParams(ctx).MaxSupply,
+		TotalMinted: k.GetTotalMinted(ctx),
+		Headroom:    k.GetSupplyHeadroom(ctx),
+	}, nil
+}
@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"context"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/unigrid-project/cosmos-ugdmint/x/ugdmint/types"
+)
+
+// SetScheduledParamsChange stores a params change, replacing any change
+// scheduled for the same activation height.
+func (k Keeper) SetScheduledParamsChange(ctx context.Context, change types.ScheduledParamsChange) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	b := k.cdc.MustMarshal(&change)
+	store.Set(types.ScheduledParamsChangeKey(change.ActivationHeight), b)
+}
+
+// GetScheduledParamsChange returns the params change scheduled for an
+// activation height.
+func (k Keeper) GetScheduledParamsChange(ctx context.Context, height int64) (change types.ScheduledParamsChange, found bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	b := store.Get(types.ScheduledParamsChangeKey(height))
+	if b == nil {
+		return change, false
+	}
+
+	k.cdc.MustUnmarshal(b, &change)
+	return change, true
+}
+
+// DeleteScheduledParamsChange removes the params change scheduled for an
+// activation height once it has been applied or cancelled.
+func (k Keeper) DeleteScheduledParamsChange(ctx context.Context, height int64) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Delete(types.ScheduledParamsChangeKey(height))
+}
+
+// GetAllScheduledParamsChanges returns every scheduled params change, ordered
+// by activation height.
+func (k Keeper) GetAllScheduledParamsChanges(ctx context.Context) (changes []types.ScheduledParamsChange) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, types.ScheduledParamsChangeKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var change types.ScheduledParamsChange
+		k.cdc.MustUnmarshal(iterator.Value(), &change)
+		changes = append(changes, change)
+	}
+
+	return changes
+}
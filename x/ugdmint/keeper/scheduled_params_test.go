@@ -0,0 +1,103 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	"cosmossdk.io/math"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/unigrid-project/cosmos-ugdmint/x/ugdmint/keeper"
+	"github.com/unigrid-project/cosmos-ugdmint/x/ugdmint/types"
+)
+
+func TestScheduleParamsChange(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	ctx = ctx.WithBlockHeight(100)
+	ms := keeper.NewMsgServerImpl(k)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	params := types.DefaultParams()
+	params.InflationMax = math.LegacyNewDecWithPrec(25, 2)
+	for _, height := range []int64{300, 200} {
+		msg := &types.MsgScheduleParamsChange{Authority: authority, ActivationHeight: height, Params: params}
+		if _, err := ms.ScheduleParamsChange(ctx, msg); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	res, err := k.ScheduledParamsChanges(ctx, &types.QueryScheduledParamsChangesRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.ScheduledParamsChanges) != 2 {
+		t.Fatalf("expected 2 scheduled params changes, got %v", res.ScheduledParamsChanges)
+	}
+	if res.ScheduledParamsChanges[0].ActivationHeight != 200 || res.ScheduledParamsChanges[1].ActivationHeight != 300 {
+		t.Fatalf("expected the changes to be ordered by activation height, got %v", res.ScheduledParamsChanges)
+	}
+	if !res.ScheduledParamsChanges[0].Params.InflationMax.Equal(params.InflationMax) {
+		t.Fatalf("expected the scheduled params to be stored, got %v", res.ScheduledParamsChanges[0].Params)
+	}
+
+	if _, err := ms.CancelParamsChange(ctx, &types.MsgCancelParamsChange{Authority: authority, ActivationHeight: 200}); err != nil {
+		t.Fatal(err)
+	}
+	if _, found := k.GetScheduledParamsChange(ctx, 200); found {
+		t.Fatal("expected the change at height 200 to be cancelled")
+	}
+	if _, found := k.GetScheduledParamsChange(ctx, 300); !found {
+		t.Fatal("expected the change at height 300 to remain scheduled")
+	}
+
+	_, err = ms.CancelParamsChange(ctx, &types.MsgCancelParamsChange{Authority: authority, ActivationHeight: 200})
+	if !errors.Is(err, types.ErrParamsChangeNotFound) {
+		t.Fatalf("expected %v, got %v", types.ErrParamsChangeNotFound, err)
+	}
+}
+
+func TestScheduleParamsChangeInvalid(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	ctx = ctx.WithBlockHeight(100)
+	ms := keeper.NewMsgServerImpl(k)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	invalidParams := types.DefaultParams()
+	invalidParams.MintDenom = ""
+
+	cases := map[string]struct {
+		msg types.MsgScheduleParamsChange
+		err error
+	}{
+		"wrong authority": {
+			types.MsgScheduleParamsChange{Authority: authtypes.NewModuleAddress(types.ModuleName).String(), ActivationHeight: 200, Params: types.DefaultParams()},
+			govtypes.ErrInvalidSigner,
+		},
+		"current height": {
+			types.MsgScheduleParamsChange{Authority: authority, ActivationHeight: 100, Params: types.DefaultParams()},
+			types.ErrInvalidActivationHeight,
+		},
+		"negative height": {
+			types.MsgScheduleParamsChange{Authority: authority, ActivationHeight: -1, Params: types.DefaultParams()},
+			types.ErrInvalidActivationHeight,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			if _, err := ms.ScheduleParamsChange(ctx, &c.msg); !errors.Is(err, c.err) {
+				t.Fatalf("expected %v, got %v", c.err, err)
+			}
+		})
+	}
+
+	msg := &types.MsgScheduleParamsChange{Authority: authority, ActivationHeight: 200, Params: invalidParams}
+	if _, err := ms.ScheduleParamsChange(ctx, msg); err == nil {
+		t.Fatal("expected invalid params to be rejected")
+	}
+
+	if changes := k.GetAllScheduledParamsChanges(ctx); len(changes) != 0 {
+		t.Fatalf("expected nothing to be scheduled, got %v", changes)
+	}
+}
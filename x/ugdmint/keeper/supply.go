@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/unigrid-project/cosmos-ugdmint/x/ugdmint/types"
+)
+
+// GetTotalMinted returns the supply of every denom counted against the
+// max_supply param: its supply when the module started counting it, see
+// SeedTotalMinted, plus what the module has minted since.
+func (k Keeper) GetTotalMinted(ctx context.Context) sdk.Coins {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.TotalMintedKeyPrefix)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	minted := sdk.NewCoins()
+	for ; iterator.Valid(); iterator.Next() {
+		var amount math.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		minted = minted.Add(sdk.NewCoin(string(iterator.Key()), amount))
+	}
+
+	return minted
+}
+
+// SetTotalMinted replaces the amounts the module has minted so far.
+func (k Keeper) SetTotalMinted(ctx context.Context, minted sdk.Coins) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.TotalMintedKeyPrefix)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	for _, coin := range minted {
+		k.setTotalMintedOf(ctx, coin)
+	}
+}
+
+// AddTotalMinted adds freshly minted coins to the amounts minted so far.
+func (k Keeper) AddTotalMinted(ctx context.Context, coins sdk.Coins) {
+	for _, coin := range coins {
+		k.setTotalMintedOf(ctx, coin.Add(sdk.NewCoin(coin.Denom, k.totalMintedOf(ctx, coin.Denom))))
+	}
+}
+
+// SubTotalMinted removes burned coins from the amounts minted so far, so that
+// they may be minted again under the max_supply param. An amount never drops
+// below zero.
+func (k Keeper) SubTotalMinted(ctx context.Context, coins sdk.Coins) {
+	for _, coin := range coins {
+		minted := k.totalMintedOf(ctx, coin.Denom)
+		k.setTotalMintedOf(ctx, sdk.NewCoin(coin.Denom, minted.Sub(math.MinInt(minted, coin.Amount))))
+	}
+}
+
+// SeedTotalMinted starts counting the mint denom and every denom capped by the
+// max_supply param of params from its supply in the bank module, unless the
+// denom is counted already. The cap then applies to the total supply, coins
+// that were in circulation before the module counted its mints included.
+func (k Keeper) SeedTotalMinted(ctx context.Context, params types.Params) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+
+	denoms := []string{params.MintDenom}
+	for _, max := range params.MaxSupply {
+		denoms = append(denoms, max.Denom)
+	}
+	for _, denom := range denoms {
+		if denom == "" || store.Has(types.TotalMintedKey(denom)) {
+			continue
+		}
+		k.setTotalMintedOf(ctx, k.bankKeeper.GetSupply(ctx, denom))
+	}
+}
+
+// CapMint reduces coins to the amount of every capped denom that may still
+// be minted before the max_supply param is reached. When the cap cut the
+// amount it returns ErrSupplyCapReached along with the coins that may still
+// be minted, which are empty once every requested denom is exhausted.
+func (k Keeper) CapMint(ctx context.Context, coins sdk.Coins) (sdk.Coins, error) {
+	maxSupply := k.GetParams(ctx).MaxSupply
+
+	capped := sdk.NewCoins()
+	reached := false
+	for _, coin := range coins {
+		if found, max := maxSupply.Find(coin.Denom); found {
+			headroom := math.MaxInt(max.Amount.Sub(k.totalMintedOf(ctx, coin.Denom)), math.ZeroInt())
+			if coin.Amount.GT(headroom) {
+				coin.Amount = headroom
+				reached = true
+			}
+		}
+		capped = capped.Add(coin)
+	}
+
+	if reached {
+		return capped, errors.Wrapf(types.ErrSupplyCapReached, "requested %s, may mint %s", coins, capped)
+	}
+	return capped, nil
+}
+
+// GetSupplyHeadroom returns the amount of every capped denom that may still be
+// minted. Denoms whose cap has been reached are listed with a zero amount.
+func (k Keeper) GetSupplyHeadroom(ctx context.Context) sdk.Coins {
+	maxSupply := k.GetParams(ctx).MaxSupply
+
+	headroom := make(sdk.Coins, 0, len(maxSupply))
+	for _, max := range maxSupply {
+		amount := math.MaxInt(max.Amount.Sub(k.totalMintedOf(ctx, max.Denom)), math.ZeroInt())
+		headroom = append(headroom, sdk.Coin{Denom: max.Denom, Amount: amount})
+	}
+
+	return headroom
+}
+
+func (k Keeper) totalMintedOf(ctx context.Context, denom string) math.Int {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.TotalMintedKey(denom))
+	if bz == nil {
+		return math.ZeroInt()
+	}
+
+	var amount math.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return amount
+}
+
+func (k Keeper) setTotalMintedOf(ctx context.Context, coin sdk.Coin) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz, err := coin.Amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(types.TotalMintedKey(coin.Denom), bz)
+}
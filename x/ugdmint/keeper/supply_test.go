@@ -0,0 +1,108 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/unigrid-project/cosmos-ugdmint/x/ugdmint/keeper"
+	"github.com/unigrid-project/cosmos-ugdmint/x/ugdmint/types"
+)
+
+func TestCapMint(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	params := k.GetParams(ctx)
+	params.MaxSupply = sdk.NewCoins(sdk.NewInt64Coin("uugd", 1000))
+	if err := k.SetParams(ctx, params); err != nil {
+		t.Fatal(err)
+	}
+
+	// denoms without a cap are never reduced
+	uncapped := sdk.NewCoins(sdk.NewInt64Coin("ugd", 5000))
+	if coins, err := k.CapMint(ctx, uncapped); err != nil || !coins.Equal(uncapped) {
+		t.Fatalf("expected uncapped coins to be minted as is, got %s, %v", coins, err)
+	}
+
+	k.AddTotalMinted(ctx, sdk.NewCoins(sdk.NewInt64Coin("uugd", 900)))
+
+	coins, err := k.CapMint(ctx, sdk.NewCoins(sdk.NewInt64Coin("uugd", 250), sdk.NewInt64Coin("ugd", 5)))
+	if !errors.Is(err, types.ErrSupplyCapReached) {
+		t.Fatalf("expected ErrSupplyCapReached, got %v", err)
+	}
+	if expected := sdk.NewCoins(sdk.NewInt64Coin("uugd", 100), sdk.NewInt64Coin("ugd", 5)); !coins.Equal(expected) {
+		t.Fatalf("expected the final mint to be reduced to %s, got %s", expected, coins)
+	}
+
+	k.AddTotalMinted(ctx, sdk.NewCoins(sdk.NewInt64Coin("uugd", 100)))
+	headroom := k.GetSupplyHeadroom(ctx)
+	if len(headroom) != 1 || headroom[0].Denom != "uugd" || !headroom[0].Amount.IsZero() {
+		t.Fatalf("expected no headroom left, got %s", headroom)
+	}
+
+	coins, err = k.CapMint(ctx, sdk.NewCoins(sdk.NewInt64Coin("uugd", 1)))
+	if !errors.Is(err, types.ErrSupplyCapReached) || !coins.Empty() {
+		t.Fatalf("expected nothing to be minted once the cap is reached, got %s, %v", coins, err)
+	}
+}
+
+func TestTotalMinted(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	if minted := k.GetTotalMinted(ctx); !minted.Empty() {
+		t.Fatalf("expected nothing minted on a new chain, got %s", minted)
+	}
+
+	k.AddTotalMinted(ctx, sdk.NewCoins(sdk.NewInt64Coin("uugd", 10), sdk.NewInt64Coin("ugd", 1)))
+	k.AddTotalMinted(ctx, sdk.NewCoins(sdk.NewInt64Coin("uugd", 5)))
+	if expected := sdk.NewCoins(sdk.NewInt64Coin("uugd", 15), sdk.NewInt64Coin("ugd", 1)); !k.GetTotalMinted(ctx).Equal(expected) {
+		t.Fatalf("expected %s minted, got %s", expected, k.GetTotalMinted(ctx))
+	}
+
+	replaced := sdk.NewCoins(sdk.NewCoin("uugd", math.NewInt(7)))
+	k.SetTotalMinted(ctx, replaced)
+	if !k.GetTotalMinted(ctx).Equal(replaced) {
+		t.Fatalf("expected %s minted after replacing the totals, got %s", replaced, k.GetTotalMinted(ctx))
+	}
+}
+
+func TestSeedTotalMinted(t *testing.T) {
+	k, ctx, bk, _ := setupPayoutKeeper(t)
+	bk.supply = sdk.NewCoins(sdk.NewInt64Coin("ugd", 5000), sdk.NewInt64Coin("uugd", 300))
+
+	// a genesis without totals counts the supply of the mint denom
+	genesis := types.DefaultGenesisState()
+	k.InitGenesis(ctx, accountKeeper{}, genesis)
+	if expected := sdk.NewCoins(sdk.NewInt64Coin("ugd", 5000)); !k.GetTotalMinted(ctx).Equal(expected) {
+		t.Fatalf("expected %s minted after the genesis, got %s", expected, k.GetTotalMinted(ctx))
+	}
+
+	// denoms already counted are kept, newly capped denoms are counted from
+	// their supply
+	k.AddTotalMinted(ctx, sdk.NewCoins(sdk.NewInt64Coin("ugd", 10)))
+	params := k.GetParams(ctx)
+	params.MaxSupply = sdk.NewCoins(sdk.NewInt64Coin("uugd", 1000))
+	ms := keeper.NewMsgServerImpl(k)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	if _, err := ms.UpdateParams(ctx, &types.MsgUpdateParams{Authority: authority, Params: params}); err != nil {
+		t.Fatal(err)
+	}
+	if expected := sdk.NewCoins(sdk.NewInt64Coin("ugd", 5010), sdk.NewInt64Coin("uugd", 300)); !k.GetTotalMinted(ctx).Equal(expected) {
+		t.Fatalf("expected %s minted after capping uugd, got %s", expected, k.GetTotalMinted(ctx))
+	}
+	if headroom := k.GetSupplyHeadroom(ctx); !headroom.Equal(sdk.NewCoins(sdk.NewInt64Coin("uugd", 700))) {
+		t.Fatalf("expected a headroom of 700uugd, got %s", headroom)
+	}
+
+	// a genesis with totals keeps them
+	genesis.TotalMinted = sdk.NewCoins(sdk.NewInt64Coin("ugd", 42))
+	imported, importedCtx, _, _ := setupPayoutKeeper(t)
+	imported.InitGenesis(importedCtx, accountKeeper{}, genesis)
+	if !imported.GetTotalMinted(importedCtx).Equal(genesis.TotalMinted) {
+		t.Fatalf("expected %s minted after the genesis, got %s", genesis.TotalMinted, imported.GetTotalMinted(importedCtx))
+	}
+}
@@ -15,18 +15,30 @@ const (
 
 var ParamsKey = []byte{0x01}
 
-// Migrate migrates the x/mint module state from the consensus version 1 to
-// version 2. Specifically, it takes the parameters that are currently stored
-// and managed by the x/params modules and stores them directly into the x/ugdmint
-// module state.
+// Migrate migrates the x/ugdmint module state from the consensus version 1 to
+// version 2. Version 1 only knew the mint denom, the subsidy halving interval,
+// the bonding goal and the blocks per year. Those are kept, from the module
+// store or, when the params are still managed by the x/params module, from the
+// legacy subspace, and every param added since is set to its default, so the
+// migrated params are valid and minting goes on.
 func Migrate(
 	ctx sdk.Context,
 	store store.KVStore,
 	legacySubspace exported.Subspace,
 	cdc codec.BinaryCodec,
 ) error {
-	var currParams types.Params
-	legacySubspace.GetParamSet(ctx, &currParams)
+	currParams := types.DefaultParams()
+	if bz := store.Get(ParamsKey); bz != nil {
+		var v1Params types.Params
+		cdc.MustUnmarshal(bz, &v1Params)
+
+		currParams.MintDenom = v1Params.MintDenom
+		currParams.SubsidyHalvingInterval = v1Params.SubsidyHalvingInterval
+		currParams.GoalBonded = v1Params.GoalBonded
+		currParams.BlocksPerYear = v1Params.BlocksPerYear
+	} else if legacySubspace != nil {
+		legacySubspace.GetParamSet(ctx, &currParams)
+	}
 
 	if err := currParams.Validate(); err != nil {
 		return err
@@ -2,11 +2,11 @@ package ugdmint
 
 import (
 	"context"
+	"errors"
 
 	"fmt"
 	"time"
 
-	"cosmossdk.io/log"
 	"cosmossdk.io/math"
 
 	"runtime/debug"
@@ -14,14 +14,14 @@ import (
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	"github.com/unigrid-project/cosmos-ugdmint/x/ugdmint/keeper"
 	"github.com/unigrid-project/cosmos-ugdmint/x/ugdmint/types"
 )
 
 var (
-	prevBlockTime = time.Now()
-	account       authtypes.BaseAccount
+	account authtypes.BaseAccount
 )
 
 type StatusResponse struct {
@@ -32,8 +32,9 @@ type StatusResponse struct {
 	} `json:"result"`
 }
 
-// BeginBlocker mints new tokens for the previous block.
-func BeginBlocker(goCtx context.Context, k keeper.Keeper) {
+// BeginBlocker mints new tokens for the previous block. The block provision is
+// calculated by the emission strategy ic.
+func BeginBlocker(goCtx context.Context, k keeper.Keeper, ic types.InflationCalculationFn) {
 	defer func() {
 		if r := recover(); r != nil {
 			fmt.Printf("recovered from panic in BeginBlocker: %v\n", r)
@@ -45,18 +46,38 @@ func BeginBlocker(goCtx context.Context, k keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 	fmt.Println("BeginBlocker: Starting")
 
+	// Remember the time of this block for the provision of the next one, even
+	// when nothing is minted
+	previousBlockTime, found := k.GetPreviousBlockTime(ctx)
+	k.SetPreviousBlockTime(ctx, ctx.BlockTime())
+
+	// Governance may have scheduled new params for this height, which apply
+	// to this block already
+	applyScheduledParamsChange(ctx, k)
+
 	// Fetch stored minter & params
 	minter := k.GetMinter(ctx)
-	if (minter == types.Minter{}) {
+	if minter.SubsidyHalvingInterval.IsNil() {
 		fmt.Println("BeginBlocker: Minter is empty")
 		return
 	}
 
 	params := k.GetParams(ctx)
-	if (params == types.Params{}) {
+	if params.MintDenom == "" {
 		fmt.Println("BeginBlocker: Params are empty")
 		return
 	}
+	if err := params.Validate(); err != nil {
+		fmt.Println("BeginBlocker: Params are invalid:", err)
+		return
+	}
+
+	if !found {
+		// The first block has no previous block and is paid for a full
+		// reference window
+		fmt.Println("BeginBlocker: No previous block time stored, paying a full reference window")
+		previousBlockTime = ctx.BlockTime().Add(-time.Duration(params.ReferenceWindowSeconds) * time.Second)
+	}
 
 	height := uint64(ctx.BlockHeight())
 	fmt.Printf("BeginBlocker: Current block height: %d\n", height)
@@ -68,28 +89,133 @@ func BeginBlocker(goCtx context.Context, k keeper.Keeper) {
 	}
 
 	minter.SubsidyHalvingInterval = params.SubsidyHalvingInterval
+	if found && ctx.BlockTime().After(previousBlockTime) {
+		minter.ObservedBlockTimeSeconds = uint64(ctx.BlockTime().Unix() - previousBlockTime.Unix())
+	}
 	k.SetMinter(goCtx, minter)
 
-	// Get the previous block time from the context
-	prevCtx := sdk.NewContext(ctx.MultiStore(), ctx.BlockHeader(), false, log.NewNopLogger()).WithBlockHeight(ctx.BlockHeight() - 1)
-	mintedCoins := minter.BlockProvision(params, height, ctx, prevCtx)
-	if mintedCoins.Empty() {
-		fmt.Println("BeginBlocker: Minted coins are empty")
+	pause := resumeMinting(ctx, k)
+
+	mintBlockProvision(ctx, k, ic, minter, params, bondedRatio, previousBlockTime, pause.BlockProvisionsPaused)
+
+	// Execute the hedgehog mints that the validators agreed on through vote
+	// extensions for this height
+	executeAgreedMints(ctx, k, pause.HedgehogMintsPaused)
+
+	fmt.Println("BeginBlocker: Completed successfully")
+}
+
+// applyScheduledParamsChange replaces the params with the params change
+// scheduled for the current height, if any.
+func applyScheduledParamsChange(ctx sdk.Context, k keeper.Keeper) {
+	change, found := k.GetScheduledParamsChange(ctx, ctx.BlockHeight())
+	if !found {
 		return
 	}
+	k.DeleteScheduledParamsChange(ctx, ctx.BlockHeight())
 
-	err2 := k.MintCoins(goCtx, mintedCoins)
-	if err2 != nil {
-		fmt.Println("BeginBlocker: Error minting coins:", err2)
+	if err := k.SetParams(ctx, change.Params); err != nil {
+		fmt.Println("BeginBlocker: Error applying the scheduled params change:", err)
 		return
 	}
+	k.SeedTotalMinted(ctx, change.Params)
 
-	err = k.AddCollectedFees(ctx, mintedCoins)
+	fmt.Printf("BeginBlocker: Applied the params change scheduled for height %d\n", change.ActivationHeight)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeParamsChangeApplied,
+			sdk.NewAttribute(types.AttributeKeyActivationHeight, fmt.Sprintf("%d", change.ActivationHeight)),
+		),
+	)
+}
+
+// resumeMinting lifts the minting pause once its resume height is reached and
+// returns the kinds of minting that remain paused for the current block.
+func resumeMinting(ctx sdk.Context, k keeper.Keeper) types.MintingPause {
+	pause := k.GetMintingPause(ctx)
+	if pause.ResumeHeight == 0 || ctx.BlockHeight() < pause.ResumeHeight {
+		return pause
+	}
+
+	fmt.Printf("BeginBlocker: Resuming minting at height %d\n", ctx.BlockHeight())
+	k.SetMintingPause(ctx, types.MintingPause{})
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMintingResumed,
+			sdk.NewAttribute(types.AttributeKeyResumeHeight, fmt.Sprintf("%d", pause.ResumeHeight)),
+		),
+	)
+
+	return types.MintingPause{}
+}
+
+// mintBlockProvision mints the block provision calculated by ic, adjusted by
+// the bonded ratio, and pays it out. While block provisions are paused it
+// only emits what would have been minted.
+func mintBlockProvision(ctx sdk.Context, k keeper.Keeper, ic types.InflationCalculationFn, minter types.Minter, params types.Params, bondedRatio math.LegacyDec, previousBlockTime time.Time, paused bool) {
+	delta, clamped := types.BlockTimeDelta(params, ctx.BlockTime(), previousBlockTime)
+	if clamped {
+		observed := ctx.BlockTime().Unix() - previousBlockTime.Unix()
+		fmt.Printf("BeginBlocker: Clamped block time delta of %ds to %ds\n", observed, delta)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeBlockTimeDeltaClamped,
+				sdk.NewAttribute(types.AttributeKeyObservedDelta, fmt.Sprintf("%d", observed)),
+				sdk.NewAttribute(types.AttributeKeyClampedDelta, fmt.Sprintf("%d", delta)),
+			),
+		)
+	}
+
+	provision, err := ic(ctx, &minter, params, bondedRatio, delta)
 	if err != nil {
-		fmt.Println("BeginBlocker: Error adding collected fees:", err)
+		fmt.Println("BeginBlocker: Error calculating the block provision:", err)
+		return
+	}
+	boost, stakers, communityPool, burned := k.AdjustBlockProvision(ctx, provision, bondedRatio)
+
+	// A paused block provision leaves the minter untouched
+	if paused {
+		unminted := boost.Add(stakers...).Add(communityPool...)
+		fmt.Println("BeginBlocker: Block provisions are paused, not minting", unminted)
+		emitMintingPaused(ctx, types.SourceBlockProvision, "", unminted)
 		return
 	}
 
+	// The provision and the running totals of the minter are only written
+	// when the whole provision has been minted and paid out
+	cacheCtx, write := ctx.CacheContext()
+
+	mintedCoins, err := capMint(cacheCtx, k, boost.Add(stakers...).Add(communityPool...))
+	if err != nil {
+		fmt.Println("BeginBlocker: Error capping the block provision:", err)
+		return
+	}
+	if mintedCoins.Empty() {
+		fmt.Println("BeginBlocker: Minted coins are empty")
+		minter.BlockSubsidy = sdk.NewCoins()
+		k.SetMinter(cacheCtx, minter)
+		write()
+		return
+	}
+
+	// The supply cap cuts the community pool before the stakers, and the
+	// coins paid through the split before the boost
+	boost = boost.Min(mintedCoins)
+	stakers = stakers.Min(mintedCoins.Sub(boost...))
+	communityPool = mintedCoins.Sub(boost...).Sub(stakers...)
+
+	mintedCoins, payouts, err := k.PayBlockProvision(cacheCtx, boost, stakers, communityPool)
+	if err != nil {
+		fmt.Println("BeginBlocker: Error paying the block provision:", err)
+		return
+	}
+
+	minter.BlockProvisionMinted = minter.BlockProvisionMinted.Add(mintedCoins...)
+	minter.BlockSubsidy = mintedCoins
+	minter.LastMintHeight = ctx.BlockHeight()
+	k.SetMinter(cacheCtx, minter)
+	write()
+
 	fmt.Println("MintedCoins:", mintedCoins)
 	for _, coin := range mintedCoins {
 		if coin.Amount.BigInt() != nil && coin.Amount.IsInt64() {
@@ -99,6 +225,31 @@ func BeginBlocker(goCtx context.Context, k keeper.Keeper) {
 		}
 	}
 
+	for _, payout := range payouts {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeProvisionDistribution,
+				sdk.NewAttribute(types.AttributeKeyDestination, payout.Destination.Kind),
+				sdk.NewAttribute(types.AttributeKeyRecipient, payout.Destination.Recipient),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, payout.Amount.String()),
+			),
+		)
+	}
+
+	if params.BondedAdjustmentEnabled {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeBondedAdjustment,
+				sdk.NewAttribute(types.AttributeKeyBondedRatio, bondedRatio.String()),
+				sdk.NewAttribute(types.AttributeKeyGoalBonded, params.GoalBonded.String()),
+				sdk.NewAttribute(types.AttributeKeyStakingBoost, boost.String()),
+				sdk.NewAttribute(types.AttributeKeyStakers, stakers.String()),
+				sdk.NewAttribute(types.AttributeKeyCommunityPool, communityPool.String()),
+				sdk.NewAttribute(types.AttributeKeyBurned, burned.String()),
+			),
+		)
+	}
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeUGDMint,
@@ -107,151 +258,263 @@ func BeginBlocker(goCtx context.Context, k keeper.Keeper) {
 			sdk.NewAttribute(sdk.AttributeKeyAmount, mintedCoins.String()),
 		),
 	)
+}
 
-	// Initialize and start the mint cache
-	fmt.Println("BeginBlocker: Initializing mint cache")
-	mc := types.GetCache()
-	if mc == nil {
-		fmt.Println("BeginBlocker: Mint cache is nil")
+// executeAgreedMints pays out the hedgehog mints the validators agreed on for
+// the current height. While Hedgehog mints are paused the agreed mints are
+// held in the store and only emitted as what would have been minted. Hedgehog
+// only offers a mint at its own height, so the held mints are paid out along
+// with the mints of the first block after Hedgehog mints are resumed.
+func executeAgreedMints(ctx sdk.Context, k keeper.Keeper, paused bool) {
+	height := ctx.BlockHeight()
+
+	if paused {
+		agreed, found := k.GetAgreedMints(ctx, height)
+		if !found {
+			return
+		}
+
+		fmt.Println("BeginBlocker: Hedgehog mints are paused, holding the agreed mints")
+		for _, mint := range agreed.Mints {
+			if !k.HasProcessedMint(ctx, mint.Key()) {
+				emitMintingPaused(ctx, types.SourceHedgehog, mint.Address, sdk.NewCoins(sdk.NewCoin("uugd", math.NewInt(mint.Amount))))
+			}
+		}
 		return
 	}
 
-	// Use mc.Read to check if mint data exists for the current height
-	mint, err := mc.Read(height)
-	if err != nil {
-		fmt.Printf("BeginBlocker: No mint data for current block height %d: %v\n", height, err)
+	held := k.GetAllAgreedMints(ctx)
+	if len(held) == 0 {
+		fmt.Printf("BeginBlocker: No agreed mints for current block height %d\n", height)
 		fmt.Println("BeginBlocker: No mint data available. Skipping minting process.")
 		return
-	} else {
-		// Process the mint if it exists for the current height
-		fmt.Println("BeginBlocker: Mint data found for current block height")
-		acc, aErr := types.ConvertStringToAcc(mint.Address)
-		if aErr != nil {
-			fmt.Println("BeginBlocker: Error converting string to account:", aErr)
-			return
-		}
-
-		account := k.GetAccount(ctx, acc)
-		fmt.Println("BeginBlocker: Retrieved account:", account)
+	}
 
-		if account == nil {
-			baseAcc := authtypes.NewBaseAccountWithAddress(acc)
-			fmt.Println("BeginBlocker: Created new base account:", baseAcc)
+	var mints []types.HedgehogMint
+	for _, agreed := range held {
+		mints = append(mints, agreed.Mints...)
+	}
+	payAgreedMints(ctx, k, mints)
 
-			accNum, err := k.GetNextAccountNumber(ctx)
-			if err != nil {
-				fmt.Println("BeginBlocker: Error getting next account number:", err)
-				return
-			}
+	// The agreed mints are only removed once every mint has been attempted,
+	// mints that failed are not retried
+	for _, agreed := range held {
+		k.DeleteAgreedMints(ctx, agreed.Height)
+	}
+}
 
-			baseAcc.SetAccountNumber(accNum)
-			endTime := ctx.BlockTime().Add(10 * 365 * 24 * time.Hour) // 10 years from now
+// payAgreedMints pays out every agreed mint that has not been paid yet. An
+// account has a single mint record and escrow tranche per block, so the mints
+// of an address are merged into one payout. This happens when the mints held
+// while Hedgehog mints were paused are paid out in the same block.
+func payAgreedMints(ctx sdk.Context, k keeper.Keeper, mints []types.HedgehogMint) {
+	var addresses []string
+	payouts := make(map[string][]types.HedgehogMint)
+	for _, mint := range mints {
+		// Never pay the same hedgehog mint twice
+		if k.HasProcessedMint(ctx, mint.Key()) {
+			fmt.Printf("BeginBlocker: Mint %s has already been processed, skipping\n", mint.Key())
+			continue
+		}
+		if _, found := payouts[mint.Address]; !found {
+			addresses = append(addresses, mint.Address)
+		}
+		payouts[mint.Address] = append(payouts[mint.Address], mint)
+	}
 
-			// Mint the coins first and include them in the original vesting
-			coins := sdk.NewCoins(sdk.NewCoin("uugd", math.NewInt(int64(mint.Amount))))
-			if err := k.MintCoins(goCtx, coins); err != nil {
-				fmt.Println("BeginBlocker: Error minting coins:", err)
-				return
-			}
+	for _, address := range addresses {
+		merged := payouts[address]
 
-			vestingAcc, err := vestingtypes.NewDelayedVestingAccount(baseAcc, coins, endTime.Unix())
-			if err != nil {
-				fmt.Println("BeginBlocker: Error creating vesting account:", err)
-				return
-			}
-			fmt.Println("BeginBlocker: Created new vesting account:", vestingAcc)
+		// Every payout is executed in a cached context that is only written
+		// when it fully succeeded, so a failing payout leaves no partial state
+		ctx, write := ctx.CacheContext()
 
-			if err := k.SetAccount(ctx, vestingAcc); err != nil {
-				fmt.Println("BeginBlocker: Error setting account:", err)
-				return
-			}
-		} else if baseAcc, ok := account.(*authtypes.BaseAccount); ok {
-			endTime := ctx.BlockTime().Add(10 * 365 * 24 * time.Hour) // 10 years from now
-			currentBalances := k.GetAllBalances(ctx, baseAcc.GetAddress())
-			if currentBalances == nil {
-				fmt.Println("BeginBlocker: Current balances are nil")
-				return
-			}
+		// Process the mints agreed on for the address
+		fmt.Println("BeginBlocker: Mint data found for current block height")
+		amount := math.ZeroInt()
+		for _, mint := range merged {
+			amount = amount.Add(math.NewInt(mint.Amount))
+		}
 
-			vestingAcc, err := vestingtypes.NewDelayedVestingAccount(baseAcc, currentBalances, endTime.Unix())
-			if err != nil {
-				fmt.Println("BeginBlocker: Error creating vesting account:", err)
-				return
-			}
-			if err := k.SetAccount(ctx, vestingAcc); err != nil {
-				fmt.Println("BeginBlocker: Error setting account:", err)
-				return
-			}
-		} else if baseAcc, ok := account.(*vestingtypes.DelayedVestingAccount); ok {
-			currentBalances := k.GetAllBalances(ctx, baseAcc.GetAddress())
-			if currentBalances == nil {
-				fmt.Println("BeginBlocker: Current balances are nil")
-				return
-			}
+		// Ensure the coins are converted to 'uugd'
+		coins, err := capMint(ctx, k, sdk.NewCoins(sdk.NewCoin("uugd", amount)))
+		if err != nil {
+			fmt.Println("BeginBlocker: Error capping the hedgehog mint:", err)
+			continue
+		}
+		if coins.Empty() {
+			fmt.Println("BeginBlocker: Coins conversion resulted in empty coins")
+			continue
+		}
 
-			startTime := ctx.BlockTime().Unix()
-			amountPerPeriod := sdk.Coins{}
-			for _, coin := range currentBalances {
-				amount := coin.Amount.Quo(math.NewInt(10))
-				amountPerPeriod = append(amountPerPeriod, sdk.NewCoin(coin.Denom, amount))
-			}
+		acc, aErr := types.ConvertStringToAcc(address)
+		if aErr != nil {
+			fmt.Println("BeginBlocker: Error converting string to account:", aErr)
+			continue
+		}
 
-			periods := vestingtypes.Periods{}
-			for i := 0; i < 10; i++ {
-				period := vestingtypes.Period{
-					Length: 60,
-					Amount: amountPerPeriod,
-				}
-				periods = append(periods, period)
+		// Escrowed mints are held by the escrow module account instead of
+		// vesting in the account of the recipient
+		policy := k.GetParams(ctx).VestingPolicy
+		vested := policy.Escrow
+		if !policy.Escrow {
+			if vested, err = vestMint(ctx, k, acc, coins); err != nil {
+				fmt.Println("BeginBlocker: Error setting up the vesting of the mint:", err)
+				continue
 			}
+		}
 
-			baseAccount := &authtypes.BaseAccount{
-				Address:       baseAcc.Address,
-				PubKey:        baseAcc.PubKey,
-				AccountNumber: baseAcc.AccountNumber,
-				Sequence:      baseAcc.Sequence,
-			}
-			vestingAcc, err := vestingtypes.NewPeriodicVestingAccount(baseAccount, currentBalances, startTime, periods)
+		// Record the unlocks of the mint, so its unvested coins can be
+		// clawed back
+		vesting := &types.MintVesting{Escrowed: policy.Escrow}
+		if vested {
+			tranche, err := policy.NewEscrowTranche(ctx.BlockHeight(), coins, ctx.BlockTime().Unix())
 			if err != nil {
-				fmt.Println("BeginBlocker: Error creating periodic vesting account:", err)
-				return
-			}
-			if err := k.SetAccount(ctx, vestingAcc); err != nil {
-				fmt.Println("BeginBlocker: Error setting account:", err)
-				return
+				fmt.Println("BeginBlocker: Error recording the vesting of the mint:", err)
+				continue
 			}
+			vesting.Unlocks = tranche.Unlocks
 		}
 
-		// Ensure the coins are converted to 'uugd'
-		coins := sdk.NewCoins(sdk.NewCoin("uugd", math.NewInt(int64(mint.Amount))))
-		if coins.Empty() {
-			fmt.Println("BeginBlocker: Coins conversion resulted in empty coins")
-			return
-		}
-
-		if err := k.MintCoins(goCtx, coins); err != nil {
+		if err := k.MintCoins(ctx, coins); err != nil {
 			fmt.Println("BeginBlocker: Error minting coins:", err)
-			return
+			continue
 		}
+		k.AddTotalMinted(ctx, coins)
 
-		if err := k.AddNewMint(ctx, coins, acc); err != nil {
+		if policy.Escrow {
+			if err := k.EscrowMint(ctx, acc, coins); err != nil {
+				fmt.Println("BeginBlocker: Error escrowing new mint:", err)
+				continue
+			}
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeMintEscrowed,
+					sdk.NewAttribute(types.AttributeKeyRecipient, address),
+					sdk.NewAttribute(sdk.AttributeKeyAmount, coins.String()),
+				),
+			)
+		} else if err := k.AddNewMint(ctx, coins, acc); err != nil {
 			fmt.Println("BeginBlocker: Error adding new mint:", err)
-			return
+			continue
 		}
 
 		mintRecord := types.MintRecord{
 			BlockHeight: ctx.BlockHeight(),
-			Account:     "target_account_address", // Replace with actual account
-			Amount:      mintedCoins,
+			Account:     address,
+			Amount:      coins,
+			Vesting:     vesting,
 		}
 
 		if err := k.SetMintRecord(ctx, mintRecord); err != nil {
 			fmt.Println("BeginBlocker: Error storing mints in the KVstore:", err)
-			return
+			continue
+		}
+
+		for _, mint := range merged {
+			k.SetProcessedMint(ctx, types.ProcessedMint{
+				Key:         mint.Key(),
+				Address:     mint.Address,
+				Height:      mint.Height,
+				Amount:      mint.Amount,
+				Timestamp:   mint.Timestamp,
+				BlockHeight: ctx.BlockHeight(),
+			})
 		}
 
+		minter := k.GetMinter(ctx)
+		minter.HedgehogMinted = minter.HedgehogMinted.Add(coins...)
+		minter.LastMintHeight = ctx.BlockHeight()
+		k.SetMinter(ctx, minter)
+
+		write()
 		fmt.Println("BeginBlocker: Mint process completed successfully")
 	}
+}
 
-	fmt.Println("BeginBlocker: Completed successfully")
+// vestMint makes the account at addr vest the coins of a Hedgehog mint under
+// the vesting_policy param, starting at the block time. Accounts that do not
+// exist yet are created, base accounts start vesting the coins and the coins
+// are merged into the schedule of vesting accounts as an additional tranche.
+// Other accounts are left as they are, and so is every existing account under
+// the vesting policy none. It returns whether the coins vest.
+func vestMint(ctx sdk.Context, k keeper.Keeper, addr sdk.AccAddress, coins sdk.Coins) (bool, error) {
+	policy := k.GetParams(ctx).VestingPolicy
+	startTime := ctx.BlockTime().Unix()
+
+	var (
+		vestingAcc sdk.AccountI
+		err        error
+	)
+	switch account := k.GetAccount(ctx, addr).(type) {
+	case nil:
+		baseAcc := authtypes.NewBaseAccountWithAddress(addr)
+		accNum, err := k.GetNextAccountNumber(ctx)
+		if err != nil {
+			return false, err
+		}
+		baseAcc.SetAccountNumber(accNum)
+		fmt.Println("BeginBlocker: Created new base account:", baseAcc)
+
+		vestingAcc, err = policy.NewVestingAccount(baseAcc, coins, startTime)
+		if err != nil {
+			return false, err
+		}
+
+	case *authtypes.BaseAccount:
+		if policy.Kind == types.VestingNone {
+			return false, nil
+		}
+		vestingAcc, err = policy.NewVestingAccount(account, coins, startTime)
+		if err != nil {
+			return false, err
+		}
+
+	case *vestingtypes.DelayedVestingAccount, *vestingtypes.ContinuousVestingAccount, *vestingtypes.PeriodicVestingAccount:
+		if policy.Kind == types.VestingNone {
+			return false, nil
+		}
+		vestingAcc, err = policy.MergeVestingAccount(account.(vestexported.VestingAccount), coins, startTime)
+		if err != nil {
+			return false, err
+		}
+
+	default:
+		fmt.Printf("BeginBlocker: Account %s of type %T cannot vest the mint\n", addr, account)
+		return false, nil
+	}
+
+	fmt.Println("BeginBlocker: Vesting the mint in account:", vestingAcc)
+	return policy.Kind != types.VestingNone, k.SetAccount(ctx, vestingAcc)
+}
+
+// emitMintingPaused emits the coins that a paused source would have minted.
+func emitMintingPaused(ctx sdk.Context, source, recipient string, coins sdk.Coins) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMintingPaused,
+			sdk.NewAttribute(types.AttributeKeySource, source),
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, coins.String()),
+		),
+	)
+}
+
+// capMint reduces coins to what may still be minted under the max_supply
+// param and emits an event when the supply cap cut the amount.
+func capMint(ctx sdk.Context, k keeper.Keeper, coins sdk.Coins) (sdk.Coins, error) {
+	capped, err := k.CapMint(ctx, coins)
+	if errors.Is(err, types.ErrSupplyCapReached) {
+		fmt.Println("BeginBlocker:", err)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSupplyCapReached,
+				sdk.NewAttribute(types.AttributeKeyRequested, coins.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, capped.String()),
+			),
+		)
+		return capped, nil
+	}
+
+	return capped, err
 }
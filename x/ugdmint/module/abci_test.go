@@ -0,0 +1,423 @@
+package ugdmint
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/unigrid-project/cosmos-ugdmint/x/ugdmint/keeper"
+	"github.com/unigrid-project/cosmos-ugdmint/x/ugdmint/types"
+)
+
+// accountKeeper keeps the accounts in memory. Every module account is known
+// unless it is listed as unregistered.
+type accountKeeper struct {
+	types.AccountKeeper
+
+	accounts          map[string]sdk.AccountI
+	nextAccountNumber uint64
+	unregistered      map[string]bool
+}
+
+func (ak *accountKeeper) GetModuleAddress(name string) sdk.AccAddress {
+	if ak.unregistered[name] {
+		return nil
+	}
+	return authtypes.NewModuleAddress(name)
+}
+
+func (ak *accountKeeper) GetModuleAccount(_ context.Context, name string) sdk.ModuleAccountI {
+	return authtypes.NewEmptyModuleAccount(name)
+}
+
+func (ak *accountKeeper) GetAccount(_ context.Context, addr sdk.AccAddress) sdk.AccountI {
+	return ak.accounts[addr.String()]
+}
+
+func (ak *accountKeeper) SetAccount(_ context.Context, acc sdk.AccountI) {
+	ak.accounts[acc.GetAddress().String()] = acc
+}
+
+func (ak *accountKeeper) NextAccountNumber(context.Context) uint64 {
+	ak.nextAccountNumber++
+	return ak.nextAccountNumber
+}
+
+// bankKeeper keeps the balances of accounts and module accounts in memory,
+// without checking what is spendable.
+type bankKeeper struct {
+	types.BankKeeper
+
+	balances map[string]sdk.Coins
+	supply   sdk.Coins
+}
+
+func (bk *bankKeeper) send(from, to sdk.AccAddress, amt sdk.Coins) error {
+	balance, hasNeg := bk.balances[from.String()].SafeSub(amt...)
+	if hasNeg {
+		return errors.Wrapf(sdkerrors.ErrInsufficientFunds, "%s is smaller than %s", bk.balances[from.String()], amt)
+	}
+	bk.balances[from.String()] = balance
+	bk.balances[to.String()] = bk.balances[to.String()].Add(amt...)
+	return nil
+}
+
+func (bk *bankKeeper) MintCoins(_ context.Context, moduleName string, amt sdk.Coins) error {
+	addr := authtypes.NewModuleAddress(moduleName).String()
+	bk.balances[addr] = bk.balances[addr].Add(amt...)
+	bk.supply = bk.supply.Add(amt...)
+	return nil
+}
+
+func (bk *bankKeeper) BurnCoins(_ context.Context, moduleName string, amt sdk.Coins) error {
+	if err := bk.send(authtypes.NewModuleAddress(moduleName), authtypes.NewModuleAddress("burned"), amt); err != nil {
+		return err
+	}
+	bk.supply = bk.supply.Sub(amt...)
+	return nil
+}
+
+func (bk *bankKeeper) SendCoinsFromModuleToAccount(_ context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	return bk.send(authtypes.NewModuleAddress(senderModule), recipientAddr, amt)
+}
+
+func (bk *bankKeeper) SendCoinsFromModuleToModule(_ context.Context, senderModule, recipientModule string, amt sdk.Coins) error {
+	return bk.send(authtypes.NewModuleAddress(senderModule), authtypes.NewModuleAddress(recipientModule), amt)
+}
+
+func (bk *bankKeeper) SendCoinsFromAccountToModule(_ context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	return bk.send(senderAddr, authtypes.NewModuleAddress(recipientModule), amt)
+}
+
+func (bk *bankKeeper) GetAllBalances(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	return bk.balances[addr.String()]
+}
+
+func (bk *bankKeeper) GetSupply(_ context.Context, denom string) sdk.Coin {
+	return sdk.NewCoin(denom, bk.supply.AmountOf(denom))
+}
+
+// balanceOf returns the balance of a module account.
+func (bk *bankKeeper) balanceOf(moduleName string) sdk.Coins {
+	return bk.balances[authtypes.NewModuleAddress(moduleName).String()]
+}
+
+// stakingKeeper reports the bonded ratio it is set to.
+type stakingKeeper struct {
+	bondedRatio math.LegacyDec
+}
+
+func (sk *stakingKeeper) StakingTokenSupply(context.Context) (math.Int, error) {
+	return math.NewInt(1_000_000_000_000), nil
+}
+
+func (sk *stakingKeeper) BondedRatio(context.Context) (math.LegacyDec, error) {
+	return sk.bondedRatio, nil
+}
+
+// distrKeeper records the coins the community pool is funded with.
+type distrKeeper struct {
+	communityPool sdk.Coins
+}
+
+func (dk *distrKeeper) FundCommunityPool(_ context.Context, amount sdk.Coins, _ sdk.AccAddress) error {
+	dk.communityPool = dk.communityPool.Add(amount...)
+	return nil
+}
+
+// testFixture is a keeper backed by an in-memory store along with the in-memory
+// keepers it depends on.
+type testFixture struct {
+	key storetypes.StoreKey
+	cdc codec.BinaryCodec
+
+	k  keeper.Keeper
+	sk *stakingKeeper
+	ak *accountKeeper
+	bk *bankKeeper
+	dk *distrKeeper
+}
+
+// setupBeginBlocker returns a fixture with the default params and the default
+// initial minter, and a context at block height 100.
+func setupBeginBlocker(t *testing.T) (testFixture, sdk.Context) {
+	t.Helper()
+
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	f := testFixture{
+		key: key,
+		cdc: cdc,
+		sk:  &stakingKeeper{bondedRatio: math.LegacyNewDecWithPrec(67, 2)},
+		ak:  &accountKeeper{accounts: make(map[string]sdk.AccountI), unregistered: make(map[string]bool)},
+		bk:  &bankKeeper{balances: make(map[string]sdk.Coins)},
+		dk:  &distrKeeper{},
+	}
+	f.k = keeper.NewKeeper(
+		cdc,
+		runtime.NewKVStoreService(key),
+		f.sk,
+		f.ak,
+		f.bk,
+		f.dk,
+		nil,
+		authtypes.FeeCollectorName,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	ctx := testCtx.Ctx.WithBlockHeight(100).WithBlockTime(time.Unix(1_700_000_000, 0))
+	if err := f.k.SetParams(ctx, types.DefaultParams()); err != nil {
+		t.Fatal(err)
+	}
+	f.k.SetMinter(ctx, types.DefaultInitialMinter())
+
+	return f, ctx
+}
+
+// nextBlock returns the context of the block after ctx, seconds later.
+func nextBlock(ctx sdk.Context, seconds int64) sdk.Context {
+	return ctx.WithBlockHeight(ctx.BlockHeight() + 1).
+		WithBlockTime(ctx.BlockTime().Add(time.Duration(seconds) * time.Second)).
+		WithEventManager(sdk.NewEventManager())
+}
+
+// beginBlock runs the BeginBlocker with the default emission strategy.
+func beginBlock(f testFixture, ctx sdk.Context) {
+	BeginBlocker(ctx, f.k, types.DefaultInflationCalculationFn)
+}
+
+// hasEvent reports whether ctx emitted an event of the given type.
+func hasEvent(ctx sdk.Context, eventType string) bool {
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+func TestBeginBlockerPersistsPreviousBlockTime(t *testing.T) {
+	f, ctx := setupBeginBlocker(t)
+
+	if _, found := f.k.GetPreviousBlockTime(ctx); found {
+		t.Fatalf("a new chain should have no previous block time")
+	}
+
+	beginBlock(f, ctx)
+	if previous, found := f.k.GetPreviousBlockTime(ctx); !found || !previous.Equal(ctx.BlockTime()) {
+		t.Fatalf("previous block time = %v (found %t), want %v", previous, found, ctx.BlockTime())
+	}
+
+	ctx = nextBlock(ctx, 5)
+	beginBlock(f, ctx)
+	if previous, _ := f.k.GetPreviousBlockTime(ctx); !previous.Equal(ctx.BlockTime()) {
+		t.Fatalf("previous block time = %v, want %v", previous, ctx.BlockTime())
+	}
+}
+
+func TestBeginBlockerPaysFirstBlockFullWindow(t *testing.T) {
+	f, ctx := setupBeginBlocker(t)
+	params := f.k.GetParams(ctx)
+	bondedRatio := math.LegacyNewDecWithPrec(67, 2)
+
+	provision := func(ctx sdk.Context, delta uint64) sdk.Coins {
+		minter := f.k.GetMinter(ctx)
+		coins, err := types.DefaultInflationCalculationFn(ctx, &minter, params, bondedRatio, delta)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return coins
+	}
+
+	// The first block has no previous block and is paid for the full window
+	want := provision(ctx, params.ReferenceWindowSeconds)
+	if !want.IsAllPositive() {
+		t.Fatalf("the first block provision should not be empty")
+	}
+	beginBlock(f, ctx)
+	if !f.bk.supply.Equal(want) {
+		t.Fatalf("first block minted %s, want %s", f.bk.supply, want)
+	}
+
+	// Later blocks are paid for the time since the previous block only
+	ctx = nextBlock(ctx, 5)
+	want = f.bk.supply.Add(provision(ctx, 5)...)
+	beginBlock(f, ctx)
+	if !f.bk.supply.Equal(want) {
+		t.Fatalf("supply after the second block is %s, want %s", f.bk.supply, want)
+	}
+
+	// A block within the same second is paid for the min block time delta
+	ctx = nextBlock(ctx, 0)
+	want = f.bk.supply.Add(provision(ctx, params.MinBlockTimeDeltaSeconds)...)
+	beginBlock(f, ctx)
+	if !f.bk.supply.Equal(want) {
+		t.Fatalf("supply after a same-second block is %s, want %s", f.bk.supply, want)
+	}
+	if !hasEvent(ctx, types.EventTypeBlockTimeDeltaClamped) {
+		t.Fatalf("no %s event emitted for a same-second block", types.EventTypeBlockTimeDeltaClamped)
+	}
+}
+
+func TestBeginBlockerBelowGoalMintsProvisionOnly(t *testing.T) {
+	f, ctx := setupBeginBlocker(t)
+	f.sk.bondedRatio = math.LegacyNewDecWithPrec(5, 1)
+
+	params := f.k.GetParams(ctx)
+	params.BondedAdjustmentEnabled = true
+	params.DistributionSplit = []types.DistributionDestination{
+		{Kind: types.DestinationFeeCollector, Weight: math.LegacyNewDecWithPrec(50, 2)},
+		{Kind: types.DestinationModuleAccount, Recipient: "gridnode", Weight: math.LegacyNewDecWithPrec(50, 2)},
+	}
+	if err := f.k.SetParams(ctx, params); err != nil {
+		t.Fatal(err)
+	}
+
+	minter := f.k.GetMinter(ctx)
+	provision, err := types.DefaultInflationCalculationFn(ctx, &minter, params, f.sk.bondedRatio, params.ReferenceWindowSeconds)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	beginBlock(f, ctx)
+
+	// Below the goal the boost is split off the provision, not minted on top
+	if !f.bk.supply.Equal(provision) {
+		t.Fatalf("minted %s, want the provision %s", f.bk.supply, provision)
+	}
+	if !hasEvent(ctx, types.EventTypeBondedAdjustment) {
+		t.Fatalf("no %s event emitted", types.EventTypeBondedAdjustment)
+	}
+	stakers := f.bk.balanceOf(authtypes.FeeCollectorName)
+	if gridnode := f.bk.balanceOf("gridnode"); !stakers.IsAllGT(gridnode) {
+		t.Fatalf("the stakers got %s and the gridnode pool %s, want more for the stakers", stakers, gridnode)
+	}
+}
+
+// agreeMint stores a Hedgehog mint of amount uugd to recipient as agreed on for
+// the height of ctx and returns it.
+func agreeMint(f testFixture, ctx sdk.Context, recipient sdk.AccAddress, amount int64) types.HedgehogMint {
+	mint := types.HedgehogMint{Address: recipient.String(), Height: uint64(ctx.BlockHeight()), Amount: amount}
+	f.k.SetAgreedMints(ctx, types.AgreedMints{Height: ctx.BlockHeight(), Mints: []types.HedgehogMint{mint}})
+	return mint
+}
+
+func TestBeginBlockerPausedBlockProvision(t *testing.T) {
+	f, ctx := setupBeginBlocker(t)
+	f.k.SetMintingPause(ctx, types.MintingPause{BlockProvisionsPaused: true})
+	minter := f.k.GetMinter(ctx)
+
+	beginBlock(f, ctx)
+
+	if !f.bk.supply.Empty() {
+		t.Fatalf("minted %s while block provisions are paused", f.bk.supply)
+	}
+	if !hasEvent(ctx, types.EventTypeMintingPaused) {
+		t.Fatalf("no %s event emitted", types.EventTypeMintingPaused)
+	}
+	if got := f.k.GetMinter(ctx); !got.BlockProvisionMinted.Equal(minter.BlockProvisionMinted) || got.LastMintHeight != minter.LastMintHeight {
+		t.Fatalf("minter changed while paused: %v", got)
+	}
+}
+
+func TestBeginBlockerHoldsAgreedMintsWhilePaused(t *testing.T) {
+	f, ctx := setupBeginBlocker(t)
+	recipient := sdk.AccAddress("recipient___________")
+	f.k.SetMintingPause(ctx, types.MintingPause{HedgehogMintsPaused: true, ResumeHeight: 102})
+
+	// The mint agreed on while paused is held, not dropped
+	mint := agreeMint(f, ctx, recipient, 1000)
+	beginBlock(f, ctx)
+	if !hasEvent(ctx, types.EventTypeMintingPaused) {
+		t.Fatalf("no %s event emitted", types.EventTypeMintingPaused)
+	}
+	if f.k.HasProcessedMint(ctx, mint.Key()) || !f.bk.balances[recipient.String()].Empty() {
+		t.Fatalf("mint %s paid out while paused", mint.Key())
+	}
+	if _, found := f.k.GetAgreedMints(ctx, ctx.BlockHeight()); !found {
+		t.Fatalf("mint %s dropped while paused", mint.Key())
+	}
+
+	ctx = nextBlock(ctx, 5)
+	beginBlock(f, ctx)
+	if !f.k.GetMintingPause(ctx).IsPaused() {
+		t.Fatalf("minting resumed before the resume height")
+	}
+
+	// At the resume height the held mint is paid along with the mints of the
+	// block
+	ctx = nextBlock(ctx, 5)
+	next := agreeMint(f, ctx, recipient, 500)
+	beginBlock(f, ctx)
+	if f.k.GetMintingPause(ctx).IsPaused() {
+		t.Fatalf("minting still paused at the resume height")
+	}
+	if !hasEvent(ctx, types.EventTypeMintingResumed) {
+		t.Fatalf("no %s event emitted", types.EventTypeMintingResumed)
+	}
+	for _, m := range []types.HedgehogMint{mint, next} {
+		if !f.k.HasProcessedMint(ctx, m.Key()) {
+			t.Fatalf("mint %s not paid out after resuming", m.Key())
+		}
+	}
+	if want := sdk.NewCoins(sdk.NewInt64Coin("uugd", 1500)); !f.bk.balances[recipient.String()].Equal(want) {
+		t.Fatalf("recipient has %s, want %s", f.bk.balances[recipient.String()], want)
+	}
+	if held := f.k.GetAllAgreedMints(ctx); len(held) != 0 {
+		t.Fatalf("agreed mints left after resuming: %v", held)
+	}
+}
+
+func TestBeginBlockerAppliesScheduledParamsChange(t *testing.T) {
+	f, ctx := setupBeginBlocker(t)
+
+	params := types.DefaultParams()
+	params.ProvisionScale = 2 * params.ProvisionScale
+	f.k.SetScheduledParamsChange(ctx, types.ScheduledParamsChange{ActivationHeight: 102, Params: params})
+
+	// The change is not applied before its activation height
+	ctx = nextBlock(ctx, 5)
+	beginBlock(f, ctx)
+	if f.k.GetParams(ctx).ProvisionScale != types.DefaultParams().ProvisionScale {
+		t.Fatalf("params changed before the activation height")
+	}
+	if hasEvent(ctx, types.EventTypeParamsChangeApplied) {
+		t.Fatalf("%s event emitted before the activation height", types.EventTypeParamsChangeApplied)
+	}
+
+	// The block at the activation height is already paid under the new params
+	ctx = nextBlock(ctx, 5)
+	minter := f.k.GetMinter(ctx)
+	want, err := types.DefaultInflationCalculationFn(ctx, &minter, params, f.sk.bondedRatio, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = f.bk.supply.Add(want...)
+	beginBlock(f, ctx)
+	if f.k.GetParams(ctx).ProvisionScale != params.ProvisionScale {
+		t.Fatalf("params not changed at the activation height")
+	}
+	if !hasEvent(ctx, types.EventTypeParamsChangeApplied) {
+		t.Fatalf("no %s event emitted", types.EventTypeParamsChangeApplied)
+	}
+	if !f.bk.supply.Equal(want) {
+		t.Fatalf("supply after the activation height is %s, want %s", f.bk.supply, want)
+	}
+	if _, found := f.k.GetScheduledParamsChange(ctx, 102); found {
+		t.Fatalf("the applied change is still scheduled")
+	}
+}
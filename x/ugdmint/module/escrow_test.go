@@ -0,0 +1,118 @@
+package ugdmint
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/unigrid-project/cosmos-ugdmint/x/ugdmint/keeper"
+	"github.com/unigrid-project/cosmos-ugdmint/x/ugdmint/types"
+)
+
+func TestEscrowNeedsEscrowAccount(t *testing.T) {
+	f, ctx := setupBeginBlocker(t)
+	f.ak.unregistered[types.EscrowAccountName] = true
+	ms := keeper.NewMsgServerImpl(f.k)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	params := types.DefaultParams()
+	params.VestingPolicy.Escrow = true
+
+	_, err := ms.UpdateParams(ctx, &types.MsgUpdateParams{Authority: authority, Params: params})
+	if !errors.Is(err, types.ErrUnknownModuleAccount) {
+		t.Fatalf("expected %v updating the params, got %v", types.ErrUnknownModuleAccount, err)
+	}
+	_, err = ms.ScheduleParamsChange(ctx, &types.MsgScheduleParamsChange{Authority: authority, ActivationHeight: 200, Params: params})
+	if !errors.Is(err, types.ErrUnknownModuleAccount) {
+		t.Fatalf("expected %v scheduling the params, got %v", types.ErrUnknownModuleAccount, err)
+	}
+
+	delete(f.ak.unregistered, types.EscrowAccountName)
+	if _, err := ms.UpdateParams(ctx, &types.MsgUpdateParams{Authority: authority, Params: params}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBeginBlockerEscrowsAgreedMint(t *testing.T) {
+	f, ctx := setupBeginBlocker(t)
+	setVestingPolicy(t, f, ctx, types.VestingPolicy{Kind: types.VestingDelayed, DurationSeconds: 100, Escrow: true})
+	recipient := sdk.AccAddress("recipient___________")
+	mint := agreeMint(f, ctx, recipient, 1000)
+
+	beginBlock(f, ctx)
+
+	if !f.k.HasProcessedMint(ctx, mint.Key()) {
+		t.Fatalf("mint %s not paid out", mint.Key())
+	}
+	if want := sdk.NewCoins(sdk.NewInt64Coin("uugd", 1000)); !f.bk.balanceOf(types.EscrowAccountName).Equal(want) {
+		t.Fatalf("escrow holds %s, want %s", f.bk.balanceOf(types.EscrowAccountName), want)
+	}
+	if f.ak.GetAccount(ctx, recipient) != nil || !f.bk.balances[recipient.String()].Empty() {
+		t.Fatalf("the account of the recipient was touched")
+	}
+	if !hasEvent(ctx, types.EventTypeMintEscrowed) {
+		t.Fatalf("no %s event emitted", types.EventTypeMintEscrowed)
+	}
+	if _, found := f.k.GetAgreedMints(ctx, ctx.BlockHeight()); found {
+		t.Fatalf("the agreed mints were not removed")
+	}
+}
+
+func TestBeginBlockerMergesHeldMints(t *testing.T) {
+	f, ctx := setupBeginBlocker(t)
+	setVestingPolicy(t, f, ctx, types.VestingPolicy{Kind: types.VestingDelayed, DurationSeconds: 100, Escrow: true})
+	recipient := sdk.AccAddress("recipient___________")
+	f.k.SetMintingPause(ctx, types.MintingPause{HedgehogMintsPaused: true, ResumeHeight: 102})
+
+	// Two mints to the same recipient are held while paused
+	first := agreeMint(f, ctx, recipient, 1000)
+	beginBlock(f, ctx)
+	ctx = nextBlock(ctx, 5)
+	second := agreeMint(f, ctx, recipient, 500)
+	beginBlock(f, ctx)
+
+	// Both are paid out in the block minting resumes at, as one payout
+	ctx = nextBlock(ctx, 5)
+	beginBlock(f, ctx)
+	want := sdk.NewCoins(sdk.NewInt64Coin("uugd", 1500))
+	for _, mint := range []types.HedgehogMint{first, second} {
+		processed, found := f.k.GetProcessedMint(ctx, mint.Key())
+		if !found || processed.BlockHeight != ctx.BlockHeight() {
+			t.Fatalf("mint %s not paid out after resuming", mint.Key())
+		}
+	}
+	if record, found := f.k.GetMintRecord(ctx, ctx.BlockHeight(), recipient.String()); !found || !record.Amount.Equal(want) {
+		t.Fatalf("mint record holds %v, want %s", record.Amount, want)
+	}
+	if schedule, _ := f.k.GetEscrowSchedule(ctx, recipient); len(schedule.Tranches) != 1 || !schedule.Tranches[0].Locked(ctx.BlockTime().Unix()).Equal(want) {
+		t.Fatalf("escrow schedule holds %v, want a single tranche of %s", schedule.Tranches, want)
+	}
+	if err := ExportGenesis(ctx, f.k).Validate(); err != nil {
+		t.Fatalf("exported genesis is invalid: %v", err)
+	}
+
+	// Clawing back either mint takes the whole payout out of escrow
+	ms := keeper.NewMsgServerImpl(f.k)
+	msg := &types.MsgClawbackMint{
+		Authority:   authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Address:     recipient.String(),
+		MintId:      second.Height,
+		Destination: types.DestinationBurn,
+	}
+	res, err := ms.ClawbackMint(ctx, msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.Amount.Equal(want) {
+		t.Fatalf("clawed back %s, want %s", res.Amount, want)
+	}
+	if escrowed := f.bk.balanceOf(types.EscrowAccountName); !escrowed.IsZero() {
+		t.Fatalf("escrow still holds %s after the clawback", escrowed)
+	}
+	msg.MintId = first.Height
+	if _, err := ms.ClawbackMint(ctx, msg); !errors.Is(err, types.ErrMintClawedBack) {
+		t.Fatalf("expected %v clawing back the merged mint, got %v", types.ErrMintClawedBack, err)
+	}
+}
@@ -0,0 +1,59 @@
+package ugdmint
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/golang/protobuf/proto"
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/unigrid-project/cosmos-ugdmint/x/ugdmint/types"
+)
+
+var (
+	patternMintInflation        = mintPattern("inflation")
+	patternMintAnnualProvisions = mintPattern("annual_provisions")
+)
+
+// RegisterMintGatewayAliases serves the Inflation and AnnualProvisions
+// queries at the REST paths of x/mint as well, /cosmos/mint/v1beta1/inflation
+// and /cosmos/mint/v1beta1/annual_provisions, so that wallets and explorers
+// built for x/mint keep working. Apps that replace x/mint with this module may
+// call it from RegisterAPIRoutes:
+//
+//	ugdmint.RegisterMintGatewayAliases(apiSvr.ClientCtx, apiSvr.GRPCGatewayRouter)
+//
+// It must not be used by apps that also run x/mint.
+func RegisterMintGatewayAliases(clientCtx client.Context, mux *gwruntime.ServeMux) {
+	queryClient := types.NewQueryClient(clientCtx)
+
+	mux.Handle(http.MethodGet, patternMintInflation, mintAliasHandler(mux, func(ctx context.Context) (proto.Message, error) {
+		return queryClient.Inflation(ctx, &types.QueryInflationRequest{})
+	}))
+	mux.Handle(http.MethodGet, patternMintAnnualProvisions, mintAliasHandler(mux, func(ctx context.Context) (proto.Message, error) {
+		return queryClient.AnnualProvisions(ctx, &types.QueryAnnualProvisionsRequest{})
+	}))
+}
+
+// mintAliasHandler returns a handler that answers a request with the response
+// of query, the way the generated gateway handlers do.
+func mintAliasHandler(mux *gwruntime.ServeMux, query func(context.Context) (proto.Message, error)) gwruntime.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request, _ map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		_, outboundMarshaler := gwruntime.MarshalerForRequest(mux, req)
+
+		resp, err := query(ctx)
+		if err != nil {
+			gwruntime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		gwruntime.ForwardResponseMessage(ctx, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	}
+}
+
+// mintPattern returns the pattern of /cosmos/mint/v1beta1/{name}.
+func mintPattern(name string) gwruntime.Pattern {
+	return gwruntime.MustPattern(gwruntime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"cosmos", "mint", "v1beta1", name}, "", gwruntime.AssumeColonVerbOpt(false)))
+}
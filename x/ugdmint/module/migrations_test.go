@@ -0,0 +1,67 @@
+package ugdmint
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/unigrid-project/cosmos-ugdmint/x/ugdmint/keeper"
+	"github.com/unigrid-project/cosmos-ugdmint/x/ugdmint/types"
+)
+
+func TestMigrate1to2KeepsMinting(t *testing.T) {
+	f, ctx := setupBeginBlocker(t)
+
+	// The params and the minter as stored by version 1, which only knew
+	// these fields
+	v1Params := types.Params{
+		MintDenom:              "ugd",
+		SubsidyHalvingInterval: math.LegacyNewDecWithPrec(50000, 0),
+		GoalBonded:             math.LegacyNewDecWithPrec(67, 2),
+		BlocksPerYear:          uint64(60 * 60 * 8766 / 5),
+	}
+	ctx.KVStore(f.key).Set(types.ParamsKey, f.cdc.MustMarshal(&v1Params))
+	f.k.SetMinter(ctx, types.Minter{SubsidyHalvingInterval: math.LegacyNewDecWithPrec(50000, 0)})
+	// The supply in circulation before the upgrade, which version 1 did not
+	// count
+	supply := sdk.NewInt64Coin("ugd", 1_000_000_000)
+	f.bk.supply = sdk.NewCoins(supply)
+
+	if err := keeper.NewMigrator(f.k, nil).Migrate1to2(ctx); err != nil {
+		t.Fatalf("migration failed: %v", err)
+	}
+
+	params := f.k.GetParams(ctx)
+	if err := params.Validate(); err != nil {
+		t.Fatalf("migrated params are invalid: %v", err)
+	}
+	want := types.DefaultParams()
+	if params.MintDenom != want.MintDenom || params.BlocksPerMinute != want.BlocksPerMinute ||
+		params.ReferenceWindowSeconds != want.ReferenceWindowSeconds || params.ProvisionScale != want.ProvisionScale {
+		t.Fatalf("migrated params do not have the defaults: %v", params)
+	}
+	if len(params.DistributionSplit) != len(want.DistributionSplit) ||
+		params.DistributionSplit[0].Kind != want.DistributionSplit[0].Kind ||
+		!params.DistributionSplit[0].Weight.Equal(want.DistributionSplit[0].Weight) {
+		t.Fatalf("migrated distribution split = %v, want %v", params.DistributionSplit, want.DistributionSplit)
+	}
+	if params.VestingPolicy != want.VestingPolicy {
+		t.Fatalf("migrated vesting policy = %v, want %v", params.VestingPolicy, want.VestingPolicy)
+	}
+
+	if minted := f.k.GetTotalMinted(ctx); !minted.Equal(sdk.NewCoins(supply)) {
+		t.Fatalf("migrated total minted = %s, want the supply of %s", minted, supply)
+	}
+
+	beginBlock(f, ctx)
+	ctx = nextBlock(ctx, 5)
+	beginBlock(f, ctx)
+
+	if minted := f.bk.supply.AmountOf("ugd"); !minted.GT(supply.Amount) {
+		t.Fatalf("nothing minted after the migration")
+	}
+	if minted := f.k.GetTotalMinted(ctx); !minted.Equal(f.bk.supply) {
+		t.Fatalf("total minted = %s, want the supply of %s", minted, f.bk.supply)
+	}
+}
@@ -31,7 +31,7 @@ import (
 )
 
 // ConsensusVersion defines the current x/ugdmint module consensus version.
-const ConsensusVersion = 1
+const ConsensusVersion = 2
 
 var (
 	_ module.AppModuleBasic      = (*AppModule)(nil)
@@ -89,7 +89,12 @@ func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *g
 
 // GetTxCmd returns the root Tx command for the module. The subcommands of this root command are used by end-users to generate new transactions containing messages defined in the module
 func (a AppModuleBasic) GetTxCmd() *cobra.Command {
-	return nil
+	return cli.GetTxCmd()
+}
+
+// GetClientCmd returns the root command of the offline commands of the module, which apps add to their root command
+func (AppModuleBasic) GetClientCmd() *cobra.Command {
+	return cli.GetClientCmd()
 }
 
 // GetQueryCmd returns the root query command for the module. The subcommands of this root command are used by end-users to generate new queries to the subset of the state defined by the module
@@ -108,6 +113,9 @@ type AppModule struct {
 	keeper     keeper.Keeper
 	authKeeper types.AccountKeeper
 
+	// inflationCalculator is used to calculate the block provision
+	inflationCalculator types.InflationCalculationFn
+
 	// legacySubspace is used solely for migration of x/params managed parameters
 	legacySubspace exported.Subspace
 }
@@ -119,11 +127,17 @@ func NewAppModule(
 	ic types.InflationCalculationFn,
 	ss exported.Subspace,
 ) AppModule {
+	if ic == nil {
+		ic = types.DefaultInflationCalculationFn
+	}
+	keeper.SetInflationCalculationFn(ic)
+
 	return AppModule{
-		AppModuleBasic: AppModuleBasic{cdc: cdc},
-		keeper:         keeper,
-		authKeeper:     ak,
-		legacySubspace: ss,
+		AppModuleBasic:      AppModuleBasic{cdc: cdc},
+		keeper:              keeper,
+		authKeeper:          ak,
+		inflationCalculator: ic,
+		legacySubspace:      ss,
 	}
 }
 
@@ -148,6 +162,11 @@ func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := keeper.NewMigrator(am.keeper, am.legacySubspace)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
+	}
 }
 
 // InitGenesis performs genesis initialization for the mint module. It returns
@@ -172,7 +191,7 @@ func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 
 // BeginBlock contains the logic that is automatically triggered at the beginning of each block
 func (am AppModule) BeginBlock(ctx context.Context) error {
-	BeginBlocker(ctx, am.keeper)
+	BeginBlocker(ctx, am.keeper, am.inflationCalculator)
 	return nil
 }
 
@@ -226,6 +245,17 @@ type MintInputs struct {
 	BankKeeper    types.BankKeeper
 	StakingKeeper types.StakingKeeper
 
+	// DistributionKeeper funds the community pool. It is needed when the
+	// distribution split has a community_pool destination, when the excess of
+	// the bonded adjustment is sent to the community pool and when governance
+	// claws back a mint to the community pool. Params that need it are
+	// rejected by the msg server when it is not provided.
+	DistributionKeeper types.DistributionKeeper `optional:"true"`
+
+	// MintSource provides the Hedgehog mints observed by this node. When it is
+	// not provided, the mints are fetched from the Hedgehog server configured in
+	// app.toml.
+	MintSource types.MintSource `optional:"true"`
 }
 
 //nolint:revive
@@ -248,6 +278,11 @@ func ProvideModule(in MintInputs) MintOutputs {
 		authority = authtypes.NewModuleAddressOrBech32Address(in.Config.Authority)
 	}
 
+	mintSource := in.MintSource
+	if mintSource == nil {
+		mintSource = types.NewHedgehogMintSource("")
+	}
+
 	k := keeper.NewKeeper(
 		in.Cdc,
 		//in.Key,
@@ -255,11 +290,14 @@ func ProvideModule(in MintInputs) MintOutputs {
 		in.StakingKeeper,
 		in.AccountKeeper,
 		in.BankKeeper,
+		in.DistributionKeeper,
+		mintSource,
 		feeCollectorName,
 		authority.String(),
 	)
 
 	// when no inflation calculation function is provided it will use the default types.DefaultInflationCalculationFn
+	k.SetInflationCalculationFn(in.InflationCalculationFn)
 	m := NewAppModule(in.Cdc, k, in.AccountKeeper, in.InflationCalculationFn, in.LegacySubspace)
 
 	return MintOutputs{MintKeeper: k, Module: m}
@@ -0,0 +1,167 @@
+package ugdmint
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/unigrid-project/cosmos-ugdmint/x/ugdmint/types"
+)
+
+// setVestingPolicy replaces the vesting policy of the params.
+func setVestingPolicy(t *testing.T, f testFixture, ctx sdk.Context, policy types.VestingPolicy) {
+	t.Helper()
+
+	params := f.k.GetParams(ctx)
+	params.VestingPolicy = policy
+	if err := f.k.SetParams(ctx, params); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestVestMintNewAccount(t *testing.T) {
+	f, ctx := setupBeginBlocker(t)
+	recipient := sdk.AccAddress("recipient___________")
+	coins := sdk.NewCoins(sdk.NewInt64Coin("uugd", 1000))
+
+	vested, err := vestMint(ctx, f.k, recipient, coins)
+	if err != nil || !vested {
+		t.Fatalf("vestMint = %t, %v, want the coins to vest", vested, err)
+	}
+
+	acc, ok := f.ak.GetAccount(ctx, recipient).(*vestingtypes.DelayedVestingAccount)
+	if !ok {
+		t.Fatalf("expected a delayed vesting account, got %T", f.ak.GetAccount(ctx, recipient))
+	}
+	if !acc.OriginalVesting.Equal(coins) || acc.EndTime != ctx.BlockTime().Unix()+int64(types.DefaultVestingPolicy().DurationSeconds) {
+		t.Fatalf("unexpected vesting of the new account: %v", acc)
+	}
+	if acc.GetAccountNumber() == 0 {
+		t.Fatalf("the new account has no account number")
+	}
+}
+
+func TestVestMintBaseAccount(t *testing.T) {
+	f, ctx := setupBeginBlocker(t)
+	recipient := sdk.AccAddress("recipient___________")
+	base := authtypes.NewBaseAccount(recipient, nil, 7, 3)
+	f.ak.SetAccount(ctx, base)
+	coins := sdk.NewCoins(sdk.NewInt64Coin("uugd", 1000))
+
+	vested, err := vestMint(ctx, f.k, recipient, coins)
+	if err != nil || !vested {
+		t.Fatalf("vestMint = %t, %v, want the coins to vest", vested, err)
+	}
+
+	acc, ok := f.ak.GetAccount(ctx, recipient).(*vestingtypes.DelayedVestingAccount)
+	if !ok {
+		t.Fatalf("expected a delayed vesting account, got %T", f.ak.GetAccount(ctx, recipient))
+	}
+	if acc.GetAccountNumber() != 7 || acc.GetSequence() != 3 || !acc.OriginalVesting.Equal(coins) {
+		t.Fatalf("the base account was not kept: %v", acc)
+	}
+}
+
+func TestVestMintMergesVestingAccount(t *testing.T) {
+	f, ctx := setupBeginBlocker(t)
+	setVestingPolicy(t, f, ctx, types.VestingPolicy{Kind: types.VestingPeriodic, DurationSeconds: 200, PeriodCount: 2})
+
+	recipient := sdk.AccAddress("recipient___________")
+	existing := sdk.NewCoins(sdk.NewInt64Coin("uugd", 500))
+	delayed, err := vestingtypes.NewDelayedVestingAccount(authtypes.NewBaseAccount(recipient, nil, 7, 0), existing, ctx.BlockTime().Unix()+50)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.ak.SetAccount(ctx, delayed)
+	coins := sdk.NewCoins(sdk.NewInt64Coin("uugd", 1000))
+
+	vested, err := vestMint(ctx, f.k, recipient, coins)
+	if err != nil || !vested {
+		t.Fatalf("vestMint = %t, %v, want the coins to vest", vested, err)
+	}
+
+	acc, ok := f.ak.GetAccount(ctx, recipient).(*vestingtypes.PeriodicVestingAccount)
+	if !ok {
+		t.Fatalf("expected a periodic vesting account, got %T", f.ak.GetAccount(ctx, recipient))
+	}
+	if !acc.OriginalVesting.Equal(existing.Add(coins...)) {
+		t.Fatalf("original vesting = %s, want %s", acc.OriginalVesting, existing.Add(coins...))
+	}
+
+	// the existing coins still unlock after 50s, the minted coins in two
+	// periods of 100s
+	now := ctx.BlockTime()
+	for seconds, want := range map[int64]int64{49: 1500, 50: 1000, 100: 500, 200: 0} {
+		if vesting := acc.GetVestingCoins(now.Add(time.Duration(seconds) * time.Second)); !vesting.AmountOf("uugd").Equal(math.NewInt(want)) {
+			t.Errorf("vesting after %ds = %s, want %duugd", seconds, vesting, want)
+		}
+	}
+}
+
+func TestVestMintUnsupportedAccount(t *testing.T) {
+	f, ctx := setupBeginBlocker(t)
+	moduleAcc := authtypes.NewEmptyModuleAccount("gridnode")
+	f.ak.SetAccount(ctx, moduleAcc)
+
+	vested, err := vestMint(ctx, f.k, moduleAcc.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin("uugd", 1000)))
+	if err != nil || vested {
+		t.Fatalf("vestMint = %t, %v, want the coins paid out unlocked", vested, err)
+	}
+	if _, ok := f.ak.GetAccount(ctx, moduleAcc.GetAddress()).(*authtypes.ModuleAccount); !ok {
+		t.Fatalf("the module account was changed to %T", f.ak.GetAccount(ctx, moduleAcc.GetAddress()))
+	}
+}
+
+func TestVestMintNonePolicy(t *testing.T) {
+	f, ctx := setupBeginBlocker(t)
+	setVestingPolicy(t, f, ctx, types.VestingPolicy{Kind: types.VestingNone})
+	coins := sdk.NewCoins(sdk.NewInt64Coin("uugd", 1000))
+
+	// a new account is created as a plain account
+	recipient := sdk.AccAddress("recipient___________")
+	vested, err := vestMint(ctx, f.k, recipient, coins)
+	if err != nil || vested {
+		t.Fatalf("vestMint = %t, %v, want the coins paid out unlocked", vested, err)
+	}
+	if _, ok := f.ak.GetAccount(ctx, recipient).(*authtypes.BaseAccount); !ok {
+		t.Fatalf("expected a base account, got %T", f.ak.GetAccount(ctx, recipient))
+	}
+
+	// an existing vesting account is left as it is
+	other := sdk.AccAddress("other_______________")
+	delayed, err := vestingtypes.NewDelayedVestingAccount(authtypes.NewBaseAccount(other, nil, 8, 0), coins, ctx.BlockTime().Unix()+50)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.ak.SetAccount(ctx, delayed)
+	if vested, err := vestMint(ctx, f.k, other, coins); err != nil || vested {
+		t.Fatalf("vestMint = %t, %v, want the coins paid out unlocked", vested, err)
+	}
+	if acc := f.ak.GetAccount(ctx, other).(vestexported.VestingAccount); !acc.GetOriginalVesting().Equal(coins) {
+		t.Fatalf("the vesting account was changed: %v", acc)
+	}
+}
+
+func TestBeginBlockerVestsAgreedMint(t *testing.T) {
+	f, ctx := setupBeginBlocker(t)
+	recipient := sdk.AccAddress("recipient___________")
+	mint := agreeMint(f, ctx, recipient, 1000)
+
+	beginBlock(f, ctx)
+
+	if !f.k.HasProcessedMint(ctx, mint.Key()) {
+		t.Fatalf("mint %s not paid out", mint.Key())
+	}
+	if _, ok := f.ak.GetAccount(ctx, recipient).(*vestingtypes.DelayedVestingAccount); !ok {
+		t.Fatalf("expected the mint to vest in a delayed vesting account, got %T", f.ak.GetAccount(ctx, recipient))
+	}
+	record, found := f.k.GetMintRecord(ctx, ctx.BlockHeight(), recipient.String())
+	if !found || record.Vesting == nil || len(record.Vesting.Unlocks) != 1 {
+		t.Fatalf("expected the vesting of the mint to be recorded, got %v", record)
+	}
+}
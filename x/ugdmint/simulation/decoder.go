@@ -19,6 +19,16 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvA.Value, &minterA)
 			cdc.MustUnmarshal(kvB.Value, &minterB)
 			return fmt.Sprintf("%v\n%v", minterA, minterB)
+		case bytes.HasPrefix(kvA.Key, types.AgreedMintsKeyPrefix):
+			var agreedA, agreedB types.AgreedMints
+			cdc.MustUnmarshal(kvA.Value, &agreedA)
+			cdc.MustUnmarshal(kvB.Value, &agreedB)
+			return fmt.Sprintf("%v\n%v", agreedA, agreedB)
+		case bytes.HasPrefix(kvA.Key, types.ProcessedMintKeyPrefix):
+			var mintA, mintB types.ProcessedMint
+			cdc.MustUnmarshal(kvA.Value, &mintA)
+			cdc.MustUnmarshal(kvB.Value, &mintB)
+			return fmt.Sprintf("%v\n%v", mintA, mintB)
 		default:
 			panic(fmt.Sprintf("invalid mint key %X", kvA.Key))
 		}
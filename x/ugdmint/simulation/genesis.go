@@ -53,7 +53,16 @@ func RandomizedGenState(simState *module.SimulationState) {
 
 	mintDenom := sdk.DefaultBondDenom
 	blocksPerYear := uint64(60 * 60 * 8766 / 5)
-	params := types.NewParams(mintDenom, subsidyHalvingInterval, goalBonded, blocksPerYear)
+	defaults := types.DefaultParams()
+	params := types.NewParams(
+		mintDenom, subsidyHalvingInterval, goalBonded, blocksPerYear,
+		defaults.BlocksPerMinute, defaults.HeightOffset, defaults.DecayStartHeight, defaults.DecayFactor,
+		defaults.ReferenceWindowSeconds, defaults.ProvisionScale,
+		defaults.MinBlockTimeDeltaSeconds, defaults.MaxBlockTimeDeltaSeconds,
+		defaults.MaxSupply, defaults.InflationRateChange, defaults.InflationMax, defaults.InflationMin,
+		defaults.BondedAdjustmentEnabled, defaults.BelowGoalStakingShare, defaults.ExcessDestination,
+		defaults.DistributionSplit, defaults.VestingPolicy,
+	)
 
 	mintGenesis := types.NewGenesisState(types.InitialMinter(subsidyHalvingInterval), params)
 
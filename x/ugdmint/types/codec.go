@@ -12,6 +12,11 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(Params{}, "cosmos-sdk/x/ugdmint/Params", nil)
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/ugdmint/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgSetMintingPaused{}, "ugdmint/MsgSetMintingPaused")
+	legacy.RegisterAminoMsg(cdc, &MsgScheduleParamsChange{}, "ugdmint/MsgScheduleParamsChange")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelParamsChange{}, "ugdmint/MsgCancelParamsChange")
+	legacy.RegisterAminoMsg(cdc, &MsgClaimVested{}, "cosmos-sdk/x/ugdmint/MsgClaimVested")
+	legacy.RegisterAminoMsg(cdc, &MsgClawbackMint{}, "cosmos-sdk/x/ugdmint/MsgClawbackMint")
 }
 
 // RegisterInterfaces registers the interfaces types with the interface registry.
@@ -19,6 +24,11 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgSetMintingPaused{},
+		&MsgScheduleParamsChange{},
+		&MsgCancelParamsChange{},
+		&MsgClaimVested{},
+		&MsgClawbackMint{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,27 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+func TestRegisterLegacyAminoCodec(t *testing.T) {
+	// the amino names of messages are limited to 39 characters, longer names
+	// panic at registration
+	cdc := codec.NewLegacyAmino()
+	RegisterLegacyAminoCodec(cdc)
+
+	msg := &MsgSetMintingPaused{Authority: "authority", HedgehogMintsPaused: true, ResumeHeight: 10}
+	bz, err := cdc.MarshalJSON(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded MsgSetMintingPaused
+	if err := cdc.UnmarshalJSON(bz, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Authority != msg.Authority || decoded.HedgehogMintsPaused != msg.HedgehogMintsPaused || decoded.ResumeHeight != msg.ResumeHeight {
+		t.Fatalf("expected %v after an amino round trip, got %v", msg, &decoded)
+	}
+}
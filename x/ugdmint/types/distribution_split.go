@@ -0,0 +1,128 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Kinds of destinations of the distribution split
+const (
+	// DestinationFeeCollector pays the fee collector, from where the
+	// distribution module pays the stakers.
+	DestinationFeeCollector = "fee_collector"
+	// DestinationCommunityPool funds the community pool.
+	DestinationCommunityPool = "community_pool"
+	// DestinationModuleAccount pays the module account named by the recipient.
+	DestinationModuleAccount = "module_account"
+	// DestinationAddress pays the bech32 address of the recipient.
+	DestinationAddress = "address"
+	// DestinationBurn does not mint the share at all.
+	DestinationBurn = "burn"
+)
+
+// ProvisionPayout is the share of the block provision paid to a destination
+// of the distribution split.
+type ProvisionPayout struct {
+	Destination DistributionDestination
+	Amount      sdk.Coins
+}
+
+// DefaultDistributionSplit pays the whole block provision to the fee
+// collector.
+func DefaultDistributionSplit() []DistributionDestination {
+	return []DistributionDestination{
+		{Kind: DestinationFeeCollector, Weight: math.LegacyOneDec()},
+	}
+}
+
+// SplitProvision returns the share of coins every destination of split is
+// paid, in the order of split. Every share is truncated and the remainder is
+// paid to the first destination, so the shares always add up to coins.
+func SplitProvision(split []DistributionDestination, coins sdk.Coins) []sdk.Coins {
+	shares := make([]sdk.Coins, len(split))
+	for i := range shares {
+		shares[i] = sdk.NewCoins()
+	}
+	if len(split) == 0 {
+		return shares
+	}
+
+	for _, coin := range coins {
+		remainder := coin.Amount
+		for i, destination := range split {
+			amount := destination.Weight.MulInt(coin.Amount).TruncateInt()
+			shares[i] = shares[i].Add(sdk.NewCoin(coin.Denom, amount))
+			remainder = remainder.Sub(amount)
+		}
+		shares[0] = shares[0].Add(sdk.NewCoin(coin.Denom, remainder))
+	}
+
+	return shares
+}
+
+// Validate validates the destination.
+func (d DistributionDestination) Validate() error {
+	switch d.Kind {
+	case DestinationFeeCollector, DestinationCommunityPool, DestinationBurn:
+		if d.Recipient != "" {
+			return fmt.Errorf("%s destination cannot have a recipient: %s", d.Kind, d.Recipient)
+		}
+	case DestinationModuleAccount:
+		if d.Recipient == "" {
+			return fmt.Errorf("module account destination must name the module account")
+		}
+		if d.Recipient == ModuleName {
+			return fmt.Errorf("module account destination cannot be the %s module", ModuleName)
+		}
+	case DestinationAddress:
+		if _, err := sdk.AccAddressFromBech32(d.Recipient); err != nil {
+			return fmt.Errorf("invalid address destination %s: %w", d.Recipient, err)
+		}
+	default:
+		return fmt.Errorf("unknown distribution destination: %s", d.Kind)
+	}
+
+	if d.Weight.IsNil() {
+		return fmt.Errorf("weight of the %s destination cannot be nil", d.Kind)
+	}
+	if !d.Weight.IsPositive() {
+		return fmt.Errorf("weight of the %s destination must be positive: %s", d.Kind, d.Weight)
+	}
+
+	return nil
+}
+
+func validateDistributionSplit(i interface{}) error {
+	v, ok := i.([]DistributionDestination)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if len(v) == 0 {
+		return fmt.Errorf("distribution split cannot be empty")
+	}
+
+	total := math.LegacyZeroDec()
+	seen := make(map[string]bool, len(v))
+	for _, destination := range v {
+		if err := destination.Validate(); err != nil {
+			return err
+		}
+
+		key := destination.Kind + "/" + destination.Recipient
+		if seen[key] {
+			return fmt.Errorf("duplicate distribution destination %s", key)
+		}
+		seen[key] = true
+
+		total = total.Add(destination.Weight)
+	}
+	if !total.Equal(math.LegacyOneDec()) {
+		return fmt.Errorf("distribution split weights must sum to 1: %s", total)
+	}
+
+	return nil
+}
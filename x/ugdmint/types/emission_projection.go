@@ -0,0 +1,115 @@
+package types
+
+import (
+	"fmt"
+	gomath "math"
+	"time"
+
+	cosmosmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxEmissionProjectionEpochs bounds the number of decay epochs a single
+// projection walks through, so that a query cannot keep a node busy.
+const MaxEmissionProjectionEpochs = 10_000
+
+// DefaultBlockTimeSeconds returns the block time the emission params are
+// calibrated for, the reference window divided by the blocks per minute.
+func DefaultBlockTimeSeconds(params Params) uint64 {
+	if params.BlocksPerMinute == 0 || params.ReferenceWindowSeconds < params.BlocksPerMinute {
+		return 1
+	}
+	return params.ReferenceWindowSeconds / params.BlocksPerMinute
+}
+
+// ProjectEmission projects the block provisions of the heights from
+// startHeight to endHeight, assuming every block is produced blockTimeSeconds
+// after the previous one. supply is the supply of the mint denom after the
+// block before nextHeight; the provisions of the heights between nextHeight
+// and startHeight are added to the cumulative supply without being reported.
+//
+// Every height of an epoch shares the same decay, so the provision is
+// computed once per epoch with BlockProvision, just like BeginBlocker does
+// for every block. The max_supply param is applied to the provisions using
+// the totalMinted amounts. Hedgehog mints cannot be known in advance and are
+// not part of the projection.
+func (m Minter) ProjectEmission(
+	params Params, supply sdk.Coin, totalMinted sdk.Coins,
+	nextHeight, startHeight, endHeight, blockTimeSeconds uint64,
+) ([]EmissionEpoch, error) {
+	if startHeight < nextHeight {
+		return nil, fmt.Errorf("start height %d must not be before the next height %d", startHeight, nextHeight)
+	}
+	if endHeight < startHeight {
+		return nil, fmt.Errorf("end height %d must not be before the start height %d", endHeight, startHeight)
+	}
+	if blockTimeSeconds == 0 {
+		return nil, fmt.Errorf("block time must be positive")
+	}
+
+	denom := params.MintDenom
+	headroom, capped := cosmosmath.ZeroInt(), false
+	if found, max := params.MaxSupply.Find(denom); found {
+		headroom = cosmosmath.MaxInt(max.Amount.Sub(totalMinted.AmountOf(denom)), cosmosmath.ZeroInt())
+		capped = true
+	}
+
+	previousBlockTime := time.Unix(0, 0)
+	blockTime := previousBlockTime.Add(time.Duration(blockTimeSeconds) * time.Second)
+
+	cumulative := supply.Amount
+	var epochs []EmissionEpoch
+	for height, n := nextHeight, 0; height <= endHeight; n++ {
+		if n == MaxEmissionProjectionEpochs {
+			return nil, fmt.Errorf("projection spans more than %d epochs", MaxEmissionProjectionEpochs)
+		}
+
+		last := min(decayEpochEnd(params, height), endHeight)
+		if height < startHeight && last >= startHeight {
+			// Only add up the heights before the start
+			last = startHeight - 1
+		}
+
+		provision := m.BlockProvision(params, height, blockTime, previousBlockTime).AmountOf(denom)
+		provisions := provision.Mul(cosmosmath.NewIntFromUint64(last - height + 1))
+		if capped {
+			provisions = cosmosmath.MinInt(provisions, headroom)
+			headroom = headroom.Sub(provisions)
+		}
+		cumulative = cumulative.Add(provisions)
+
+		if height >= startHeight {
+			epochs = append(epochs, EmissionEpoch{
+				StartHeight:      height,
+				EndHeight:        last,
+				BlockProvision:   sdk.NewCoin(denom, provision),
+				Provisions:       sdk.NewCoin(denom, provisions),
+				CumulativeSupply: sdk.NewCoin(denom, cumulative),
+			})
+		}
+
+		if last == gomath.MaxUint64 {
+			break
+		}
+		height = last + 1
+	}
+
+	return epochs, nil
+}
+
+// decayEpochEnd returns the last height that shares the decay of height in
+// BlockProvision.
+func decayEpochEnd(params Params, height uint64) uint64 {
+	interval := params.SubsidyHalvingInterval.Abs().TruncateInt64()
+	if interval <= 0 {
+		return gomath.MaxUint64
+	}
+
+	adjustedHeight := height + params.HeightOffset
+	next := params.DecayStartHeight + uint64(interval)
+	if adjustedHeight > params.DecayStartHeight {
+		next = params.DecayStartHeight + ((adjustedHeight-params.DecayStartHeight)/uint64(interval)+1)*uint64(interval)
+	}
+
+	return next - params.HeightOffset - 1
+}
@@ -0,0 +1,145 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestProjectEmissionEpochs(t *testing.T) {
+	params := DefaultParams()
+	minter := DefaultInitialMinter()
+	supply := sdk.NewInt64Coin(params.MintDenom, 1000)
+
+	// the 40th decay step starts at 314934
+	epochs, err := minter.ProjectEmission(params, supply, sdk.NewCoins(), 314930, 314930, 314940, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []EmissionEpoch{
+		{
+			StartHeight:      314930,
+			EndHeight:        314933,
+			BlockProvision:   sdk.NewInt64Coin("ugd", 469256),
+			Provisions:       sdk.NewInt64Coin("ugd", 4*469256),
+			CumulativeSupply: sdk.NewInt64Coin("ugd", 1000+4*469256),
+		},
+		{
+			StartHeight:      314934,
+			EndHeight:        314940,
+			BlockProvision:   sdk.NewInt64Coin("ugd", 464563),
+			Provisions:       sdk.NewInt64Coin("ugd", 7*464563),
+			CumulativeSupply: sdk.NewInt64Coin("ugd", 1000+4*469256+7*464563),
+		},
+	}
+	if len(epochs) != len(expected) {
+		t.Fatalf("expected %d epochs, got %d: %v", len(expected), len(epochs), epochs)
+	}
+	for i := range expected {
+		if epochs[i].String() != expected[i].String() {
+			t.Errorf("epoch %d: expected %v, got %v", i, expected[i], epochs[i])
+		}
+	}
+
+	// heights before the start only add up to the supply
+	epochs, err = minter.ProjectEmission(params, supply, sdk.NewCoins(), 314930, 314935, 314936, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(epochs) != 1 || epochs[0].StartHeight != 314935 || epochs[0].EndHeight != 314936 {
+		t.Fatalf("expected a single epoch from 314935 to 314936, got %v", epochs)
+	}
+	if expected := math.NewInt(1000 + 4*469256 + 3*464563); !epochs[0].CumulativeSupply.Amount.Equal(expected) {
+		t.Errorf("expected a cumulative supply of %s, got %s", expected, epochs[0].CumulativeSupply)
+	}
+}
+
+func TestProjectEmissionMatchesBlockProvision(t *testing.T) {
+	params := DefaultParams()
+	params.SubsidyHalvingInterval = math.LegacyNewDec(7)
+	params.DecayFactor = math.LegacyNewDecWithPrec(9, 1)
+	params.HeightOffset = 0
+	params.DecayStartHeight = 20
+	minter := DefaultInitialMinter()
+
+	prevTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	blockTime := prevTime.Add(13 * time.Second)
+
+	epochs, err := minter.ProjectEmission(params, sdk.NewInt64Coin("ugd", 0), sdk.NewCoins(), 1, 1, 100, 13)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	supply := math.ZeroInt()
+	next := uint64(1)
+	for _, epoch := range epochs {
+		if epoch.StartHeight != next {
+			t.Fatalf("expected an epoch starting at %d, got %v", next, epoch)
+		}
+		for height := epoch.StartHeight; height <= epoch.EndHeight; height++ {
+			provision := minter.BlockProvision(params, height, blockTime, prevTime)
+			if !provision.AmountOf("ugd").Equal(epoch.BlockProvision.Amount) {
+				t.Fatalf("height %d: expected a provision of %s, got %s", height, provision, epoch.BlockProvision)
+			}
+			supply = supply.Add(provision.AmountOf("ugd"))
+		}
+		if !epoch.CumulativeSupply.Amount.Equal(supply) {
+			t.Fatalf("epoch ending at %d: expected a cumulative supply of %s, got %s", epoch.EndHeight, supply, epoch.CumulativeSupply)
+		}
+		next = epoch.EndHeight + 1
+	}
+	if next != 101 {
+		t.Fatalf("expected the epochs to end at 100, got %d", next-1)
+	}
+}
+
+func TestProjectEmissionSupplyCap(t *testing.T) {
+	params := DefaultParams()
+	params.MaxSupply = sdk.NewCoins(sdk.NewInt64Coin("ugd", 10_000_000))
+	minter := DefaultInitialMinter()
+
+	// 9,000,000 minted leaves room for two blocks of 498423 and a part of a third
+	epochs, err := minter.ProjectEmission(params, sdk.NewInt64Coin("ugd", 9_500_000),
+		sdk.NewCoins(sdk.NewInt64Coin("ugd", 9_000_000)), 1, 1, 100, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(epochs) != 1 {
+		t.Fatalf("expected a single epoch, got %v", epochs)
+	}
+	if !epochs[0].Provisions.Amount.Equal(math.NewInt(1_000_000)) {
+		t.Errorf("expected the provisions to be capped at 1000000, got %s", epochs[0].Provisions)
+	}
+	if !epochs[0].CumulativeSupply.Amount.Equal(math.NewInt(10_500_000)) {
+		t.Errorf("expected a cumulative supply of 10500000, got %s", epochs[0].CumulativeSupply)
+	}
+	if !epochs[0].BlockProvision.Amount.Equal(math.NewInt(498423)) {
+		t.Errorf("expected the uncapped block provision to be reported, got %s", epochs[0].BlockProvision)
+	}
+}
+
+func TestProjectEmissionInvalid(t *testing.T) {
+	params := DefaultParams()
+	minter := DefaultInitialMinter()
+	supply := sdk.NewInt64Coin(params.MintDenom, 0)
+
+	invalid := map[string]struct {
+		next, start, end, blockTime uint64
+	}{
+		"start before next": {10, 9, 20, 5},
+		"end before start":  {10, 20, 19, 5},
+		"zero block time":   {10, 10, 20, 0},
+		"too many epochs":   {1, 1, 50000 * (MaxEmissionProjectionEpochs + 1), 5},
+	}
+
+	for name, v := range invalid {
+		t.Run(name, func(t *testing.T) {
+			if _, err := minter.ProjectEmission(params, supply, sdk.NewCoins(), v.next, v.start, v.end, v.blockTime); err == nil {
+				t.Error("expected the projection to fail")
+			}
+		})
+	}
+}
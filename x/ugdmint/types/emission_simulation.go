@@ -0,0 +1,66 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	cosmosmath "cosmossdk.io/math"
+)
+
+// SimulatedBlock is a block of an emission simulation.
+type SimulatedBlock struct {
+	Height uint64
+	// BlockTimeSeconds is the time between the block and the previous one.
+	BlockTimeSeconds int64
+	// ElapsedSeconds is the time between the block and the start of the
+	// simulation.
+	ElapsedSeconds int64
+	// Provision is the amount of the mint denom minted by the block.
+	Provision cosmosmath.Int
+	// Cumulative is the amount of the mint denom minted by the simulated
+	// blocks up to and including the block.
+	Cumulative cosmosmath.Int
+}
+
+// SimulateEmission runs BlockProvision for every height from startHeight to
+// endHeight, the way BeginBlocker does, and passes every simulated block to
+// visit. blockTime returns the seconds between a height and the previous
+// block; it may be zero or negative, in which case the block is paid like
+// BeginBlocker pays a block that is not later than the previous one.
+//
+// Unlike ProjectEmission, every block is computed on its own, so the block
+// times may vary from block to block. The max_supply param and Hedgehog mints
+// are not part of the simulation.
+func (m Minter) SimulateEmission(
+	params Params, startHeight, endHeight uint64,
+	blockTime func(height uint64) int64, visit func(SimulatedBlock) error,
+) error {
+	if endHeight < startHeight {
+		return fmt.Errorf("end height %d must not be before the start height %d", endHeight, startHeight)
+	}
+
+	previousBlockTime := time.Unix(0, 0)
+	cumulative := cosmosmath.ZeroInt()
+	for height := startHeight; ; height++ {
+		delta := blockTime(height)
+		currentBlockTime := previousBlockTime.Add(time.Duration(max(delta, 0)) * time.Second)
+
+		provision := m.BlockProvision(params, height, currentBlockTime, previousBlockTime).AmountOf(params.MintDenom)
+		cumulative = cumulative.Add(provision)
+
+		if err := visit(SimulatedBlock{
+			Height:           height,
+			BlockTimeSeconds: delta,
+			ElapsedSeconds:   currentBlockTime.Unix(),
+			Provision:        provision,
+			Cumulative:       cumulative,
+		}); err != nil {
+			return err
+		}
+
+		previousBlockTime = currentBlockTime
+		if height == endHeight {
+			return nil
+		}
+	}
+}
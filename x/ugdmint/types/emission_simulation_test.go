@@ -0,0 +1,62 @@
+package types
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestSimulateEmissionMatchesProjection(t *testing.T) {
+	params := DefaultParams()
+	minter := DefaultInitialMinter()
+
+	epochs, err := minter.ProjectEmission(params, sdk.NewInt64Coin("ugd", 0), sdk.NewCoins(), 314900, 314900, 315000, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var last SimulatedBlock
+	err = minter.SimulateEmission(params, 314900, 315000, func(uint64) int64 { return 5 }, func(block SimulatedBlock) error {
+		if last.Height != 0 && block.Height != last.Height+1 {
+			t.Fatalf("expected height %d after %d, got %d", last.Height+1, last.Height, block.Height)
+		}
+		last = block
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if last.Height != 315000 || last.ElapsedSeconds != 101*5 {
+		t.Fatalf("expected the simulation to end at 315000 after 505s, got %d after %ds", last.Height, last.ElapsedSeconds)
+	}
+	if expected := epochs[len(epochs)-1].CumulativeSupply.Amount; !last.Cumulative.Equal(expected) {
+		t.Errorf("expected a cumulative emission of %s, got %s", expected, last.Cumulative)
+	}
+}
+
+func TestSimulateEmissionBlockTimes(t *testing.T) {
+	params := DefaultParams()
+	minter := DefaultInitialMinter()
+
+	deltas := map[uint64]int64{1: 5, 2: 13, 3: 0, 4: -3, 5: 600}
+	expected := map[uint64]int64{1: 498423, 2: 1295902, 3: 99684, 4: 99684, 5: 5981087}
+
+	err := minter.SimulateEmission(params, 1, 5, func(height uint64) int64 { return deltas[height] }, func(block SimulatedBlock) error {
+		if block.BlockTimeSeconds != deltas[block.Height] {
+			t.Errorf("height %d: expected a block time of %ds, got %ds", block.Height, deltas[block.Height], block.BlockTimeSeconds)
+		}
+		if !block.Provision.Equal(math.NewInt(expected[block.Height])) {
+			t.Errorf("height %d: expected a provision of %d, got %s", block.Height, expected[block.Height], block.Provision)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := minter.SimulateEmission(params, 2, 1, func(uint64) int64 { return 5 }, func(SimulatedBlock) error { return nil }); err == nil {
+		t.Fatal("expected an end height before the start height to fail")
+	}
+}
@@ -8,5 +8,17 @@ import (
 
 // x/ugdmint module sentinel errors
 var (
-	ErrSample = errors.Register(ModuleName, 1100, "sample error")
+	ErrSample                   = errors.Register(ModuleName, 1100, "sample error")
+	ErrInvalidHedgehogSignature = errors.Register(ModuleName, 1101, "invalid hedgehog signature")
+	ErrNoHedgehogPublicKeys     = errors.Register(ModuleName, 1102, "no trusted hedgehog public keys configured")
+	ErrMintNotFound             = errors.Register(ModuleName, 1103, "no mint scheduled for height")
+	ErrSupplyCapReached         = errors.Register(ModuleName, 1104, "supply cap reached")
+	ErrNoDistributionKeeper     = errors.Register(ModuleName, 1105, "no distribution keeper to fund the community pool")
+	ErrInvalidResumeHeight      = errors.Register(ModuleName, 1106, "invalid resume height")
+	ErrInvalidActivationHeight  = errors.Register(ModuleName, 1107, "invalid activation height")
+	ErrParamsChangeNotFound     = errors.Register(ModuleName, 1108, "no params change scheduled for height")
+	ErrNothingToClaim           = errors.Register(ModuleName, 1109, "no escrowed coins to claim")
+	ErrNothingToClawback        = errors.Register(ModuleName, 1110, "no unvested coins to claw back")
+	ErrMintClawedBack           = errors.Register(ModuleName, 1111, "mint has already been clawed back")
+	ErrUnknownModuleAccount     = errors.Register(ModuleName, 1112, "module account is not registered")
 )
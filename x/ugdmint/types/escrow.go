@@ -0,0 +1,144 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+)
+
+// NewEscrowTranche returns the escrow tranche of the coins of a Hedgehog mint
+// paid out at blockHeight, unlocking under the policy from now on. Continuous
+// vesting unlocks every ContinuousUnlockSeconds, like it does when it is
+// merged into a vesting account.
+func (p VestingPolicy) NewEscrowTranche(blockHeight int64, coins sdk.Coins, now int64) (EscrowTranche, error) {
+	if p.Kind == VestingNone {
+		return EscrowTranche{}, fmt.Errorf("vesting policy %s has no coins to escrow", p.Kind)
+	}
+
+	acc, err := p.NewVestingAccount(&authtypes.BaseAccount{}, coins, now)
+	if err != nil {
+		return EscrowTranche{}, err
+	}
+	unlocks, err := vestingUnlocks(acc.(vestexported.VestingAccount), now)
+	if err != nil {
+		return EscrowTranche{}, err
+	}
+
+	tranche := EscrowTranche{BlockHeight: blockHeight}
+	for t, amount := range unlocks {
+		tranche.Unlocks = append(tranche.Unlocks, EscrowUnlock{UnlockTime: t, Amount: amount})
+	}
+	sort.Slice(tranche.Unlocks, func(i, j int) bool { return tranche.Unlocks[i].UnlockTime < tranche.Unlocks[j].UnlockTime })
+
+	return tranche, nil
+}
+
+// Claimable returns the coins of the tranche that have unlocked at now.
+func (t EscrowTranche) Claimable(now int64) sdk.Coins {
+	claimable := sdk.NewCoins()
+	for _, unlock := range t.Unlocks {
+		if unlock.UnlockTime <= now {
+			claimable = claimable.Add(unlock.Amount...)
+		}
+	}
+	return claimable
+}
+
+// Locked returns the coins of the tranche that have not unlocked at now.
+func (t EscrowTranche) Locked(now int64) sdk.Coins {
+	locked := sdk.NewCoins()
+	for _, unlock := range t.Unlocks {
+		if unlock.UnlockTime > now {
+			locked = locked.Add(unlock.Amount...)
+		}
+	}
+	return locked
+}
+
+// Claimable returns the escrowed coins that have unlocked at now.
+func (s EscrowSchedule) Claimable(now int64) sdk.Coins {
+	claimable := sdk.NewCoins()
+	for _, tranche := range s.Tranches {
+		claimable = claimable.Add(tranche.Claimable(now)...)
+	}
+	return claimable
+}
+
+// Locked returns the escrowed coins that have not unlocked at now.
+func (s EscrowSchedule) Locked(now int64) sdk.Coins {
+	locked := sdk.NewCoins()
+	for _, tranche := range s.Tranches {
+		locked = locked.Add(tranche.Locked(now)...)
+	}
+	return locked
+}
+
+// Claim removes the coins that have unlocked at now from the schedule and
+// returns them. Tranches that have been fully claimed are removed as well.
+func (s *EscrowSchedule) Claim(now int64) sdk.Coins {
+	claimed := s.Claimable(now)
+
+	tranches := s.Tranches[:0]
+	for _, tranche := range s.Tranches {
+		unlocks := tranche.Unlocks[:0]
+		for _, unlock := range tranche.Unlocks {
+			if unlock.UnlockTime > now {
+				unlocks = append(unlocks, unlock)
+			}
+		}
+		if len(unlocks) > 0 {
+			tranche.Unlocks = unlocks
+			tranches = append(tranches, tranche)
+		}
+	}
+	s.Tranches = tranches
+
+	return claimed
+}
+
+// Validate checks that the schedule belongs to a valid address and that every
+// tranche holds coins, in unlock order, and is escrowed only once.
+func (s EscrowSchedule) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(s.Address); err != nil {
+		return fmt.Errorf("invalid escrow address %s: %w", s.Address, err)
+	}
+
+	for i, tranche := range s.Tranches {
+		if i > 0 && tranche.BlockHeight <= s.Tranches[i-1].BlockHeight {
+			return fmt.Errorf("escrow tranches of %s are not ordered by block height", s.Address)
+		}
+		if len(tranche.Unlocks) == 0 {
+			return fmt.Errorf("escrow tranche of %s at block height %d holds no coins", s.Address, tranche.BlockHeight)
+		}
+		for j, unlock := range tranche.Unlocks {
+			if j > 0 && unlock.UnlockTime <= tranche.Unlocks[j-1].UnlockTime {
+				return fmt.Errorf("escrow tranche of %s at block height %d is not ordered by unlock time", s.Address, tranche.BlockHeight)
+			}
+			if !unlock.Amount.IsValid() || unlock.Amount.Empty() {
+				return fmt.Errorf("invalid escrowed coins of %s at block height %d: %s", s.Address, tranche.BlockHeight, unlock.Amount)
+			}
+		}
+	}
+
+	return nil
+}
+
+// ValidateEscrowSchedules checks that every escrow schedule is valid and that
+// no two schedules share a recipient.
+func ValidateEscrowSchedules(schedules []EscrowSchedule) error {
+	seen := make(map[string]bool, len(schedules))
+	for _, schedule := range schedules {
+		if err := schedule.Validate(); err != nil {
+			return err
+		}
+		if seen[schedule.Address] {
+			return fmt.Errorf("duplicate escrow schedule of %s", schedule.Address)
+		}
+		seen[schedule.Address] = true
+	}
+
+	return nil
+}
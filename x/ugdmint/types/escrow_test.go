@@ -0,0 +1,85 @@
+package types
+
+import "testing"
+
+func TestNewEscrowTranche(t *testing.T) {
+	const now = int64(100 * day)
+	policy := VestingPolicy{Kind: VestingContinuous, DurationSeconds: 3 * day, CliffSeconds: day, Escrow: true}
+
+	tranche, err := policy.NewEscrowTranche(42, ugd(200), now)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []EscrowUnlock{
+		{UnlockTime: now + 2*day, Amount: ugd(100)},
+		{UnlockTime: now + 3*day, Amount: ugd(100)},
+	}
+	if tranche.BlockHeight != 42 || len(tranche.Unlocks) != len(expected) {
+		t.Fatalf("expected unlocks %v at block height 42, got %v", expected, tranche)
+	}
+	for i := range expected {
+		if tranche.Unlocks[i].UnlockTime != expected[i].UnlockTime || !tranche.Unlocks[i].Amount.Equal(expected[i].Amount) {
+			t.Fatalf("expected unlocks %v, got %v", expected, tranche.Unlocks)
+		}
+	}
+
+	if _, err := (VestingPolicy{Kind: VestingNone}).NewEscrowTranche(42, ugd(200), now); err == nil {
+		t.Error("expected the vesting policy none to have nothing to escrow")
+	}
+}
+
+func TestEscrowScheduleClaim(t *testing.T) {
+	schedule := EscrowSchedule{
+		Address: "cosmos1w3jhxarpv3j8yvg4ufs4x",
+		Tranches: []EscrowTranche{
+			{BlockHeight: 1, Unlocks: []EscrowUnlock{{UnlockTime: 10, Amount: ugd(5)}, {UnlockTime: 20, Amount: ugd(5)}}},
+			{BlockHeight: 2, Unlocks: []EscrowUnlock{{UnlockTime: 15, Amount: ugd(7)}}},
+		},
+	}
+
+	if claimable, locked := schedule.Claimable(15), schedule.Locked(15); !claimable.Equal(ugd(12)) || !locked.Equal(ugd(5)) {
+		t.Fatalf("expected 12 claimable and 5 locked, got %s and %s", claimable, locked)
+	}
+	if claimed := schedule.Claim(15); !claimed.Equal(ugd(12)) {
+		t.Fatalf("expected to claim 12, got %s", claimed)
+	}
+	if len(schedule.Tranches) != 1 || len(schedule.Tranches[0].Unlocks) != 1 || schedule.Tranches[0].Unlocks[0].UnlockTime != 20 {
+		t.Fatalf("expected only the unlock at 20 to be left, got %v", schedule.Tranches)
+	}
+	if claimed := schedule.Claim(15); !claimed.IsZero() {
+		t.Fatalf("expected nothing left to claim, got %s", claimed)
+	}
+}
+
+func TestValidateEscrowSchedules(t *testing.T) {
+	valid := EscrowSchedule{
+		Address:  "cosmos1w3jhxarpv3j8yvg4ufs4x",
+		Tranches: []EscrowTranche{{BlockHeight: 1, Unlocks: []EscrowUnlock{{UnlockTime: 10, Amount: ugd(5)}}}},
+	}
+	if err := ValidateEscrowSchedules([]EscrowSchedule{valid}); err != nil {
+		t.Fatal(err)
+	}
+
+	invalid := map[string][]EscrowSchedule{
+		"duplicate recipient": {valid, valid},
+		"invalid address":     {{Address: "recipient", Tranches: valid.Tranches}},
+		"empty tranche":       {{Address: valid.Address, Tranches: []EscrowTranche{{BlockHeight: 1}}}},
+		"unordered tranches": {{Address: valid.Address, Tranches: []EscrowTranche{
+			{BlockHeight: 2, Unlocks: valid.Tranches[0].Unlocks},
+			{BlockHeight: 1, Unlocks: valid.Tranches[0].Unlocks},
+		}}},
+		"unordered unlocks": {{Address: valid.Address, Tranches: []EscrowTranche{{BlockHeight: 1, Unlocks: []EscrowUnlock{
+			{UnlockTime: 20, Amount: ugd(5)},
+			{UnlockTime: 10, Amount: ugd(5)},
+		}}}}},
+		"no coins": {{Address: valid.Address, Tranches: []EscrowTranche{{BlockHeight: 1, Unlocks: []EscrowUnlock{{UnlockTime: 10}}}}}},
+	}
+	for name, schedules := range invalid {
+		t.Run(name, func(t *testing.T) {
+			if err := ValidateEscrowSchedules(schedules); err == nil {
+				t.Error("expected the escrow schedules to be invalid")
+			}
+		})
+	}
+}
@@ -2,8 +2,36 @@ package types
 
 // Minting module event types
 const (
-	EventTypeUGDMint = ModuleName
+	EventTypeUGDMint               = ModuleName
+	EventTypeBlockTimeDeltaClamped = "block_time_delta_clamped"
+	EventTypeSupplyCapReached      = "supply_cap_reached"
+	EventTypeBondedAdjustment      = "bonded_adjustment"
+	EventTypeProvisionDistribution = "provision_distribution"
+	EventTypeMintingPaused         = "minting_paused"
+	EventTypeMintingResumed        = "minting_resumed"
+	EventTypeParamsChangeApplied   = "params_change_applied"
+	EventTypeMintEscrowed          = "mint_escrowed"
+	EventTypeEscrowClaimed         = "escrow_claimed"
+	EventTypeMintClawedBack        = "mint_clawed_back"
 
 	AttributeKeyBondedRatio            = "bonded_ratio"
 	AttributeKeySubsidyHalvingInterval = "subsidy_halving_interval"
+	AttributeKeyObservedDelta          = "observed_delta"
+	AttributeKeyClampedDelta           = "clamped_delta"
+	AttributeKeyRequested              = "requested"
+	AttributeKeyGoalBonded             = "goal_bonded"
+	AttributeKeyStakingBoost           = "staking_boost"
+	AttributeKeyStakers                = "stakers"
+	AttributeKeyCommunityPool          = "community_pool"
+	AttributeKeyBurned                 = "burned"
+	AttributeKeyDestination            = "destination"
+	AttributeKeyRecipient              = "recipient"
+	AttributeKeySource                 = "source"
+	AttributeKeyResumeHeight           = "resume_height"
+	AttributeKeyActivationHeight       = "activation_height"
+	AttributeKeyMintID                 = "mint_id"
+
+	// Sources of the minted coins
+	SourceBlockProvision = "block_provision"
+	SourceHedgehog       = "hedgehog"
 )
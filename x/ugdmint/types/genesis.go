@@ -1,6 +1,9 @@
 package types
 
 import (
+	"context"
+	"fmt"
+
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -8,16 +11,38 @@ import (
 // DefaultIndex is the default global index
 const DefaultIndex uint64 = 1
 
-// InflationCalculationFn defines the function required to calculate inflation rate during
-// BeginBlock. It receives the minter and params stored in the keeper, along with the current
-// bondedRatio and returns the newly calculated inflation rate.
-// It can be used to specify a custom inflation calculation logic, instead of relying on the
-// default logic provided by the sdk.
-type InflationCalculationFn func(ctx sdk.Context, minter Minter, params Params, bondedRatio math.LegacyDec) math.LegacyDec
+// InflationCalculationFn defines the emission strategy, the function required to calculate the
+// block provision during BeginBlock. It receives the minter and params stored in the keeper, along
+// with the current bondedRatio and the number of seconds the block is paid for, as returned by
+// BlockTimeDelta, and returns the coins to mint. Strategies that keep state between blocks may
+// update the minter, which is stored along with the provision.
+// It can be used to specify a custom emission logic, instead of relying on the UGD algorithm.
+type InflationCalculationFn func(ctx sdk.Context, minter *Minter, params Params, bondedRatio math.LegacyDec, blockTimeDelta uint64) (sdk.Coins, error)
 
-// DefaultInflationCalculationFn is the default function used to calculate inflation.
-func DefaultInflationCalculationFn(_ sdk.Context, minter Minter, params Params, bondedRatio math.LegacyDec) math.LegacyDec {
-	return math.LegacyNewDec(0)
+// DefaultInflationCalculationFn is the default emission strategy, the UGD algorithm of
+// BlockProvision.
+func DefaultInflationCalculationFn(ctx sdk.Context, minter *Minter, params Params, _ math.LegacyDec, blockTimeDelta uint64) (sdk.Coins, error) {
+	return minter.BlockProvisionForDelta(params, uint64(ctx.BlockHeight()), blockTimeDelta), nil
+}
+
+// NewBondedRatioInflationCalculationFn returns the emission strategy of the Cosmos SDK x/mint
+// module. The inflation rate follows the bonded ratio as computed by NextInflationRate and every
+// block is paid the annual provisions divided by BlocksPerYear, regardless of the block time.
+// stakingTokenSupply returns the supply the inflation rate applies to, usually the
+// StakingTokenSupply of the staking keeper.
+func NewBondedRatioInflationCalculationFn(stakingTokenSupply func(context.Context) (math.Int, error)) InflationCalculationFn {
+	return func(ctx sdk.Context, minter *Minter, params Params, bondedRatio math.LegacyDec, _ uint64) (sdk.Coins, error) {
+		totalSupply, err := stakingTokenSupply(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		minter.Inflation = minter.NextInflationRate(params, bondedRatio)
+		minter.AnnualProvisions = minter.NextAnnualProvisions(params, totalSupply)
+
+		provision := minter.AnnualProvisions.QuoInt(math.NewIntFromUint64(params.BlocksPerYear)).TruncateInt()
+		return sdk.NewCoins(sdk.NewCoin(params.MintDenom, provision)), nil
+	}
 }
 
 // NewGenesisState creates a new GenesisState object
@@ -43,9 +68,91 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
+	if err := ValidateProcessedMints(data.ProcessedMints); err != nil {
+		return err
+	}
+
+	if err := data.TotalMinted.Validate(); err != nil {
+		return fmt.Errorf("invalid total minted: %w", err)
+	}
+
+	if data.MintingPause != nil {
+		if err := data.MintingPause.Validate(); err != nil {
+			return fmt.Errorf("invalid minting pause: %w", err)
+		}
+	}
+
+	if err := ValidateScheduledParamsChanges(data.ScheduledParamsChanges); err != nil {
+		return err
+	}
+
+	if err := ValidateEscrowSchedules(data.EscrowSchedules); err != nil {
+		return err
+	}
+
+	if err := ValidateAgreedMints(data.AgreedMints); err != nil {
+		return err
+	}
+
 	return ValidateMinter(data.Minter)
 }
 
+// ValidateProcessedMints checks that every processed mint is well formed and
+// recorded only once.
+func ValidateProcessedMints(mints []ProcessedMint) error {
+	seen := make(map[string]bool, len(mints))
+	for _, mint := range mints {
+		hm := HedgehogMint{Address: mint.Address, Height: mint.Height, Amount: mint.Amount}
+		if err := hm.Validate(); err != nil {
+			return fmt.Errorf("invalid processed mint: %w", err)
+		}
+		if mint.Key != hm.Key() {
+			return fmt.Errorf("processed mint key %s does not match %s", mint.Key, hm.Key())
+		}
+		if seen[mint.Key] {
+			return fmt.Errorf("duplicate processed mint %s", mint.Key)
+		}
+		seen[mint.Key] = true
+	}
+
+	return nil
+}
+
+// ValidateScheduledParamsChanges checks that every scheduled params change is
+// valid and that no two changes share an activation height.
+func ValidateScheduledParamsChanges(changes []ScheduledParamsChange) error {
+	seen := make(map[int64]bool, len(changes))
+	for _, change := range changes {
+		if err := change.Validate(); err != nil {
+			return fmt.Errorf("invalid params change scheduled for height %d: %w", change.ActivationHeight, err)
+		}
+		if seen[change.ActivationHeight] {
+			return fmt.Errorf("duplicate params change scheduled for height %d", change.ActivationHeight)
+		}
+		seen[change.ActivationHeight] = true
+	}
+
+	return nil
+}
+
+// ValidateAgreedMints checks that the agreed mints of every height are valid,
+// belong to that height and that no two entries share a height.
+func ValidateAgreedMints(all []AgreedMints) error {
+	seen := make(map[int64]bool, len(all))
+	for _, agreed := range all {
+		ve := MintVoteExtension{Height: agreed.Height, Mints: agreed.Mints}
+		if err := ve.Validate(); err != nil {
+			return fmt.Errorf("invalid mints agreed on for height %d: %w", agreed.Height, err)
+		}
+		if seen[agreed.Height] {
+			return fmt.Errorf("duplicate mints agreed on for height %d", agreed.Height)
+		}
+		seen[agreed.Height] = true
+	}
+
+	return nil
+}
+
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
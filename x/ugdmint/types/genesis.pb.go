@@ -5,18 +5,24 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -30,6 +36,27 @@ type GenesisState struct {
 	Minter Minter `protobuf:"bytes,1,opt,name=minter,proto3" json:"minter"`
 	// params defines all the parameters of the module.
 	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// processed_mints holds the Hedgehog mints that have already been paid out.
+	ProcessedMints []ProcessedMint `protobuf:"bytes,3,rep,name=processed_mints,json=processedMints,proto3" json:"processed_mints"`
+	// previous_block_time is the time of the last block the module has seen. It
+	// is unset for a new chain.
+	PreviousBlockTime *time.Time `protobuf:"bytes,4,opt,name=previous_block_time,json=previousBlockTime,proto3,stdtime" json:"previous_block_time,omitempty"`
+	// total_minted is the supply of every denom counted against the max_supply
+	// param. Denoms missing from it are counted from their supply in the bank
+	// module.
+	TotalMinted github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=total_minted,json=totalMinted,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_minted"`
+	// minting_pause holds the kinds of minting that are paused. It is unset when
+	// nothing is paused.
+	MintingPause *MintingPause `protobuf:"bytes,6,opt,name=minting_pause,json=mintingPause,proto3" json:"minting_pause,omitempty"`
+	// scheduled_params_changes holds the params changes that are still to be
+	// applied.
+	ScheduledParamsChanges []ScheduledParamsChange `protobuf:"bytes,7,rep,name=scheduled_params_changes,json=scheduledParamsChanges,proto3" json:"scheduled_params_changes"`
+	// escrow_schedules holds the coins of Hedgehog mints that are still in
+	// escrow.
+	EscrowSchedules []EscrowSchedule `protobuf:"bytes,8,rep,name=escrow_schedules,json=escrowSchedules,proto3" json:"escrow_schedules"`
+	// agreed_mints holds the Hedgehog mints agreed on while Hedgehog mints were
+	// paused, which are paid out once they are resumed.
+	AgreedMints []AgreedMints `protobuf:"bytes,9,rep,name=agreed_mints,json=agreedMints,proto3" json:"agreed_mints"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -79,6 +106,55 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetProcessedMints() []ProcessedMint {
+	if m != nil {
+		return m.ProcessedMints
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPreviousBlockTime() *time.Time {
+	if m != nil {
+		return m.PreviousBlockTime
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTotalMinted() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalMinted
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMintingPause() *MintingPause {
+	if m != nil {
+		return m.MintingPause
+	}
+	return nil
+}
+
+func (m *GenesisState) GetScheduledParamsChanges() []ScheduledParamsChange {
+	if m != nil {
+		return m.ScheduledParamsChanges
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEscrowSchedules() []EscrowSchedule {
+	if m != nil {
+		return m.EscrowSchedules
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAgreedMints() []AgreedMints {
+	if m != nil {
+		return m.AgreedMints
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.ugdmint.v1beta1.GenesisState")
 }
@@ -88,23 +164,44 @@ func init() {
 }
 
 var fileDescriptor_bc17f5fd64cfe7ce = []byte{
-	// 251 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x49, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x2f, 0x4d, 0x4f, 0xc9, 0xcd, 0xcc, 0x2b, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d,
-	0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x12, 0x83, 0xa8, 0xd2, 0x83, 0xaa, 0xd2, 0x83, 0xaa, 0x92, 0x12, 0x49, 0xcf, 0x4f, 0xcf,
-	0x07, 0x2b, 0xd1, 0x07, 0xb1, 0x20, 0xaa, 0xa5, 0x94, 0x71, 0x98, 0x59, 0x90, 0x58, 0x94, 0x98,
-	0x0b, 0x35, 0x52, 0x4a, 0x30, 0x31, 0x37, 0x33, 0x2f, 0x5f, 0x1f, 0x4c, 0x42, 0x84, 0x94, 0xa6,
-	0x30, 0x72, 0xf1, 0xb8, 0x43, 0xec, 0x0d, 0x2e, 0x49, 0x2c, 0x49, 0x15, 0x72, 0xe4, 0x62, 0x03,
-	0x19, 0x90, 0x5a, 0x24, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x6d, 0x24, 0xa7, 0x87, 0xdd, 0x1d, 0x7a,
-	0xbe, 0x60, 0x55, 0x4e, 0x9c, 0x27, 0xee, 0xc9, 0x33, 0xac, 0x78, 0xbe, 0x41, 0x8b, 0x31, 0x08,
-	0xaa, 0x11, 0x64, 0x04, 0xc4, 0x5a, 0x09, 0x26, 0xfc, 0x46, 0x04, 0x80, 0x55, 0xa1, 0x18, 0x01,
-	0xd1, 0xe8, 0x14, 0x7c, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31,
-	0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x96, 0xe9,
-	0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0xa5, 0x79, 0x99, 0xe9, 0x45, 0x99,
-	0x29, 0xba, 0x05, 0x45, 0xf9, 0x59, 0xa9, 0xc9, 0x25, 0xfa, 0x10, 0x6b, 0x74, 0x61, 0x61, 0x50,
-	0x01, 0x0f, 0x8d, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0, 0x97, 0x8d, 0x01, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x97, 0xcf, 0xa4, 0x96, 0x80, 0x01, 0x00, 0x00,
+	// 589 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x63, 0x5a, 0x02, 0x75, 0x02, 0xa5, 0x06, 0x55, 0x26, 0x83, 0x53, 0xd1, 0x82, 0xa2,
+	0xa2, 0xda, 0x6a, 0x99, 0x18, 0x9b, 0x0a, 0x10, 0x03, 0x52, 0x48, 0x58, 0x40, 0x48, 0xd6, 0xd9,
+	0x7e, 0x5c, 0x8e, 0xc6, 0x3e, 0xeb, 0xde, 0x39, 0xb4, 0x3b, 0x1f, 0xa0, 0x33, 0x9f, 0x00, 0x31,
+	0x75, 0xe4, 0x23, 0x74, 0xec, 0xc8, 0x44, 0x51, 0x32, 0xf4, 0x6b, 0xa0, 0x3b, 0xdb, 0x91, 0x2b,
+	0xd5, 0x5d, 0x12, 0x9f, 0xef, 0xf7, 0xff, 0xbf, 0xf7, 0xfc, 0xbf, 0x33, 0xb7, 0x42, 0x8e, 0x31,
+	0x47, 0x2f, 0xa3, 0x51, 0xcc, 0x12, 0xe9, 0x4d, 0x77, 0x03, 0x90, 0x64, 0xd7, 0xa3, 0x90, 0x00,
+	0x32, 0x74, 0x53, 0xc1, 0x25, 0xb7, 0xd6, 0x73, 0xca, 0x2d, 0x28, 0xb7, 0xa0, 0x3a, 0x8f, 0x28,
+	0xa7, 0x5c, 0x23, 0x9e, 0x7a, 0xca, 0xe9, 0x4e, 0x97, 0x72, 0x4e, 0x27, 0xe0, 0xe9, 0x55, 0x90,
+	0x7d, 0xf1, 0x24, 0x8b, 0x01, 0x25, 0x89, 0xd3, 0x02, 0xd8, 0xac, 0x29, 0x9a, 0x12, 0x41, 0xe2,
+	0xa2, 0x66, 0x67, 0x8d, 0xc4, 0x2c, 0xe1, 0x9e, 0xfe, 0x2d, 0x5e, 0xf5, 0x6a, 0x74, 0x6a, 0xe1,
+	0x0b, 0x08, 0xb9, 0x88, 0x0a, 0xd2, 0x29, 0xc8, 0x80, 0x20, 0x2c, 0xb0, 0x90, 0xb3, 0xa4, 0xd8,
+	0x7f, 0x5e, 0xe3, 0x34, 0xe5, 0x12, 0x7c, 0x38, 0x92, 0x90, 0x20, 0xe3, 0x05, 0xfc, 0xe4, 0x77,
+	0xd3, 0x6c, 0xbf, 0xc9, 0xbf, 0xc7, 0x48, 0x12, 0x09, 0xd6, 0xbe, 0xd9, 0x54, 0x2a, 0x10, 0xb6,
+	0xb1, 0x61, 0xf4, 0x5a, 0x7b, 0x8e, 0x7b, 0xfd, 0xf7, 0x71, 0xdf, 0x69, 0xaa, 0xbf, 0x72, 0xf6,
+	0xb7, 0xdb, 0xf8, 0x79, 0x79, 0xba, 0x6d, 0x0c, 0x0b, 0xa1, 0xb2, 0xc8, 0xa7, 0xb5, 0x6f, 0xdd,
+	0x6c, 0x31, 0xd0, 0xd4, 0x15, 0x8b, 0x5c, 0x68, 0x7d, 0x34, 0x57, 0x53, 0xc1, 0x43, 0x40, 0x84,
+	0xc8, 0x57, 0x1a, 0xb4, 0x97, 0x36, 0x96, 0x7a, 0xad, 0xbd, 0xa7, 0xb5, 0x5e, 0x25, 0xae, 0xfa,
+	0xaa, 0x5a, 0xde, 0x4f, 0xab, 0x3b, 0x68, 0x0d, 0xcc, 0x87, 0xa9, 0x80, 0x29, 0xe3, 0x19, 0xfa,
+	0xc1, 0x84, 0x87, 0x87, 0xbe, 0x8a, 0xd0, 0x5e, 0xd6, 0xad, 0x76, 0xdc, 0x3c, 0x5f, 0xb7, 0xcc,
+	0xd7, 0xfd, 0x50, 0xe6, 0xdb, 0x5f, 0x3e, 0xb9, 0xe8, 0x1a, 0xc3, 0xb5, 0x52, 0xdc, 0x57, 0x5a,
+	0xb5, 0x6b, 0x7d, 0x37, 0xcc, 0xb6, 0xe4, 0x92, 0x4c, 0x74, 0xa7, 0x10, 0xd9, 0xb7, 0x75, 0xab,
+	0x8f, 0xcb, 0x56, 0x55, 0x50, 0x8b, 0x3e, 0x0f, 0x38, 0x4b, 0xfa, 0xaf, 0x55, 0x7b, 0xbf, 0x2e,
+	0xba, 0x3d, 0xca, 0xe4, 0x38, 0x0b, 0xdc, 0x90, 0xc7, 0x5e, 0x91, 0x5a, 0xfe, 0xb7, 0x83, 0xd1,
+	0xa1, 0x27, 0x8f, 0x53, 0x40, 0x2d, 0xc0, 0x1f, 0x97, 0xa7, 0xdb, 0xed, 0x09, 0x50, 0x12, 0x1e,
+	0xfb, 0x2a, 0x6a, 0xcc, 0x67, 0x6b, 0xe9, 0xb2, 0x3a, 0x89, 0xc8, 0x7a, 0x6b, 0xde, 0x53, 0xf5,
+	0x59, 0x42, 0xfd, 0x94, 0x64, 0x08, 0x76, 0x53, 0x8f, 0xb4, 0x75, 0x53, 0x80, 0x2c, 0xa1, 0x03,
+	0xc5, 0x0e, 0xdb, 0x71, 0x65, 0x65, 0x09, 0xd3, 0xc6, 0x70, 0x0c, 0x51, 0x36, 0x81, 0xc8, 0xcf,
+	0x23, 0xf1, 0xc3, 0x31, 0x49, 0x28, 0xa0, 0x7d, 0x47, 0x0f, 0xb7, 0x53, 0xe7, 0x3a, 0x2a, 0x75,
+	0x79, 0xb8, 0x07, 0x5a, 0x55, 0xcd, 0x63, 0x1d, 0xaf, 0x23, 0xd0, 0xfa, 0x6c, 0x3e, 0x00, 0x0c,
+	0x05, 0xff, 0xe6, 0x97, 0x00, 0xda, 0x77, 0x75, 0xad, 0x67, 0x75, 0xb5, 0x5e, 0x69, 0xbe, 0xac,
+	0x58, 0x2d, 0xb2, 0x0a, 0x57, 0xb6, 0xd0, 0x7a, 0x6f, 0xb6, 0x09, 0x15, 0xb0, 0x38, 0x4d, 0x2b,
+	0xda, 0x79, 0xb3, 0xce, 0x79, 0x5f, 0xb3, 0xfa, 0xc0, 0x54, 0x6d, 0x5b, 0xa4, 0xf2, 0x7e, 0x74,
+	0x36, 0x73, 0x8c, 0xf3, 0x99, 0x63, 0xfc, 0x9b, 0x39, 0xc6, 0xc9, 0xdc, 0x69, 0x9c, 0xcf, 0x9d,
+	0xc6, 0x9f, 0xb9, 0xd3, 0xf8, 0xf4, 0xb2, 0x12, 0x6b, 0x96, 0x30, 0x2a, 0x58, 0xb4, 0x93, 0x0a,
+	0xfe, 0x15, 0x42, 0x59, 0xe6, 0x5b, 0x5e, 0xce, 0xa3, 0xc5, 0x35, 0xd5, 0x69, 0x07, 0x4d, 0x7d,
+	0xf0, 0x5e, 0xfc, 0x1f, 0x00, 0xb7, 0x06, 0x69, 0xe1, 0xbc, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -127,6 +224,98 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AgreedMints) > 0 {
+		for iNdEx := len(m.AgreedMints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AgreedMints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.EscrowSchedules) > 0 {
+		for iNdEx := len(m.EscrowSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EscrowSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ScheduledParamsChanges) > 0 {
+		for iNdEx := len(m.ScheduledParamsChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledParamsChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.MintingPause != nil {
+		{
+			size, err := m.MintingPause.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.TotalMinted) > 0 {
+		for iNdEx := len(m.TotalMinted) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalMinted[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.PreviousBlockTime != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.PreviousBlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.PreviousBlockTime):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintGenesis(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ProcessedMints) > 0 {
+		for iNdEx := len(m.ProcessedMints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProcessedMints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -171,6 +360,44 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ProcessedMints) > 0 {
+		for _, e := range m.ProcessedMints {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.PreviousBlockTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.PreviousBlockTime)
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.TotalMinted) > 0 {
+		for _, e := range m.TotalMinted {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.MintingPause != nil {
+		l = m.MintingPause.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.ScheduledParamsChanges) > 0 {
+		for _, e := range m.ScheduledParamsChanges {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EscrowSchedules) > 0 {
+		for _, e := range m.EscrowSchedules {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AgreedMints) > 0 {
+		for _, e := range m.AgreedMints {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -275,6 +502,248 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessedMints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessedMints = append(m.ProcessedMints, ProcessedMint{})
+			if err := m.ProcessedMints[len(m.ProcessedMints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousBlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PreviousBlockTime == nil {
+				m.PreviousBlockTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.PreviousBlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalMinted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalMinted = append(m.TotalMinted, types.Coin{})
+			if err := m.TotalMinted[len(m.TotalMinted)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintingPause", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MintingPause == nil {
+				m.MintingPause = &MintingPause{}
+			}
+			if err := m.MintingPause.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledParamsChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledParamsChanges = append(m.ScheduledParamsChanges, ScheduledParamsChange{})
+			if err := m.ScheduledParamsChanges[len(m.ScheduledParamsChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EscrowSchedules = append(m.EscrowSchedules, EscrowSchedule{})
+			if err := m.EscrowSchedules[len(m.EscrowSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgreedMints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AgreedMints = append(m.AgreedMints, AgreedMints{})
+			if err := m.AgreedMints[len(m.AgreedMints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
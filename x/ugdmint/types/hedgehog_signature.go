@@ -0,0 +1,112 @@
+package types
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/errors"
+	"github.com/spf13/viper"
+)
+
+// HedgehogPublicKeysConfigKey is the app.toml key holding the list of Hedgehog
+// public keys that are trusted to sign mint-storage responses.
+const HedgehogPublicKeysConfigKey = "hedgehog.hedgehog_public_keys"
+
+// hedgehogEnvelope captures the signed part of a Hedgehog response exactly as
+// it was received, so the signature is checked against the original bytes and
+// not against a re-encoding of them.
+type hedgehogEnvelope struct {
+	Data      json.RawMessage `json:"data"`
+	Signature string          `json:"signature"`
+}
+
+// ParseHedgehogPublicKey parses a trusted Hedgehog public key. The key must be
+// an ECDSA P-521 key, given either as a PEM block or as base64 encoded PKIX DER.
+func ParseHedgehogPublicKey(encoded string) (*ecdsa.PublicKey, error) {
+	encoded = strings.TrimSpace(encoded)
+
+	var der []byte
+	if block, _ := pem.Decode([]byte(encoded)); block != nil {
+		der = block.Bytes
+	} else {
+		b, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("hedgehog public key is neither PEM nor base64: %w", err)
+		}
+		der = b
+	}
+
+	pub, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse hedgehog public key: %w", err)
+	}
+
+	key, ok := pub.(*ecdsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("hedgehog public key must be an ECDSA key, got %T", pub)
+	}
+	if key.Curve != elliptic.P521() {
+		return nil, fmt.Errorf("hedgehog public key must use curve P-521, got %s", key.Curve.Params().Name)
+	}
+
+	return key, nil
+}
+
+// HedgehogPublicKeys returns the trusted Hedgehog public keys configured in
+// app.toml. Keys that fail to parse are logged and skipped.
+func HedgehogPublicKeys() []*ecdsa.PublicKey {
+	var keys []*ecdsa.PublicKey
+	for _, encoded := range viper.GetStringSlice(HedgehogPublicKeysConfigKey) {
+		key, err := ParseHedgehogPublicKey(encoded)
+		if err != nil {
+			fmt.Printf("HedgehogPublicKeys: Skipping invalid key: %s\n", err.Error())
+			continue
+		}
+		keys = append(keys, key)
+	}
+
+	return keys
+}
+
+// VerifyHedgehogSignature checks that the body of a Hedgehog mint-storage
+// response carries a valid signature from one of the trusted keys.
+//
+// The signature is a base64 encoded ASN.1 ECDSA P-521 signature over the
+// SHA-512 digest of the raw "data" object of the response.
+func VerifyHedgehogSignature(body []byte, keys []*ecdsa.PublicKey) error {
+	if len(keys) == 0 {
+		return ErrNoHedgehogPublicKeys
+	}
+
+	var envelope hedgehogEnvelope
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return errors.Wrap(ErrInvalidHedgehogSignature, err.Error())
+	}
+	if len(envelope.Data) == 0 {
+		return errors.Wrap(ErrInvalidHedgehogSignature, "response has no data")
+	}
+	if envelope.Signature == "" {
+		return errors.Wrap(ErrInvalidHedgehogSignature, "response is not signed")
+	}
+
+	sig, err := base64.StdEncoding.DecodeString(envelope.Signature)
+	if err != nil {
+		return errors.Wrapf(ErrInvalidHedgehogSignature, "signature is not base64: %s", err)
+	}
+
+	digest := sha512.Sum512(envelope.Data)
+	for _, key := range keys {
+		if ecdsa.VerifyASN1(key, digest[:], sig) {
+			return nil
+		}
+	}
+
+	return errors.Wrap(ErrInvalidHedgehogSignature, "signature does not match any trusted key")
+}
@@ -1,9 +1,35 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 var (
 	// MinterKey is the key to use for the keeper store.
 	MinterKey = []byte{0x00}
 	ParamsKey = []byte{0x01}
+	// AgreedMintsKeyPrefix is the prefix under which the Hedgehog mints agreed
+	// on through vote extensions are stored, keyed by block height.
+	AgreedMintsKeyPrefix = []byte{0x02}
+	// ProcessedMintKeyPrefix is the prefix under which the Hedgehog mints that
+	// have been paid out are stored, keyed by their hedgehog key.
+	ProcessedMintKeyPrefix = []byte{0x03}
+	// PreviousBlockTimeKey is the key under which the time of the last block
+	// seen by the module is stored.
+	PreviousBlockTimeKey = []byte{0x04}
+	// TotalMintedKeyPrefix is the prefix under which the amount the module has
+	// minted so far is stored, keyed by denom.
+	TotalMintedKeyPrefix = []byte{0x05}
+	// MintingPauseKey is the key under which the kinds of minting paused by
+	// governance are stored.
+	MintingPauseKey = []byte{0x06}
+	// ScheduledParamsChangeKeyPrefix is the prefix under which the params
+	// changes scheduled by governance are stored, keyed by activation height.
+	ScheduledParamsChangeKeyPrefix = []byte{0x07}
+	// EscrowScheduleKeyPrefix is the prefix under which the escrowed coins of
+	// the recipients of Hedgehog mints are stored, keyed by address.
+	EscrowScheduleKeyPrefix = []byte{0x08}
 )
 
 const (
@@ -12,4 +38,38 @@ const (
 
 	// StoreKey defines the primary module store key
 	StoreKey = ModuleName
+
+	// EscrowAccountName is the name of the module account that holds the
+	// escrowed coins of Hedgehog mints
+	EscrowAccountName = "ugdmint_escrow"
 )
+
+// AgreedMintsKey returns the store key of the agreed mints for a block height.
+func AgreedMintsKey(height int64) []byte {
+	return append(AgreedMintsKeyPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// ProcessedMintKey returns the store key of a processed mint from its hedgehog
+// key (address/height). The timestamp of the payload is left out on purpose:
+// the same entry is published again in later payloads with newer timestamps
+// and must still be paid only once.
+func ProcessedMintKey(hedgehogKey string) []byte {
+	return append(ProcessedMintKeyPrefix, []byte(hedgehogKey)...)
+}
+
+// TotalMintedKey returns the store key of the amount minted of a denom.
+func TotalMintedKey(denom string) []byte {
+	return append(TotalMintedKeyPrefix, []byte(denom)...)
+}
+
+// ScheduledParamsChangeKey returns the store key of the params change
+// scheduled for an activation height.
+func ScheduledParamsChangeKey(height int64) []byte {
+	return append(ScheduledParamsChangeKeyPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// EscrowScheduleKey returns the store key of the escrowed coins of a
+// recipient.
+func EscrowScheduleKey(addr sdk.AccAddress) []byte {
+	return append(EscrowScheduleKeyPrefix, address.MustLengthPrefix(addr)...)
+}
@@ -31,6 +31,12 @@ type MintRecord struct {
 	BlockHeight int64                                    `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
 	Account     string                                   `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
 	Amount      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// vesting of the minted coins, unset for mints recorded before the vesting
+	// was recorded
+	Vesting *MintVesting `protobuf:"bytes,4,opt,name=vesting,proto3" json:"vesting,omitempty"`
+	// clawback of the unvested coins by governance, unset unless the mint has
+	// been clawed back
+	Clawback *MintClawback `protobuf:"bytes,5,opt,name=clawback,proto3" json:"clawback,omitempty"`
 }
 
 func (m *MintRecord) Reset()         { *m = MintRecord{} }
@@ -87,128 +93,1984 @@ func (m *MintRecord) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*MintRecord)(nil), "cosmos.ugdmint.v1beta1.MintRecord")
+func (m *MintRecord) GetVesting() *MintVesting {
+	if m != nil {
+		return m.Vesting
+	}
+	return nil
 }
 
-func init() {
-	proto.RegisterFile("cosmos/ugdmint/v1beta1/mint_record.proto", fileDescriptor_39b2ccc048ad7222)
+func (m *MintRecord) GetClawback() *MintClawback {
+	if m != nil {
+		return m.Clawback
+	}
+	return nil
 }
 
-var fileDescriptor_39b2ccc048ad7222 = []byte{
-	// 323 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x91, 0xb1, 0x4e, 0xc3, 0x30,
-	0x10, 0x86, 0x63, 0x2a, 0x15, 0x91, 0x76, 0x21, 0x42, 0xa8, 0x74, 0x70, 0x0b, 0x53, 0x54, 0xa9,
-	0xb1, 0x0a, 0x13, 0x6b, 0x91, 0x10, 0x0b, 0x4b, 0xd8, 0x58, 0xaa, 0xc4, 0xb1, 0x5c, 0xd3, 0xc6,
-	0x57, 0xc5, 0x0e, 0xa2, 0x6f, 0xc1, 0xcc, 0x13, 0x20, 0xa6, 0x3e, 0x04, 0x43, 0xc7, 0x8e, 0x4c,
-	0x80, 0x9a, 0xa1, 0xaf, 0x81, 0xe2, 0x38, 0x15, 0x8b, 0x7d, 0xf7, 0xdf, 0x6f, 0x7d, 0xbf, 0x7c,
-	0xae, 0x4f, 0x41, 0xa5, 0xa0, 0x48, 0xce, 0x93, 0x54, 0x48, 0x4d, 0x9e, 0x47, 0x31, 0xd3, 0xd1,
-	0x88, 0x94, 0xcd, 0x24, 0x63, 0x14, 0xb2, 0x24, 0x58, 0x64, 0xa0, 0xc1, 0x3b, 0xad, 0x9c, 0x81,
-	0x75, 0x06, 0xd6, 0xd9, 0x3d, 0x8e, 0x52, 0x21, 0x81, 0x98, 0xb3, 0xb2, 0x76, 0x4f, 0x38, 0x70,
-	0x30, 0x25, 0x29, 0x2b, 0xab, 0x62, 0x8b, 0x8a, 0x23, 0xc5, 0xf6, 0x1c, 0x0a, 0x42, 0x56, 0xf3,
-	0x8b, 0x4f, 0xe4, 0xba, 0xf7, 0x42, 0xea, 0xd0, 0x50, 0xbd, 0x73, 0xb7, 0x1d, 0xcf, 0x81, 0xce,
-	0x26, 0x53, 0x26, 0xf8, 0x54, 0x77, 0x50, 0x1f, 0xf9, 0x8d, 0xb0, 0x65, 0xb4, 0x3b, 0x23, 0x79,
-	0x1d, 0xf7, 0x30, 0xa2, 0x14, 0x72, 0xa9, 0x3b, 0x07, 0x7d, 0xe4, 0x1f, 0x85, 0x75, 0xeb, 0x2d,
-	0xdd, 0x66, 0x94, 0x9a, 0x41, 0xa3, 0xdf, 0xf0, 0x5b, 0x97, 0x67, 0x81, 0x4d, 0x5f, 0xc2, 0xeb,
-	0xe8, 0xc1, 0x0d, 0x08, 0x39, 0xbe, 0x5d, 0x7f, 0xf7, 0x9c, 0x8f, 0x9f, 0x9e, 0xcf, 0x85, 0x9e,
-	0xe6, 0x71, 0x40, 0x21, 0x25, 0x36, 0x69, 0x75, 0x0d, 0x55, 0x32, 0x23, 0x7a, 0xb9, 0x60, 0xca,
-	0x3c, 0x50, 0x6f, 0xbb, 0xd5, 0xa0, 0x3d, 0x67, 0x3c, 0xa2, 0xcb, 0x49, 0x19, 0x5f, 0xbd, 0xef,
-	0x56, 0x03, 0x14, 0x5a, 0xe0, 0xf8, 0x61, 0xbd, 0xc5, 0x68, 0xb3, 0xc5, 0xe8, 0x77, 0x8b, 0xd1,
-	0x6b, 0x81, 0x9d, 0x4d, 0x81, 0x9d, 0xaf, 0x02, 0x3b, 0x8f, 0xd7, 0xff, 0x08, 0xb9, 0x14, 0x3c,
-	0x13, 0xc9, 0x70, 0x91, 0xc1, 0x13, 0xa3, 0xba, 0x46, 0xd5, 0x6b, 0x78, 0xd9, 0x2f, 0xc4, 0x80,
-	0xe3, 0xa6, 0xf9, 0xa2, 0xab, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa3, 0x94, 0x4f, 0xac, 0xaf,
-	0x01, 0x00, 0x00,
+// MintVesting records how the coins of a Hedgehog mint vest.
+type MintVesting struct {
+	// whether the coins are held in escrow instead of vesting in the account of
+	// the recipient
+	Escrowed bool `protobuf:"varint,1,opt,name=escrowed,proto3" json:"escrowed,omitempty"`
+	// unlocks of the coins under the vesting policy applied to the mint, empty
+	// when the coins were paid out unlocked
+	Unlocks []EscrowUnlock `protobuf:"bytes,2,rep,name=unlocks,proto3" json:"unlocks"`
 }
 
-func (m *MintRecord) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MintVesting) Reset()         { *m = MintVesting{} }
+func (m *MintVesting) String() string { return proto.CompactTextString(m) }
+func (*MintVesting) ProtoMessage()    {}
+func (*MintVesting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39b2ccc048ad7222, []int{1}
+}
+func (m *MintVesting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintVesting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintVesting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MintVesting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintVesting.Merge(m, src)
+}
+func (m *MintVesting) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintVesting) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintVesting.DiscardUnknown(m)
 }
 
-func (m *MintRecord) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MintVesting proto.InternalMessageInfo
+
+func (m *MintVesting) GetEscrowed() bool {
+	if m != nil {
+		return m.Escrowed
+	}
+	return false
 }
 
-func (m *MintRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
+func (m *MintVesting) GetUnlocks() []EscrowUnlock {
+	if m != nil {
+		return m.Unlocks
+	}
+	return nil
+}
+
+// MintClawback records the clawback of the unvested coins of a Hedgehog mint.
+type MintClawback struct {
+	// block height at which the coins were clawed back
+	BlockHeight int64 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// coins that were clawed back
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// where the coins went, either community_pool or burn
+	Destination string `protobuf:"bytes,3,opt,name=destination,proto3" json:"destination,omitempty"`
+}
+
+func (m *MintClawback) Reset()         { *m = MintClawback{} }
+func (m *MintClawback) String() string { return proto.CompactTextString(m) }
+func (*MintClawback) ProtoMessage()    {}
+func (*MintClawback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39b2ccc048ad7222, []int{2}
+}
+func (m *MintClawback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintClawback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintClawback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintClawback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintClawback.Merge(m, src)
+}
+func (m *MintClawback) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintClawback) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintClawback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintClawback proto.InternalMessageInfo
+
+func (m *MintClawback) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *MintClawback) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *MintClawback) GetDestination() string {
+	if m != nil {
+		return m.Destination
+	}
+	return ""
+}
+
+// ProcessedMint records a Hedgehog mint that has been paid out, so that it is
+// never paid twice.
+type ProcessedMint struct {
+	// hedgehog key of the mint, in address/height form
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// bech32 address of the account that received the mint
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// height the mint was scheduled for by Hedgehog
+	Height uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// amount of uugd minted
+	Amount int64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// timestamp of the Hedgehog payload the mint was taken from
+	Timestamp string `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// block height at which the mint was paid out
+	BlockHeight int64 `protobuf:"varint,6,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (m *ProcessedMint) Reset()         { *m = ProcessedMint{} }
+func (m *ProcessedMint) String() string { return proto.CompactTextString(m) }
+func (*ProcessedMint) ProtoMessage()    {}
+func (*ProcessedMint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39b2ccc048ad7222, []int{3}
+}
+func (m *ProcessedMint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProcessedMint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProcessedMint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProcessedMint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProcessedMint.Merge(m, src)
+}
+func (m *ProcessedMint) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProcessedMint) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProcessedMint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProcessedMint proto.InternalMessageInfo
+
+func (m *ProcessedMint) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ProcessedMint) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ProcessedMint) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ProcessedMint) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *ProcessedMint) GetTimestamp() string {
+	if m != nil {
+		return m.Timestamp
+	}
+	return ""
+}
+
+func (m *ProcessedMint) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+// MintingPause records which kinds of minting governance has paused.
+type MintingPause struct {
+	// block_provisions_paused stops the block provision from being minted.
+	BlockProvisionsPaused bool `protobuf:"varint,1,opt,name=block_provisions_paused,json=blockProvisionsPaused,proto3" json:"block_provisions_paused,omitempty"`
+	// hedgehog_mints_paused stops the agreed Hedgehog mints from being paid out.
+	HedgehogMintsPaused bool `protobuf:"varint,2,opt,name=hedgehog_mints_paused,json=hedgehogMintsPaused,proto3" json:"hedgehog_mints_paused,omitempty"`
+	// resume_height is the height from which minting resumes by itself, zero
+	// when the pause lasts until it is lifted.
+	ResumeHeight int64 `protobuf:"varint,3,opt,name=resume_height,json=resumeHeight,proto3" json:"resume_height,omitempty"`
+}
+
+func (m *MintingPause) Reset()         { *m = MintingPause{} }
+func (m *MintingPause) String() string { return proto.CompactTextString(m) }
+func (*MintingPause) ProtoMessage()    {}
+func (*MintingPause) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39b2ccc048ad7222, []int{4}
+}
+func (m *MintingPause) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintingPause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintingPause.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintingPause) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintingPause.Merge(m, src)
+}
+func (m *MintingPause) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintingPause) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintingPause.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintingPause proto.InternalMessageInfo
+
+func (m *MintingPause) GetBlockProvisionsPaused() bool {
+	if m != nil {
+		return m.BlockProvisionsPaused
+	}
+	return false
+}
+
+func (m *MintingPause) GetHedgehogMintsPaused() bool {
+	if m != nil {
+		return m.HedgehogMintsPaused
+	}
+	return false
+}
+
+func (m *MintingPause) GetResumeHeight() int64 {
+	if m != nil {
+		return m.ResumeHeight
+	}
+	return 0
+}
+
+// EscrowUnlock is an amount of escrowed coins along with the time it unlocks.
+type EscrowUnlock struct {
+	// unix time in seconds the coins unlock at
+	UnlockTime int64 `protobuf:"varint,1,opt,name=unlock_time,json=unlockTime,proto3" json:"unlock_time,omitempty"`
+	// coins that unlock
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *EscrowUnlock) Reset()         { *m = EscrowUnlock{} }
+func (m *EscrowUnlock) String() string { return proto.CompactTextString(m) }
+func (*EscrowUnlock) ProtoMessage()    {}
+func (*EscrowUnlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39b2ccc048ad7222, []int{5}
+}
+func (m *EscrowUnlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EscrowUnlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EscrowUnlock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EscrowUnlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EscrowUnlock.Merge(m, src)
+}
+func (m *EscrowUnlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *EscrowUnlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_EscrowUnlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EscrowUnlock proto.InternalMessageInfo
+
+func (m *EscrowUnlock) GetUnlockTime() int64 {
+	if m != nil {
+		return m.UnlockTime
+	}
+	return 0
+}
+
+func (m *EscrowUnlock) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// EscrowTranche holds the coins of a Hedgehog mint that are still in escrow.
+type EscrowTranche struct {
+	// block height at which the mint was paid out
+	BlockHeight int64 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// unlocks of the coins that have not been claimed yet, ordered by time
+	Unlocks []EscrowUnlock `protobuf:"bytes,2,rep,name=unlocks,proto3" json:"unlocks"`
+}
+
+func (m *EscrowTranche) Reset()         { *m = EscrowTranche{} }
+func (m *EscrowTranche) String() string { return proto.CompactTextString(m) }
+func (*EscrowTranche) ProtoMessage()    {}
+func (*EscrowTranche) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39b2ccc048ad7222, []int{6}
+}
+func (m *EscrowTranche) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EscrowTranche) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EscrowTranche.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EscrowTranche) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EscrowTranche.Merge(m, src)
+}
+func (m *EscrowTranche) XXX_Size() int {
+	return m.Size()
+}
+func (m *EscrowTranche) XXX_DiscardUnknown() {
+	xxx_messageInfo_EscrowTranche.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EscrowTranche proto.InternalMessageInfo
+
+func (m *EscrowTranche) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *EscrowTranche) GetUnlocks() []EscrowUnlock {
+	if m != nil {
+		return m.Unlocks
+	}
+	return nil
+}
+
+// EscrowSchedule holds the coins of the Hedgehog mints of a recipient that are
+// still in escrow.
+type EscrowSchedule struct {
+	// bech32 address of the recipient
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// tranches of the mints that have not been fully claimed yet, ordered by
+	// block height
+	Tranches []EscrowTranche `protobuf:"bytes,2,rep,name=tranches,proto3" json:"tranches"`
+}
+
+func (m *EscrowSchedule) Reset()         { *m = EscrowSchedule{} }
+func (m *EscrowSchedule) String() string { return proto.CompactTextString(m) }
+func (*EscrowSchedule) ProtoMessage()    {}
+func (*EscrowSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39b2ccc048ad7222, []int{7}
+}
+func (m *EscrowSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EscrowSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EscrowSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EscrowSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EscrowSchedule.Merge(m, src)
+}
+func (m *EscrowSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *EscrowSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_EscrowSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EscrowSchedule proto.InternalMessageInfo
+
+func (m *EscrowSchedule) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EscrowSchedule) GetTranches() []EscrowTranche {
+	if m != nil {
+		return m.Tranches
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MintRecord)(nil), "cosmos.ugdmint.v1beta1.MintRecord")
+	proto.RegisterType((*MintVesting)(nil), "cosmos.ugdmint.v1beta1.MintVesting")
+	proto.RegisterType((*MintClawback)(nil), "cosmos.ugdmint.v1beta1.MintClawback")
+	proto.RegisterType((*ProcessedMint)(nil), "cosmos.ugdmint.v1beta1.ProcessedMint")
+	proto.RegisterType((*MintingPause)(nil), "cosmos.ugdmint.v1beta1.MintingPause")
+	proto.RegisterType((*EscrowUnlock)(nil), "cosmos.ugdmint.v1beta1.EscrowUnlock")
+	proto.RegisterType((*EscrowTranche)(nil), "cosmos.ugdmint.v1beta1.EscrowTranche")
+	proto.RegisterType((*EscrowSchedule)(nil), "cosmos.ugdmint.v1beta1.EscrowSchedule")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/ugdmint/v1beta1/mint_record.proto", fileDescriptor_39b2ccc048ad7222)
+}
+
+var fileDescriptor_39b2ccc048ad7222 = []byte{
+	// 689 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0x4f, 0x6b, 0xd4, 0x4e,
+	0x18, 0xde, 0x6c, 0xfa, 0xdb, 0xee, 0xce, 0x6e, 0x7f, 0x68, 0xb4, 0x35, 0x16, 0x49, 0xd7, 0xad,
+	0xc2, 0x52, 0x68, 0x42, 0x2b, 0x08, 0x1e, 0x04, 0x69, 0x51, 0x14, 0x14, 0x4a, 0x5a, 0x3d, 0x78,
+	0x09, 0xd9, 0xc9, 0x90, 0x8c, 0xbb, 0x99, 0x59, 0x32, 0x93, 0xb6, 0x7b, 0xf0, 0x3b, 0x78, 0xf6,
+	0xe2, 0x55, 0x8a, 0x87, 0x7e, 0x09, 0xa1, 0x27, 0xe9, 0xd1, 0x93, 0x4a, 0x7b, 0xe8, 0xd7, 0x90,
+	0xf9, 0x93, 0x34, 0x52, 0x59, 0x7a, 0x10, 0xbd, 0xec, 0xe6, 0xfd, 0xf3, 0xcc, 0xf3, 0xbe, 0xcf,
+	0x3c, 0xbb, 0x01, 0x7d, 0x48, 0x59, 0x4a, 0x99, 0x97, 0xc7, 0x51, 0x8a, 0x09, 0xf7, 0x76, 0xd7,
+	0x06, 0x88, 0x87, 0x6b, 0x9e, 0x08, 0x82, 0x0c, 0x41, 0x9a, 0x45, 0xee, 0x38, 0xa3, 0x9c, 0x5a,
+	0x0b, 0xaa, 0xd3, 0xd5, 0x9d, 0xae, 0xee, 0x5c, 0xbc, 0x1a, 0xa6, 0x98, 0x50, 0x4f, 0x7e, 0xaa,
+	0xd6, 0xc5, 0xeb, 0x31, 0x8d, 0xa9, 0x7c, 0xf4, 0xc4, 0x93, 0xce, 0x3a, 0x9a, 0x6a, 0x10, 0x32,
+	0x54, 0xf2, 0x40, 0x8a, 0x89, 0xaa, 0xf7, 0x3e, 0xd7, 0x01, 0x78, 0x81, 0x09, 0xf7, 0x25, 0xab,
+	0x75, 0x1b, 0x74, 0x06, 0x23, 0x0a, 0x87, 0x41, 0x82, 0x70, 0x9c, 0x70, 0xdb, 0xe8, 0x1a, 0x7d,
+	0xd3, 0x6f, 0xcb, 0xdc, 0x53, 0x99, 0xb2, 0x6c, 0x30, 0x1b, 0x42, 0x48, 0x73, 0xc2, 0xed, 0x7a,
+	0xd7, 0xe8, 0xb7, 0xfc, 0x22, 0xb4, 0x26, 0xa0, 0x11, 0xa6, 0xb2, 0x60, 0x76, 0xcd, 0x7e, 0x7b,
+	0xfd, 0xa6, 0xab, 0xa7, 0x17, 0xe4, 0xc5, 0xe8, 0xee, 0x26, 0xc5, 0x64, 0xe3, 0xc9, 0xd1, 0xb7,
+	0xa5, 0xda, 0xc1, 0xf7, 0xa5, 0x7e, 0x8c, 0x79, 0x92, 0x0f, 0x5c, 0x48, 0x53, 0x4f, 0x4f, 0xaa,
+	0xbe, 0x56, 0x59, 0x34, 0xf4, 0xf8, 0x64, 0x8c, 0x98, 0x04, 0xb0, 0xf7, 0x67, 0x87, 0x2b, 0x9d,
+	0x11, 0x8a, 0x43, 0x38, 0x09, 0xc4, 0xf8, 0xec, 0xe3, 0xd9, 0xe1, 0x8a, 0xe1, 0x6b, 0x42, 0xeb,
+	0x21, 0x98, 0xdd, 0x45, 0x8c, 0x63, 0x12, 0xdb, 0x33, 0x5d, 0xa3, 0xdf, 0x5e, 0x5f, 0x76, 0x7f,
+	0xaf, 0x9c, 0x2b, 0x96, 0x7d, 0xa5, 0x5a, 0xfd, 0x02, 0x63, 0x3d, 0x02, 0x4d, 0x38, 0x0a, 0xf7,
+	0x06, 0x21, 0x1c, 0xda, 0xff, 0x49, 0xfc, 0x9d, 0x69, 0xf8, 0x4d, 0xdd, 0xeb, 0x97, 0xa8, 0x1e,
+	0x07, 0xed, 0xca, 0xc9, 0xd6, 0x22, 0x68, 0x22, 0x06, 0x33, 0xba, 0x87, 0x22, 0xa9, 0x61, 0xd3,
+	0x2f, 0x63, 0xeb, 0x19, 0x98, 0xcd, 0x89, 0x10, 0x94, 0xd9, 0xf5, 0xae, 0x39, 0x8d, 0xeb, 0xb1,
+	0x84, 0xbc, 0x94, 0xcd, 0x1b, 0x2d, 0x21, 0x99, 0xda, 0xba, 0xc0, 0xf7, 0xbe, 0x18, 0xa0, 0x53,
+	0x1d, 0xe8, 0x32, 0xf7, 0x77, 0x7e, 0x4b, 0xf5, 0xbf, 0x7d, 0x4b, 0x5d, 0xd0, 0x8e, 0xa4, 0x40,
+	0x21, 0xc7, 0x94, 0xd8, 0xa6, 0xb4, 0x4f, 0x35, 0xd5, 0xfb, 0x64, 0x80, 0xb9, 0xad, 0x8c, 0x42,
+	0xc4, 0x18, 0x8a, 0xc4, 0x66, 0xd6, 0x15, 0x60, 0x0e, 0xd1, 0x44, 0x2e, 0xd2, 0xf2, 0xc5, 0xa3,
+	0x34, 0x60, 0x14, 0x65, 0x88, 0xb1, 0xd2, 0x80, 0x2a, 0xb4, 0x16, 0x40, 0x43, 0xef, 0x2d, 0x8e,
+	0x9e, 0xf1, 0x75, 0x24, 0xf2, 0x7a, 0xe5, 0x19, 0xa9, 0x47, 0x31, 0xcf, 0x2d, 0xd0, 0xe2, 0x38,
+	0x45, 0x8c, 0x87, 0xe9, 0x58, 0xde, 0x7b, 0xcb, 0x3f, 0x4f, 0x5c, 0xd0, 0xb2, 0x71, 0x41, 0xcb,
+	0xde, 0x07, 0xad, 0x3f, 0x26, 0xf1, 0x56, 0x98, 0x33, 0x64, 0xdd, 0x07, 0x37, 0x14, 0x66, 0x9c,
+	0xd1, 0x5d, 0xcc, 0x30, 0x25, 0x2c, 0x18, 0x8b, 0x4a, 0x61, 0x83, 0x79, 0x59, 0xde, 0x2a, 0xab,
+	0x12, 0x16, 0x59, 0xeb, 0x60, 0x3e, 0x41, 0x51, 0x8c, 0x12, 0x1a, 0x07, 0xc2, 0x02, 0x25, 0xaa,
+	0x2e, 0x51, 0xd7, 0x8a, 0xa2, 0x20, 0x2b, 0x30, 0xcb, 0x60, 0x2e, 0x43, 0x2c, 0x4f, 0x51, 0x50,
+	0x59, 0xda, 0xf4, 0x3b, 0x2a, 0xa9, 0x27, 0x3c, 0x30, 0x40, 0xa7, 0x6a, 0x23, 0x6b, 0x09, 0xb4,
+	0x95, 0x7b, 0x02, 0xb1, 0xa9, 0x36, 0x08, 0x50, 0xa9, 0x1d, 0x9c, 0xa2, 0x7f, 0xe8, 0x8f, 0xde,
+	0x5b, 0x30, 0xa7, 0x66, 0xdd, 0xc9, 0x42, 0x02, 0x13, 0x74, 0x19, 0x3b, 0xff, 0xc1, 0x5f, 0xd3,
+	0x3e, 0xf8, 0x5f, 0xf5, 0x6c, 0xc3, 0x04, 0x45, 0xf9, 0x08, 0x55, 0xad, 0x66, 0xfc, 0x6a, 0xb5,
+	0xe7, 0xa0, 0xc9, 0xd5, 0x90, 0x05, 0xef, 0xdd, 0xe9, 0xbc, 0x7a, 0xa5, 0x2a, 0x71, 0x79, 0xc2,
+	0xc6, 0xf6, 0xd1, 0x89, 0x63, 0x1c, 0x9f, 0x38, 0xc6, 0x8f, 0x13, 0xc7, 0x78, 0x77, 0xea, 0xd4,
+	0x8e, 0x4f, 0x9d, 0xda, 0xd7, 0x53, 0xa7, 0xf6, 0xfa, 0x41, 0x45, 0xda, 0x9c, 0xe0, 0x38, 0xc3,
+	0xd1, 0xea, 0x38, 0xa3, 0x6f, 0x10, 0xe4, 0x85, 0xc6, 0xc5, 0x5b, 0x64, 0xbf, 0x7c, 0x9f, 0x48,
+	0xc5, 0x07, 0x0d, 0xf9, 0x0f, 0x7f, 0xef, 0xe7, 0x00, 0x4a, 0xfb, 0x9e, 0xcc, 0x6e, 0x06, 0x00,
+	0x00,
+}
+
+func (m *MintRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Clawback != nil {
+		{
+			size, err := m.Clawback.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMintRecord(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Vesting != nil {
+		{
+			size, err := m.Vesting.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMintRecord(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMintRecord(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintMintRecord(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintMintRecord(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MintVesting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintVesting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintVesting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Unlocks) > 0 {
+		for iNdEx := len(m.Unlocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unlocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMintRecord(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Escrowed {
+		i--
+		if m.Escrowed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MintClawback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintClawback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintClawback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintMintRecord(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMintRecord(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintMintRecord(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProcessedMint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProcessedMint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProcessedMint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintMintRecord(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Timestamp) > 0 {
+		i -= len(m.Timestamp)
+		copy(dAtA[i:], m.Timestamp)
+		i = encodeVarintMintRecord(dAtA, i, uint64(len(m.Timestamp)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Amount != 0 {
+		i = encodeVarintMintRecord(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Height != 0 {
+		i = encodeVarintMintRecord(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMintRecord(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintMintRecord(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MintingPause) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintingPause) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintingPause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ResumeHeight != 0 {
+		i = encodeVarintMintRecord(dAtA, i, uint64(m.ResumeHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.HedgehogMintsPaused {
+		i--
+		if m.HedgehogMintsPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockProvisionsPaused {
+		i--
+		if m.BlockProvisionsPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EscrowUnlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EscrowUnlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EscrowUnlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMintRecord(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.UnlockTime != 0 {
+		i = encodeVarintMintRecord(dAtA, i, uint64(m.UnlockTime))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EscrowTranche) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EscrowTranche) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EscrowTranche) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Unlocks) > 0 {
+		for iNdEx := len(m.Unlocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unlocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMintRecord(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintMintRecord(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EscrowSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EscrowSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EscrowSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tranches) > 0 {
+		for iNdEx := len(m.Tranches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tranches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMintRecord(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMintRecord(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMintRecord(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMintRecord(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MintRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovMintRecord(uint64(m.BlockHeight))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovMintRecord(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovMintRecord(uint64(l))
+		}
+	}
+	if m.Vesting != nil {
+		l = m.Vesting.Size()
+		n += 1 + l + sovMintRecord(uint64(l))
+	}
+	if m.Clawback != nil {
+		l = m.Clawback.Size()
+		n += 1 + l + sovMintRecord(uint64(l))
+	}
+	return n
+}
+
+func (m *MintVesting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Escrowed {
+		n += 2
+	}
+	if len(m.Unlocks) > 0 {
+		for _, e := range m.Unlocks {
+			l = e.Size()
+			n += 1 + l + sovMintRecord(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MintClawback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovMintRecord(uint64(m.BlockHeight))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovMintRecord(uint64(l))
+		}
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovMintRecord(uint64(l))
+	}
+	return n
+}
+
+func (m *ProcessedMint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovMintRecord(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMintRecord(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovMintRecord(uint64(m.Height))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovMintRecord(uint64(m.Amount))
+	}
+	l = len(m.Timestamp)
+	if l > 0 {
+		n += 1 + l + sovMintRecord(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovMintRecord(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *MintingPause) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockProvisionsPaused {
+		n += 2
+	}
+	if m.HedgehogMintsPaused {
+		n += 2
+	}
+	if m.ResumeHeight != 0 {
+		n += 1 + sovMintRecord(uint64(m.ResumeHeight))
+	}
+	return n
+}
+
+func (m *EscrowUnlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UnlockTime != 0 {
+		n += 1 + sovMintRecord(uint64(m.UnlockTime))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovMintRecord(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EscrowTranche) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovMintRecord(uint64(m.BlockHeight))
+	}
+	if len(m.Unlocks) > 0 {
+		for _, e := range m.Unlocks {
+			l = e.Size()
+			n += 1 + l + sovMintRecord(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EscrowSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMintRecord(uint64(l))
+	}
+	if len(m.Tranches) > 0 {
+		for _, e := range m.Tranches {
+			l = e.Size()
+			n += 1 + l + sovMintRecord(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovMintRecord(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMintRecord(x uint64) (n int) {
+	return sovMintRecord(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MintRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMintRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMintRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMintRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vesting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMintRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Vesting == nil {
+				m.Vesting = &MintVesting{}
+			}
+			if err := m.Vesting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Clawback", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMintRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Clawback == nil {
+				m.Clawback = &MintClawback{}
+			}
+			if err := m.Clawback.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMintRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMintRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MintVesting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMintRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintVesting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintVesting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrowed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Escrowed = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unlocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMintRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unlocks = append(m.Unlocks, EscrowUnlock{})
+			if err := m.Unlocks[len(m.Unlocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMintRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMintRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MintClawback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMintRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintClawback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintClawback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMintRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMintRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMintRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMintRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProcessedMint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMintRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProcessedMint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProcessedMint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMintRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMintRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMintRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Timestamp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMintRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMintRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MintingPause) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMintRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintingPause: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintingPause: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockProvisionsPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BlockProvisionsPaused = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HedgehogMintsPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HedgehogMintsPaused = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResumeHeight", wireType)
+			}
+			m.ResumeHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResumeHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				i -= size
-				i = encodeVarintMintRecord(dAtA, i, uint64(size))
 			}
-			i--
-			dAtA[i] = 0x1a
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMintRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMintRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintMintRecord(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintMintRecord(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintMintRecord(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMintRecord(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *MintRecord) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BlockHeight != 0 {
-		n += 1 + sovMintRecord(uint64(m.BlockHeight))
-	}
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovMintRecord(uint64(l))
-	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovMintRecord(uint64(l))
+func (m *EscrowUnlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMintRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EscrowUnlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EscrowUnlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockTime", wireType)
+			}
+			m.UnlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnlockTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMintRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMintRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMintRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovMintRecord(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMintRecord(x uint64) (n int) {
-	return sovMintRecord(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MintRecord) Unmarshal(dAtA []byte) error {
+func (m *EscrowTranche) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -231,10 +2093,10 @@ func (m *MintRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MintRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: EscrowTranche: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MintRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EscrowTranche: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -258,7 +2120,91 @@ func (m *MintRecord) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Unlocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMintRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unlocks = append(m.Unlocks, EscrowUnlock{})
+			if err := m.Unlocks[len(m.Unlocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMintRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMintRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EscrowSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMintRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EscrowSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EscrowSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -286,11 +2232,11 @@ func (m *MintRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tranches", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -317,8 +2263,8 @@ func (m *MintRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Tranches = append(m.Tranches, EscrowTranche{})
+			if err := m.Tranches[len(m.Tranches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
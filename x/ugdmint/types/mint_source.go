@@ -0,0 +1,129 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"cosmossdk.io/errors"
+)
+
+// MintSource provides the Hedgehog mints that are scheduled for a block
+// height. Validators read it when extending their votes.
+type MintSource interface {
+	// Read returns the mints scheduled for height, sorted with SortMints. It
+	// returns an error wrapping ErrMintNotFound when no mint is scheduled for
+	// height.
+	Read(height uint64) ([]Mint, error)
+}
+
+// Mint is a mint scheduled by Hedgehog.
+type Mint struct {
+	Address string
+	Amount  int
+	Height  uint64
+	// Timestamp of the Hedgehog payload the mint was read from, if any.
+	Timestamp string
+}
+
+// Mints is the mint-storage payload published by Hedgehog. Its keys have the
+// address/height form and its values are the amounts to mint.
+type Mints struct {
+	Mints map[string]int
+}
+
+// ParseMints returns the mints of the payload. Entries with a malformed key
+// are logged and skipped.
+func (m Mints) ParseMints() []Mint {
+	mints := make([]Mint, 0, len(m.Mints))
+	for key, amount := range m.Mints {
+		arr := strings.Split(key, "/")
+		if len(arr) != 2 {
+			fmt.Printf("ParseMints: Skipping malformed hedgehog key '%s'\n", key)
+			continue
+		}
+
+		height, err := strconv.ParseUint(arr[1], 10, 64)
+		if err != nil {
+			fmt.Printf("ParseMints: Error parsing height '%s': %s\n", arr[1], err.Error())
+			continue
+		}
+
+		mints = append(mints, Mint{
+			Address: arr[0],
+			Amount:  amount,
+			Height:  height,
+		})
+	}
+
+	return mints
+}
+
+// SortMints sorts mints by address and then by amount, the order in which
+// mints of the same height are voted on and executed.
+func SortMints(mints []Mint) {
+	sort.Slice(mints, func(i, j int) bool {
+		if mints[i].Address != mints[j].Address {
+			return mints[i].Address < mints[j].Address
+		}
+		return mints[i].Amount < mints[j].Amount
+	})
+}
+
+// groupMintsByHeight returns the mints grouped by height, every group sorted
+// with SortMints.
+func groupMintsByHeight(mints []Mint) map[uint64][]Mint {
+	grouped := make(map[uint64][]Mint)
+	for _, mint := range mints {
+		grouped[mint.Height] = append(grouped[mint.Height], mint)
+	}
+	for _, group := range grouped {
+		SortMints(group)
+	}
+
+	return grouped
+}
+
+// StaticMintSource is a MintSource serving a fixed set of mints held in
+// memory. It is meant for unit tests and local testnets.
+type StaticMintSource struct {
+	mints map[uint64][]Mint
+}
+
+var _ MintSource = StaticMintSource{}
+
+// NewStaticMintSource returns a MintSource serving the given mints.
+func NewStaticMintSource(mints ...Mint) StaticMintSource {
+	return StaticMintSource{mints: groupMintsByHeight(mints)}
+}
+
+func (s StaticMintSource) Read(height uint64) ([]Mint, error) {
+	mints, ok := s.mints[height]
+	if !ok {
+		return nil, errors.Wrapf(ErrMintNotFound, "height %d", height)
+	}
+	return append([]Mint(nil), mints...), nil
+}
+
+// NewFileMintSource returns a MintSource serving the mints of a JSON file. The
+// file holds the data object of a Hedgehog mint-storage response, e.g.
+//
+//	{"mints": {"unigrid1.../80": 100}}
+//
+// The file is read once, when the source is created.
+func NewFileMintSource(path string) (StaticMintSource, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return StaticMintSource{}, fmt.Errorf("failed to read mint file: %w", err)
+	}
+
+	var data Mints
+	if err := json.Unmarshal(bz, &data); err != nil {
+		return StaticMintSource{}, fmt.Errorf("failed to parse mint file %s: %w", path, err)
+	}
+
+	return NewStaticMintSource(data.ParseMints()...), nil
+}
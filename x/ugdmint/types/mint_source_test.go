@@ -0,0 +1,50 @@
+package types
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestStaticMintSource(t *testing.T) {
+	first := Mint{Address: "unigrid1pk2sxhrywmxsqtnas3p7gu0t8x43alvy4jatsg", Amount: 250, Height: 80}
+	second := Mint{Address: "unigrid1pk2sxhrywmxsqtnas3p7gu0t8x43hlvy6hl2lt", Amount: 100, Height: 80}
+	source := NewStaticMintSource(second, first)
+
+	got, err := source.Read(80)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 || got[0] != first || got[1] != second {
+		t.Errorf("expected both mints of the height in sorted order, got %+v", got)
+	}
+
+	if _, err := source.Read(81); !errors.Is(err, ErrMintNotFound) {
+		t.Errorf("expected ErrMintNotFound for a height without mint, got %v", err)
+	}
+}
+
+func TestFileMintSource(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "mints.json")
+	if err := os.WriteFile(path, []byte(hedgehogMints), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	source, err := NewFileMintSource(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mints, err := source.Read(150)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(mints) != 1 || mints[0].Address != "unigrid1pk2sxhrywmxsqtnas3p7gu0t8x43alvy4jatsg" || mints[0].Amount != 981256 {
+		t.Errorf("unexpected mints read from file: %+v", mints)
+	}
+
+	if _, err := NewFileMintSource(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Error("expected an error for a missing mint file")
+	}
+}
@@ -4,28 +4,17 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
-	"math"
-	"strconv"
-	"strings"
 	"sync"
 	"time"
 
+	"cosmossdk.io/errors"
 	cosmosmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/viper"
 	"github.com/unigrid-project/cosmos-common/common/httpclient"
 )
 
-type Mint struct {
-	Address string
-	Amount  int
-	height  string
-}
-
-type Mints struct {
-	Mints map[string]int
-}
-
 type HedgehogData struct {
 	Timestamp         string `json:"timestamp"`
 	PreviousTimeStamp string `json:"previousTimeStamp"`
@@ -36,42 +25,33 @@ type HedgehogData struct {
 	Signature         string `json:"signature"`
 }
 
+// MintCache is the MintSource backed by the Hedgehog mint-storage spork. It
+// polls Hedgehog in the background once the first mint has been read.
 type MintCache struct {
 	stop chan struct{}
 
 	wg    sync.WaitGroup
 	mu    sync.RWMutex
-	mints map[uint64]Mint
+	mints map[uint64][]Mint
 	first bool
 	//mints *cache.Cache
-}
 
-type ErrorWhenGettingCache struct{}
+	hedgehogUrl string
+	start       sync.Once
+}
 
-const PreviousBlockTimeKey = "previousBlockTime"
+var _ MintSource = (*MintCache)(nil)
 
 const (
 	//defaultExperation   = 1 * time.Minute
 	cacheUpdateInterval = 15 * time.Second
 )
 
-var (
-	c          = &MintCache{}
-	once       sync.Once
-	currheight = uint64(1)
-)
-
-func (e *ErrorWhenGettingCache) Error() string {
-	return "Failed to get address from cache, cache is probably empty"
-}
-
 func (mc *MintCache) cleanupCache() {
 	t := time.NewTicker(cacheUpdateInterval)
 	defer t.Stop()
 	if mc.first { // Use mc.first instead of global first
-		hedgehogUrl := viper.GetString("hedgehog.hedgehog_url")
-		//fmt.Println("hedgehogUrl in ugdmint 1:", hedgehogUrl)
-		mc.callHedgehog(hedgehogUrl + "/gridspork/mint-storage")
+		mc.callHedgehog(mc.url() + "/gridspork/mint-storage")
 		mc.first = false
 	}
 	for {
@@ -79,53 +59,73 @@ func (mc *MintCache) cleanupCache() {
 		case <-mc.stop:
 			return
 		case <-t.C:
-			mc.mu.Lock()
-			hedgehogUrl := viper.GetString("hedgehog.hedgehog_url")
-			//fmt.Println("hedgehogUrl in ugdmint 2:", hedgehogUrl)
-			mc.callHedgehog(hedgehogUrl + "/gridspork/mint-storage")
-			mc.mu.Unlock()
+			mc.callHedgehog(mc.url() + "/gridspork/mint-storage")
 		}
 	}
 }
 
-func GetCache() *MintCache {
-	//fmt.Println("Getting cache")
-	once.Do(func() {
-		c = NewCache()
-	})
-	return c
+// NewHedgehogMintSource returns a MintSource that reads mints from the
+// Hedgehog server at hedgehogUrl. When hedgehogUrl is empty, the
+// hedgehog.hedgehog_url setting of app.toml is used.
+func NewHedgehogMintSource(hedgehogUrl string) *MintCache {
+	return &MintCache{
+		mints:       make(map[uint64][]Mint),
+		stop:        make(chan struct{}),
+		first:       true, // Initialize it here
+		hedgehogUrl: hedgehogUrl,
+	}
 }
 
-func NewCache() *MintCache {
-	mc := &MintCache{
-		mints: make(map[uint64]Mint),
-		stop:  make(chan struct{}),
-		first: true, // Initialize it here
+// url returns the base url of the Hedgehog server.
+func (mc *MintCache) url() string {
+	if mc.hedgehogUrl != "" {
+		return mc.hedgehogUrl
 	}
+	return viper.GetString("hedgehog.hedgehog_url")
+}
 
-	mc.wg.Add(1)
-	go func() {
-		defer mc.wg.Done()
-		mc.cleanupCache()
-	}()
+// Start starts polling Hedgehog in the background. It is safe to call Start
+// more than once.
+func (mc *MintCache) Start() {
+	mc.start.Do(func() {
+		mc.wg.Add(1)
+		go func() {
+			defer mc.wg.Done()
+			mc.cleanupCache()
+		}()
+	})
+}
 
-	return mc
+// Stop stops polling Hedgehog and waits for the background poller to exit.
+func (mc *MintCache) Stop() {
+	mc.start.Do(func() {})
+	select {
+	case <-mc.stop:
+	default:
+		close(mc.stop)
+	}
+	mc.wg.Wait()
 }
 
-func (mc *MintCache) Read(height uint64) (Mint, error) {
+func (mc *MintCache) Read(height uint64) ([]Mint, error) {
+	mc.Start()
 
 	mc.mu.RLock()
 	defer mc.mu.RUnlock()
 
 	cm, ok := mc.mints[height]
 	if !ok {
-		return Mint{}, &ErrorWhenGettingCache{}
+		return nil, errors.Wrapf(ErrMintNotFound, "height %d", height)
 	}
-	return cm, nil
+	return append([]Mint(nil), cm...), nil
 }
 
-func (mc *MintCache) updateCache(height uint64, mint Mint) {
-	mc.mints[height] = mint
+// updateCache replaces the mints cached for height.
+func (mc *MintCache) updateCache(height uint64, mints []Mint) {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+
+	mc.mints[height] = mints
 }
 
 func (mc *MintCache) deleteFromCache(height uint64) {
@@ -135,17 +135,6 @@ func (mc *MintCache) deleteFromCache(height uint64) {
 	delete(mc.mints, height)
 }
 
-func (mc *MintCache) checkCache(height uint64) (mint Mint) {
-
-	res, err := mc.Read(height)
-	if err != nil {
-		return res
-	}
-
-	return mint
-
-}
-
 func ConvertIntToCoin(params Params, amount int) sdk.Coins {
 	return sdk.NewCoins(sdk.NewCoin(params.MintDenom, cosmosmath.NewInt(int64(amount))))
 }
@@ -185,46 +174,46 @@ func (mc *MintCache) callHedgehog(serverUrl string) {
 		return
 	}
 
+	// Never trust mint data that is not signed by a known hedgehog key
+	if err := VerifyHedgehogSignature(body, HedgehogPublicKeys()); err != nil {
+		fmt.Printf("callHedgehog: Rejected response from hedgehog server: %s\n", err.Error())
+		telemetry.IncrCounter(1, ModuleName, "hedgehog_rejected_responses")
+		return
+	}
+
 	// Log the received data
 	fmt.Printf("callHedgehog: Received data: Timestamp: %s, PreviousTimeStamp: %s, Flags: %d, Type: %s\n",
 		res.Timestamp, res.PreviousTimeStamp, res.Flags, res.Hedgehogtype)
 
-	// Process and update cache
-	// Convert blockHeight to uint64 for comparison
-	blockHeight := uint64(sdk.Context.BlockHeight(sdk.Context{}))
-	for key, amount := range res.Data.Mints {
-		arr := strings.Split(key, "/")
-		address := arr[0]
-		heightStr := arr[1]
-		height, err := strconv.ParseUint(heightStr, 10, 64)
-		if err != nil {
-			fmt.Printf("callHedgehog: Error parsing height '%s': %s\n", heightStr, err.Error())
-			continue // Skip this iteration and move to the next one
-		}
-
-		if height >= blockHeight {
-			mc.updateCache(height, Mint{
-				Address: address,
-				Amount:  amount,
-				height:  heightStr,
-			})
+	// Process and update cache, the payload holds every mint of a height
+	mints := res.Data.ParseMints()
+	for i := range mints {
+		mints[i].Timestamp = res.Timestamp
+	}
+	for height, group := range groupMintsByHeight(mints) {
+		mc.updateCache(height, group)
 
-			// Log each mint being processed
-			fmt.Printf("callHedgehog: Processed mint for height %d: Address: %s, Amount: %d\n", height, address, amount)
+		// Log each mint being processed
+		for _, mint := range group {
+			fmt.Printf("callHedgehog: Processed mint for height %d: Address: %s, Amount: %d\n", mint.Height, mint.Address, mint.Amount)
 		}
 	}
 
 	// Additional logging to show current state of cache
 	fmt.Println("callHedgehog: Current state of cache:")
-	for h, mint := range mc.mints {
-		fmt.Printf("Height: %d, Mint: %+v\n", h, mint)
+	mc.mu.RLock()
+	for h, group := range mc.mints {
+		fmt.Printf("Height: %d, Mints: %+v\n", h, group)
 	}
+	mc.mu.RUnlock()
 }
 
 // NewMinter returns a new Minter object with the given subsidy halving interval.
 func NewMinter(subsidyHalvingInterval cosmosmath.LegacyDec) Minter {
 	return Minter{
 		SubsidyHalvingInterval: subsidyHalvingInterval,
+		Inflation:              cosmosmath.LegacyZeroDec(),
+		AnnualProvisions:       cosmosmath.LegacyZeroDec(),
 	}
 }
 
@@ -249,56 +238,177 @@ func ValidateMinter(minter Minter) error {
 		return fmt.Errorf("mint parameter subsidy halving interval should be positive, is %s",
 			minter.SubsidyHalvingInterval.String())
 	}
+	if err := minter.BlockProvisionMinted.Validate(); err != nil {
+		return fmt.Errorf("invalid block provision minted: %w", err)
+	}
+	if err := minter.HedgehogMinted.Validate(); err != nil {
+		return fmt.Errorf("invalid hedgehog minted: %w", err)
+	}
+	if minter.LastMintHeight < 0 {
+		return fmt.Errorf("last mint height should not be negative, is %d", minter.LastMintHeight)
+	}
+	if err := minter.BlockSubsidy.Validate(); err != nil {
+		return fmt.Errorf("invalid block subsidy: %w", err)
+	}
+	if !minter.Inflation.IsNil() && minter.Inflation.IsNegative() {
+		return fmt.Errorf("mint parameter inflation should not be negative, is %s",
+			minter.Inflation.String())
+	}
+	if !minter.AnnualProvisions.IsNil() && minter.AnnualProvisions.IsNegative() {
+		return fmt.Errorf("mint parameter annual provisions should not be negative, is %s",
+			minter.AnnualProvisions.String())
+	}
 	return nil
 }
 
 // BlockProvision returns the provisions for a block based on the UGD algorithm
 // provisions rate.
-func (m Minter) BlockProvision(params Params, height uint64, ctx sdk.Context, prevCtx sdk.Context) sdk.Coins {
-	// fmt.Printf("[BlockProvision] Called: height=%d\n", height)
-	// fmt.Printf("[BlockProvision] Current Block Time: %d, Previous Block Time: %d\n", ctx.BlockTime().Unix(), prevCtx.BlockTime().Unix())
+//
+// The provision is computed with cosmossdk.io/math decimals so that every node
+// arrives at the same amount regardless of architecture or compiler:
+//
+//	subsidy = DecayFactor^n * delta / ReferenceWindowSeconds / BlocksPerMinute * ProvisionScale
+//
+// where n is the number of subsidy halving intervals the height, shifted by
+// HeightOffset, lies past DecayStartHeight and delta the number of seconds
+// since the previous block as returned by BlockTimeDelta.
+//
+// Rounding is always towards zero: the decay is computed by powTruncate,
+// which truncates every multiplication to the 18 decimals of LegacyDec, and
+// the final amount is truncated to whole units of the mint denom, so a node
+// never pays out more than the exact subsidy.
+func (m Minter) BlockProvision(params Params, height uint64, blockTime, previousBlockTime time.Time) sdk.Coins {
+	delta, _ := BlockTimeDelta(params, blockTime, previousBlockTime)
+	return m.BlockProvisionForDelta(params, height, delta)
+}
+
+// BlockProvisionForDelta returns the provision of a block that is paid for
+// blockTimeDelta seconds, see BlockProvision.
+func (m Minter) BlockProvisionForDelta(params Params, height, blockTimeDelta uint64) sdk.Coins {
 	// Calculate the number of blocks per minute dynamically
-	//blocksPerMinute := calculateBlocksPerMinute(ctx, prevCtx)
-	blocksPerMinute := 12
-	//blocksPerMinute := 60
-	//fmt.Printf("[BlockProvision] blocksPerMinute=%d\n", blocksPerMinute)
+	//blocksPerMinute := calculateBlocksPerMinute(blockTime, previousBlockTime)
+	blocksPerMinute := cosmosmath.NewIntFromUint64(params.BlocksPerMinute)
+	window := cosmosmath.NewIntFromUint64(params.ReferenceWindowSeconds)
+
+	adjustedHeight := height + params.HeightOffset
+
+	// An interval shorter than a block disables the decay
+	nSubsidy := cosmosmath.LegacyOneDec()
+	interval := params.SubsidyHalvingInterval.Abs().TruncateInt64()
+	if interval > 0 && adjustedHeight > params.DecayStartHeight {
+		nBehalf := (adjustedHeight - params.DecayStartHeight) / uint64(interval)
+		nSubsidy = powTruncate(params.DecayFactor, nBehalf)
+	}
+
+	delta := cosmosmath.NewIntFromUint64(blockTimeDelta)
+
+	// Scale to the smallest unit before dividing so that only the final
+	// division truncates
+	subsidyInSmallestUnit := nSubsidy.
+		MulInt(delta).
+		MulInt(cosmosmath.NewIntFromUint64(params.ProvisionScale)).
+		QuoInt(window.Mul(blocksPerMinute)).
+		TruncateInt()
+
+	coin := sdk.NewCoin(params.MintDenom, subsidyInSmallestUnit)
 
-	var nSubsidy float64 = 1
-	//fmt.Printf("[BlockProvision] Initial nSubsidy: %f\n", nSubsidy)
+	return sdk.NewCoins(coin)
+}
 
-	adjustedHeight := height + 2685066
-	//fmt.Printf("[BlockProvision] Adjusted Height: %d\n", adjustedHeight)
+// NextInflationRate returns the new inflation rate of the bonded-ratio
+// emission strategy for the next block. The rate moves towards InflationMax
+// while the bonded ratio is below GoalBonded and towards InflationMin while it
+// is above, by at most InflationRateChange a year.
+func (m Minter) NextInflationRate(params Params, bondedRatio cosmosmath.LegacyDec) cosmosmath.LegacyDec {
+	// A minter that never used the strategy starts from the minimum
+	inflation := params.InflationMin
+	if !m.Inflation.IsNil() {
+		inflation = m.Inflation
+	}
 
-	nBehalf := int64(adjustedHeight-1000000) / params.SubsidyHalvingInterval.Abs().TruncateInt64()
-	//fmt.Printf("[BlockProvision] nBehalf: %d\n", nBehalf)
+	inflationRateChangePerYear := cosmosmath.LegacyOneDec().
+		Sub(bondedRatio.Quo(params.GoalBonded)).
+		Mul(params.InflationRateChange)
+	inflationRateChange := inflationRateChangePerYear.QuoInt(cosmosmath.NewIntFromUint64(params.BlocksPerYear))
 
-	for i := 0; i < int(nBehalf); i++ {
-		nSubsidy = nSubsidy * 99.0 / 100.0
-		//fmt.Printf("[BlockProvision] nSubsidy after %dth halving: %f\n", i+1, nSubsidy)
+	inflation = inflation.Add(inflationRateChange)
+	if inflation.GT(params.InflationMax) {
+		inflation = params.InflationMax
+	}
+	if inflation.LT(params.InflationMin) {
+		inflation = params.InflationMin
 	}
 
-	if ctx.BlockTime().Unix() <= prevCtx.BlockTime().Unix() {
-		nSubsidy = nSubsidy * (float64(ctx.BlockTime().Unix()-(ctx.BlockTime().Unix()-60)) / 60.0)
-		//fmt.Printf("[BlockProvision] nSubsidy adjusted for block time <= prev block time: %f\n", nSubsidy)
-	} else {
-		nSubsidy = nSubsidy * (float64(ctx.BlockTime().Unix()-prevCtx.BlockTime().Unix()) / 60.0)
-		//fmt.Printf("[BlockProvision] nSubsidy adjusted for block time > prev block time: %f\n", nSubsidy)
+	return inflation
+}
+
+// NextAnnualProvisions returns the annual provisions of the bonded-ratio
+// emission strategy based on the current inflation and total supply.
+func (m Minter) NextAnnualProvisions(_ Params, totalSupply cosmosmath.Int) cosmosmath.LegacyDec {
+	return m.Inflation.MulInt(totalSupply)
+}
+
+// SecondsPerYear is the length of the Julian year the annual provisions of
+// the UGD algorithm are extrapolated over.
+const SecondsPerYear = 31_557_600
+
+// UGDAnnualProvisions returns the amount of the mint denom the UGD algorithm
+// pays over a year at the rate of height, assuming every block takes
+// blockTimeSeconds, or the block time the params are calibrated for when it
+// is zero. Like the annual provisions of x/mint, the decay of the subsidy
+// during the year is not taken into account. Blocks that take longer than
+// MaxBlockTimeDeltaSeconds are only paid for that long, which lowers the
+// annual provisions.
+func (m Minter) UGDAnnualProvisions(params Params, height, blockTimeSeconds uint64) cosmosmath.LegacyDec {
+	if blockTimeSeconds == 0 {
+		blockTimeSeconds = DefaultBlockTimeSeconds(params)
 	}
 
-	// Adjust nSubsidy based on the actual blocks per minute
-	// This is the key adjustment to ensure the subsidy aligns with your intended reward rate
-	nSubsidy = nSubsidy / float64(blocksPerMinute)
+	delta := min(max(blockTimeSeconds, params.MinBlockTimeDeltaSeconds), params.MaxBlockTimeDeltaSeconds)
+	provision := m.BlockProvisionForDelta(params, height, delta).AmountOf(params.MintDenom)
 
-	if nSubsidy < 0 {
-		nSubsidy = 0
+	return cosmosmath.LegacyNewDecFromInt(provision).
+		MulInt64(SecondsPerYear).
+		QuoInt64(int64(blockTimeSeconds))
+}
+
+// BlockTimeDelta returns the number of seconds a block is paid for and whether
+// the time since the previous block had to be clamped to the
+// MinBlockTimeDeltaSeconds and MaxBlockTimeDeltaSeconds bounds. The bounds keep
+// a proposer skewing its timestamp, or a chain resuming after a halt, from
+// minting a huge one-off provision.
+//
+// A block that is not later than the previous one, such as a block within
+// the same second, is clamped to MinBlockTimeDeltaSeconds like any other block
+// that came too soon.
+func BlockTimeDelta(params Params, blockTime, previousBlockTime time.Time) (delta uint64, clamped bool) {
+	observed := blockTime.Unix() - previousBlockTime.Unix()
+
+	switch {
+	case observed < 0 || uint64(observed) < params.MinBlockTimeDeltaSeconds:
+		return params.MinBlockTimeDeltaSeconds, true
+	case uint64(observed) > params.MaxBlockTimeDeltaSeconds:
+		return params.MaxBlockTimeDeltaSeconds, true
 	}
+	return uint64(observed), false
+}
 
-	// Convert to coin with the adjusted subsidy
-	subsidyInSmallestUnit := int64(nSubsidy * math.Pow10(8))
-	coin := sdk.NewCoin(params.MintDenom, cosmosmath.NewInt(subsidyInSmallestUnit))
-	//fmt.Printf("[BlockProvision] Coin generated: %s\n", coin.String())
+// powTruncate returns base^exp computed by exponentiation by squaring, so the
+// cost grows with the number of bits of exp rather than with exp itself. Every
+// multiplication is truncated to the precision of LegacyDec.
+func powTruncate(base cosmosmath.LegacyDec, exp uint64) cosmosmath.LegacyDec {
+	result := cosmosmath.LegacyOneDec()
+	for exp > 0 {
+		if exp&1 == 1 {
+			result = result.MulTruncate(base)
+		}
+		exp >>= 1
+		if exp > 0 {
+			base = base.MulTruncate(base)
+		}
+	}
 
-	return sdk.NewCoins(coin)
+	return result
 }
 
 // this function is not working as intended
@@ -2,13 +2,16 @@ package types
 
 import (
 	"bytes"
+	"context"
 	"crypto/ecdsa"
 	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/rsa"
+	"crypto/sha512"
 	"crypto/tls"
 	"crypto/x509"
 	"crypto/x509/pkix"
+	"encoding/base64"
 	"encoding/pem"
 	"fmt"
 	"log"
@@ -16,7 +19,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
-	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -29,6 +32,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtestutil "github.com/cosmos/cosmos-sdk/x/gov/testutil"
 	"github.com/golang/mock/gomock"
+	"github.com/spf13/viper"
 )
 
 const (
@@ -53,6 +57,15 @@ const (
 		"\"signature\":\"MIGIAkIBoUwt+6QGWerjHUrq/LFn0US3OL3pBgGoibLn6rONgZi8wM42XQR4zAFFycw8baXMilXvXvd8ik+RcXSyfBsiiSkCQgGQ2LDbzNfXObev1CqIfGm1OzXmoUblwoIWvIsEi+46ueYiKkUJL/0nz0AgeGaysZDvvbzrv/FhJiZxahIhyHrFKA==\"" +
 		"}"
 
+	hedgehogMints string = "{" +
+		"\"mints\":{" +
+		"\"unigrid1pk2sxhrywmxsqtnas3p7gu0t8x43hlvy4jatsg/80\":100," +
+		"\"unigrid1pk2sxhrywmxsqtnas3p7gu0t8x43tlvy4jatsg/90\":1000," +
+		"\"unigrid1pk2sxhrywmxsqtnas3p7gu0t8x43ulvy4jatsg/110\":1275," +
+		"\"unigrid1pk2sxhrywmxsqtnas3p7gu0t8x43alvy4jatsg/150\":981256," +
+		"\"unigrid1pk2sxhrywmxsqtnas3p7gu0t8x43rlvy4jatsg/165\":1236" +
+		"}}"
+
 	/*"{" +
 	"\"data\": {" +
 	"\"mints\":{" +
@@ -177,13 +190,48 @@ func pemBlockForKey(priv interface{}) *pem.Block {
 	}
 }
 
+// trustHedgehogKey generates a hedgehog signing key and configures its public
+// half as the only trusted hedgehog key.
+func trustHedgehogKey(t *testing.T) *ecdsa.PrivateKey {
+	priv, err := ecdsa.GenerateKey(elliptic.P521(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKIXPublicKey(&priv.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	viper.Set(HedgehogPublicKeysConfigKey, []string{base64.StdEncoding.EncodeToString(der)})
+	t.Cleanup(func() { viper.Set(HedgehogPublicKeysConfigKey, nil) })
+
+	return priv
+}
+
+// signedHedgehogResponse builds a mint-storage response whose data is signed
+// with the given key.
+func signedHedgehogResponse(t *testing.T, priv *ecdsa.PrivateKey, data string) string {
+	digest := sha512.Sum512([]byte(data))
+	sig, err := ecdsa.SignASN1(rand.Reader, priv, digest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return "{\"timeStamp\":\"2023-06-16T19:03:33.104Z\"," +
+		"\"previousTimeStamp\":\"2023-06-16T19:03:01.836Z\"," +
+		"\"flags\":0,\"type\":\"MINT_STORAGE\"," +
+		"\"data\":" + data + "," +
+		"\"previousData\":{\"mints\":{}}," +
+		"\"signature\":\"" + base64.StdEncoding.EncodeToString(sig) + "\"}"
+}
+
 func TestCanMintFromHedgehog(t *testing.T) {
 
-	compareValue := []Mint{{"unigrid1pk2sxhrywmxsqtnas3p7gu0t8x43hlvy4jatsg", 100, "80"},
-		{"unigrid1pk2sxhrywmxsqtnas3p7gu0t8x43tlvy4jatsg", 1000, "90"},
-		{"unigrid1pk2sxhrywmxsqtnas3p7gu0t8x43ulvy4jatsg", 1275, "110"},
-		{"unigrid1pk2sxhrywmxsqtnas3p7gu0t8x43alvy4jatsg", 981256, "150"},
-		{"unigrid1pk2sxhrywmxsqtnas3p7gu0t8x43rlvy4jatsg", 1236, "165"},
+	compareValue := []Mint{{Address: "unigrid1pk2sxhrywmxsqtnas3p7gu0t8x43hlvy4jatsg", Amount: 100, Height: 80},
+		{Address: "unigrid1pk2sxhrywmxsqtnas3p7gu0t8x43tlvy4jatsg", Amount: 1000, Height: 90},
+		{Address: "unigrid1pk2sxhrywmxsqtnas3p7gu0t8x43ulvy4jatsg", Amount: 1275, Height: 110},
+		{Address: "unigrid1pk2sxhrywmxsqtnas3p7gu0t8x43alvy4jatsg", Amount: 981256, Height: 150},
+		{Address: "unigrid1pk2sxhrywmxsqtnas3p7gu0t8x43rlvy4jatsg", Amount: 1236, Height: 165},
 	}
 
 	//http.HandleFunc("/mint-storage", mintStorage)
@@ -191,28 +239,30 @@ func TestCanMintFromHedgehog(t *testing.T) {
 	teardown := serverSetup()
 	//defer teardown()
 
+	response := signedHedgehogResponse(t, trustHedgehogKey(t), hedgehogMints)
 	mux.HandleFunc("/gridspork/mint-storage", func(w http.ResponseWriter, r *http.Request) {
 		//r.RequestURI = "/gridspork/mint-storage"
 		//r.Host = "https://127.0.0.1:52884"
 		w.Header().Set("Content-Type", "application/json")
-		w.Write([]byte(jsonString))
+		w.Write([]byte(response))
 	})
 
 	//server.Config.Addr = "localhost:52884"
 	//server.URL = "https://127.0.0.1:52884"
 	//server.StartTLS()
 
-	cache := NewCache()
+	cache := NewHedgehogMintSource(server.URL)
 
 	cache.callHedgehog(server.URL + "/gridspork/mint-storage")
 	for _, cv := range compareValue {
-		h, er := strconv.ParseInt(cv.height, 10, 64)
-		if er != nil {
-			panic("it whent to hell")
+		found := false
+		for _, v := range cache.mints[cv.Height] {
+			if v.Address == cv.Address && v.Amount == cv.Amount {
+				fmt.Println("Found mint in cache " + v.Address)
+				found = true
+			}
 		}
-		if v, found := cache.mints[uint64(h)]; found {
-			fmt.Println("Found mint in cache " + v.Address)
-		} else {
+		if !found {
 			t.Error("compare value was not in mintcache")
 		}
 	}
@@ -220,6 +270,73 @@ func TestCanMintFromHedgehog(t *testing.T) {
 	teardown()
 }
 
+func TestCachesEveryMintOfAHeight(t *testing.T) {
+	teardown := serverSetup()
+	defer teardown()
+
+	data := "{\"mints\":{" +
+		"\"unigrid1pk2sxhrywmxsqtnas3p7gu0t8x43ulvy4jatsg/80\":1275," +
+		"\"unigrid1pk2sxhrywmxsqtnas3p7gu0t8x43hlvy4jatsg/80\":100," +
+		"\"unigrid1pk2sxhrywmxsqtnas3p7gu0t8x43tlvy4jatsg/80\":1000}}"
+	response := signedHedgehogResponse(t, trustHedgehogKey(t), data)
+	mux.HandleFunc("/gridspork/mint-storage", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(response))
+	})
+
+	cache := NewHedgehogMintSource(server.URL)
+	cache.callHedgehog(server.URL + "/gridspork/mint-storage")
+
+	mints := cache.mints[80]
+	if len(mints) != 3 {
+		t.Fatalf("expected 3 mints at height 80, got %+v", mints)
+	}
+	for i := 1; i < len(mints); i++ {
+		if mints[i-1].Address > mints[i].Address {
+			t.Fatalf("mints of height 80 are not sorted: %+v", mints)
+		}
+	}
+
+	// polling the same payload again must not duplicate the mints
+	cache.callHedgehog(server.URL + "/gridspork/mint-storage")
+	if len(cache.mints[80]) != 3 {
+		t.Errorf("expected 3 mints at height 80 after polling twice, got %+v", cache.mints[80])
+	}
+}
+
+func TestRejectsUntrustedHedgehogData(t *testing.T) {
+	priv := trustHedgehogKey(t)
+	untrusted, err := ecdsa.GenerateKey(elliptic.P521(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	responses := map[string]string{
+		"unsigned":          strings.Replace(signedHedgehogResponse(t, priv, hedgehogMints), "\"signature\":\"", "\"signature\":\"\",\"x\":\"", 1),
+		"fixture signature": jsonString,
+		"untrusted key":     signedHedgehogResponse(t, untrusted, hedgehogMints),
+		"tampered data":     strings.Replace(signedHedgehogResponse(t, priv, hedgehogMints), ":1000,", ":100000,", 1),
+	}
+
+	for name, response := range responses {
+		t.Run(name, func(t *testing.T) {
+			teardown := serverSetup()
+			defer teardown()
+
+			mux.HandleFunc("/gridspork/mint-storage", func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(response))
+			})
+
+			cache := NewHedgehogMintSource(server.URL)
+			cache.callHedgehog(server.URL + "/gridspork/mint-storage")
+			if len(cache.mints) != 0 {
+				t.Errorf("expected untrusted response to be rejected, cache holds %d mints", len(cache.mints))
+			}
+		})
+	}
+}
+
 func TestBlockProvision(t *testing.T) {
 
 	key := storetypes.NewKVStoreKey(ModuleName)
@@ -239,21 +356,17 @@ func TestBlockProvision(t *testing.T) {
 	stakingKeeper.EXPECT().TokensFromConsensusPower(ctx, gomock.Any()).DoAndReturn(func(ctx sdk.Context, power int64) math.Int {
 		return sdk.TokensFromConsensusPower(power, math.NewIntFromUint64(1000000))
 	}).AnyTimes()
-	stakingKeeper.EXPECT().BondDenom(ctx).Return("ugd").AnyTimes()
+	stakingKeeper.EXPECT().BondDenom(ctx).Return("ugd", nil).AnyTimes()
 	stakingKeeper.EXPECT().IterateBondedValidatorsByPower(gomock.Any(), gomock.Any()).AnyTimes()
 	stakingKeeper.EXPECT().IterateDelegations(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
-	stakingKeeper.EXPECT().TotalBondedTokens(gomock.Any()).Return(math.NewInt(10000000)).AnyTimes()
+	stakingKeeper.EXPECT().TotalBondedTokens(gomock.Any()).Return(math.NewInt(10000000), nil).AnyTimes()
 
-	params := Params{
-		MintDenom:              "ugd",
-		SubsidyHalvingInterval: math.LegacyNewDecWithPrec(50000, 0),
-		GoalBonded:             math.LegacyNewDecWithPrec(67, 2),
-		BlocksPerYear:          uint64(60 * 60 * 8766 / 5),
-	}
+	params := DefaultParams()
+	params.SubsidyHalvingInterval = math.LegacyNewDecWithPrec(50000, 0)
 
 	minter := NewMinter(params.SubsidyHalvingInterval)
 
-	coins := Minter.BlockProvision(minter, params, 10, ctx, prevCtx)
+	coins := Minter.BlockProvision(minter, params, 10, ctx.BlockTime(), prevCtx.BlockTime())
 
 	fmt.Println(coins.AmountOf("ugd"))
 	fmt.Println(coins.AmountOf("fermi"))
@@ -267,6 +380,147 @@ func TestBlockProvision(t *testing.T) {
 
 }
 
+// TestBlockProvisionGoldenVectors pins the exact provision paid at a range of
+// heights and block-time deltas. Any change to these amounts is a consensus
+// breaking change.
+func TestBlockProvisionGoldenVectors(t *testing.T) {
+	prevTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	vectors := []struct {
+		height   uint64
+		delta    int64
+		interval int64
+		expected int64
+	}{
+		{1, 1, 50000, 99684},
+		{1, 5, 50000, 498423},
+		{1, 7, 50000, 697793},
+		{1, 60, 50000, 5981087},
+		{1, 3600, 50000, 358865266},
+		{10, 5, 50000, 498423},
+		// last block before the 40th decay step
+		{314933, 5, 50000, 469256},
+		{314933, 60, 50000, 5631075},
+		// first block after the 40th decay step
+		{314934, 5, 50000, 464563},
+		{314934, 13, 50000, 1207865},
+		{314934, 60, 50000, 5574764},
+		{1000000, 1, 50000, 81532},
+		{1000000, 5, 50000, 407664},
+		{1000000, 3600, 50000, 293518390},
+		{5000000, 5, 50000, 182439},
+		{5000000, 3600, 50000, 131356293},
+		{50000000, 1, 50000, 4},
+		{50000000, 5, 50000, 21},
+		{50000000, 3600, 50000, 15492},
+		{100000000, 3600, 50000, 0},
+		{1000000, 5, 100000, 534752},
+		// an interval shorter than a block disables the decay
+		{1000000, 5, 0, 694444},
+		// blocks not later than the previous one are paid the min block time
+		// delta
+		{1, 0, 50000, 99684},
+		{1, -5, 50000, 99684},
+		{5000000, 0, 50000, 36487},
+	}
+
+	for _, v := range vectors {
+		params := DefaultParams()
+		params.SubsidyHalvingInterval = math.LegacyNewDec(v.interval)
+		// widen the block time bounds so the vectors pin the unclamped schedule
+		params.MaxBlockTimeDeltaSeconds = 3600
+		blockTime := prevTime.Add(time.Duration(v.delta) * time.Second)
+
+		coins := DefaultInitialMinter().BlockProvision(params, v.height, blockTime, prevTime)
+		if got := coins.AmountOf(params.MintDenom); !got.Equal(math.NewInt(v.expected)) {
+			t.Errorf("height %d, delta %ds, interval %d: expected %d, got %s", v.height, v.delta, v.interval, v.expected, got)
+		}
+	}
+}
+
+func TestBlockTimeDelta(t *testing.T) {
+	prevTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	params := DefaultParams()
+	params.MinBlockTimeDeltaSeconds = 2
+	params.MaxBlockTimeDeltaSeconds = 30
+
+	vectors := []struct {
+		delta    time.Duration
+		expected uint64
+		clamped  bool
+	}{
+		{5 * time.Second, 5, false},
+		{2 * time.Second, 2, false},
+		{30 * time.Second, 30, false},
+		{time.Second, 2, true},
+		{31 * time.Second, 30, true},
+		{6 * time.Hour, 30, true},
+		// blocks not later than the previous one are clamped to the min
+		{0, 2, true},
+		{-time.Minute, 2, true},
+	}
+
+	for _, v := range vectors {
+		delta, clamped := BlockTimeDelta(params, prevTime.Add(v.delta), prevTime)
+		if delta != v.expected || clamped != v.clamped {
+			t.Errorf("delta %s: expected (%d, %t), got (%d, %t)", v.delta, v.expected, v.clamped, delta, clamped)
+		}
+	}
+
+	// a halted chain is paid for at most the max block time delta
+	coins := DefaultInitialMinter().BlockProvision(DefaultParams(), 1, prevTime.Add(6*time.Hour), prevTime)
+	if got := coins.AmountOf(params.MintDenom); !got.Equal(math.NewInt(5981087)) {
+		t.Errorf("expected the provision of a 60s block, got %s", got)
+	}
+}
+
+func TestPowTruncate(t *testing.T) {
+	base := math.LegacyNewDecWithPrec(99, 2)
+
+	if got := powTruncate(base, 0); !got.Equal(math.LegacyOneDec()) {
+		t.Errorf("expected x^0 to be 1, got %s", got)
+	}
+	if got := powTruncate(base, 1); !got.Equal(base) {
+		t.Errorf("expected x^1 to be %s, got %s", base, got)
+	}
+	if got := powTruncate(math.LegacyNewDecWithPrec(5, 1), 10); !got.Equal(math.LegacyNewDecWithPrec(9765625, 10)) {
+		t.Errorf("expected 0.5^10 to be exact, got %s", got)
+	}
+	if got := powTruncate(base, 1<<40); !got.IsZero() {
+		t.Errorf("expected a huge exponent to decay to zero, got %s", got)
+	}
+
+	// squaring truncates differently than repeated multiplication, but never
+	// by more than a few units of the last decimal
+	expected := math.LegacyOneDec()
+	tolerance := math.LegacyNewDecWithPrec(1, 15)
+	for i := uint64(1); i <= 1000; i++ {
+		expected = expected.MulTruncate(base)
+		if diff := powTruncate(base, i).Sub(expected).Abs(); diff.GT(tolerance) {
+			t.Fatalf("0.99^%d is off by %s", i, diff)
+		}
+	}
+}
+
+func BenchmarkBlockProvision(b *testing.B) {
+	prevTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	blockTime := prevTime.Add(5 * time.Second)
+	minter := DefaultInitialMinter()
+
+	for _, interval := range []int64{50000, 1} {
+		for _, height := range []uint64{1, 1_000_000, 1_000_000_000, 1_000_000_000_000} {
+			params := DefaultParams()
+			params.SubsidyHalvingInterval = math.LegacyNewDec(interval)
+
+			b.Run(fmt.Sprintf("interval=%d/height=%d", interval, height), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					minter.BlockProvision(params, height, blockTime, prevTime)
+				}
+			})
+		}
+	}
+}
+
 func TestFirstBlockProvision(t *testing.T) {
 
 	key := storetypes.NewKVStoreKey(ModuleName)
@@ -286,27 +540,23 @@ func TestFirstBlockProvision(t *testing.T) {
 	stakingKeeper.EXPECT().TokensFromConsensusPower(ctx, gomock.Any()).DoAndReturn(func(ctx sdk.Context, power int64) math.Int {
 		return sdk.TokensFromConsensusPower(power, math.NewIntFromUint64(1000000))
 	}).AnyTimes()
-	stakingKeeper.EXPECT().BondDenom(ctx).Return("ugd").AnyTimes()
+	stakingKeeper.EXPECT().BondDenom(ctx).Return("ugd", nil).AnyTimes()
 	stakingKeeper.EXPECT().IterateBondedValidatorsByPower(gomock.Any(), gomock.Any()).AnyTimes()
 	stakingKeeper.EXPECT().IterateDelegations(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
-	stakingKeeper.EXPECT().TotalBondedTokens(gomock.Any()).Return(math.NewInt(10000000)).AnyTimes()
+	stakingKeeper.EXPECT().TotalBondedTokens(gomock.Any()).Return(math.NewInt(10000000), nil).AnyTimes()
 
-	params := Params{
-		MintDenom:              "ugd",
-		SubsidyHalvingInterval: math.LegacyNewDecWithPrec(50000, 0),
-		GoalBonded:             math.LegacyNewDecWithPrec(67, 2),
-		BlocksPerYear:          uint64(60 * 60 * 8766 / 5),
-	}
+	params := DefaultParams()
+	params.SubsidyHalvingInterval = math.LegacyNewDecWithPrec(50000, 0)
 
 	minter := NewMinter(params.SubsidyHalvingInterval)
 
-	coins := Minter.BlockProvision(minter, params, 10, ctx, prevCtx)
+	coins := Minter.BlockProvision(minter, params, 10, ctx.BlockTime(), prevCtx.BlockTime())
 
 	fmt.Println(coins.AmountOf("ugd"))
 	fmt.Println(coins.AmountOf("fermi"))
 
 	fmt.Println(coins.String())
-	if !coins.AmountOf("fermi").IsZero() {
+	if !coins.AmountOf("ugd").IsZero() {
 		fmt.Println("passed")
 	} else {
 		t.Error("faild amount 0")
@@ -316,11 +566,14 @@ func TestFirstBlockProvision(t *testing.T) {
 
 func TestAddressConvertion(t *testing.T) {
 
-	compareValue := []Mint{{"unigrid1pk2sxhrywmxsqtnas3p7gu0t8x43hlvy4jatsg", 100, "80"},
-		{"unigrid1pk2sxhrywmxsqtnas3p7gu0t8x43tlvy4jatsg", 1000, "90"},
-		{"unigrid1pk2sxhrywmxsqtnas3p7gu0t8x43ulvy4jatsg", 1275, "110"},
-		{"unigrid1pk2sxhrywmxsqtnas3p7gu0t8x43alvy4jatsg", 981256, "150"},
-		{"unigrid1pk2sxhrywmxsqtnas3p7gu0t8x43rlvy4jatsg", 1236, "165"},
+	sdk.GetConfig().SetBech32PrefixForAccount("unigrid", "unigridpub")
+	defer sdk.GetConfig().SetBech32PrefixForAccount(sdk.Bech32PrefixAccAddr, sdk.Bech32PrefixAccPub)
+
+	compareValue := []Mint{{Address: "unigrid1pk2sxhrywmxsqtnas3p7gu0t8x43hlvy6hl2lt", Amount: 100, Height: 80},
+		{Address: "unigrid1pk2sxhrywmxsqtnas3p7gu0t8x43tlvy4jatsg", Amount: 1000, Height: 90},
+		{Address: "unigrid1pk2sxhrywmxsqtnas3p7gu0t8x43ulvy4jatsg", Amount: 1275, Height: 110},
+		{Address: "unigrid1pk2sxhrywmxsqtnas3p7gu0t8x43alvy4jatsg", Amount: 981256, Height: 150},
+		{Address: "unigrid1pk2sxhrywmxsqtnas3p7gu0t8x43rlvy4jatsg", Amount: 1236, Height: 165},
 	}
 
 	key := storetypes.NewKVStoreKey(ModuleName)
@@ -339,10 +592,10 @@ func TestAddressConvertion(t *testing.T) {
 	stakingKeeper.EXPECT().TokensFromConsensusPower(ctx, gomock.Any()).DoAndReturn(func(ctx sdk.Context, power int64) math.Int {
 		return sdk.TokensFromConsensusPower(power, math.NewIntFromUint64(1000000))
 	}).AnyTimes()
-	stakingKeeper.EXPECT().BondDenom(ctx).Return("ugd").AnyTimes()
+	stakingKeeper.EXPECT().BondDenom(ctx).Return("ugd", nil).AnyTimes()
 	stakingKeeper.EXPECT().IterateBondedValidatorsByPower(gomock.Any(), gomock.Any()).AnyTimes()
 	stakingKeeper.EXPECT().IterateDelegations(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
-	stakingKeeper.EXPECT().TotalBondedTokens(gomock.Any()).Return(math.NewInt(10000000)).AnyTimes()
+	stakingKeeper.EXPECT().TotalBondedTokens(gomock.Any()).Return(math.NewInt(10000000), nil).AnyTimes()
 
 	add, err := ConvertStringToAcc(compareValue[0].Address)
 
@@ -358,3 +611,124 @@ func TestAddressConvertion(t *testing.T) {
 	//unigrid1x9cxkvnn0p58y7thd4u8xut5deshxvmsxanh2vr58purgvmgd3m8jdr2v968xect00gs9
 	//cosmos1x9cxkvnn0p58y7thd4u8xut5deshxvmsxanh2vr58purgvmgd3m8jdr2v968xecqaqucu
 }
+
+func TestValidateMinter(t *testing.T) {
+	minter := DefaultInitialMinter()
+	minter.BlockProvisionMinted = sdk.NewCoins(sdk.NewInt64Coin("ugd", 100))
+	minter.HedgehogMinted = sdk.NewCoins(sdk.NewInt64Coin("uugd", 50))
+	minter.LastMintHeight = 10
+	minter.BlockSubsidy = sdk.NewCoins(sdk.NewInt64Coin("ugd", 5))
+	if err := ValidateMinter(minter); err != nil {
+		t.Fatalf("expected minter to be valid: %v", err)
+	}
+
+	invalid := minter
+	invalid.LastMintHeight = -1
+	if err := ValidateMinter(invalid); err == nil {
+		t.Error("expected a negative last mint height to be invalid")
+	}
+
+	invalid = minter
+	invalid.HedgehogMinted = sdk.Coins{sdk.Coin{Denom: "uugd", Amount: math.NewInt(-1)}}
+	if err := ValidateMinter(invalid); err == nil {
+		t.Error("expected negative hedgehog totals to be invalid")
+	}
+}
+
+func TestDefaultInflationCalculationFn(t *testing.T) {
+	params := DefaultParams()
+	minter := DefaultInitialMinter()
+	prevTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := sdk.Context{}.WithBlockHeight(314934)
+
+	for _, delta := range []uint64{1, 5, 13, 60} {
+		coins, err := DefaultInflationCalculationFn(ctx, &minter, params, math.LegacyNewDecWithPrec(5, 1), delta)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected := minter.BlockProvision(params, 314934, prevTime.Add(time.Duration(delta)*time.Second), prevTime)
+		if !coins.Equal(expected) {
+			t.Errorf("delta %d: expected the UGD provision %s, got %s", delta, expected, coins)
+		}
+	}
+}
+
+func TestBondedRatioInflationCalculationFn(t *testing.T) {
+	params := DefaultParams()
+	params.BlocksPerYear = 100
+	supply := math.NewInt(1_000_000_000)
+	ic := NewBondedRatioInflationCalculationFn(func(context.Context) (math.Int, error) { return supply, nil })
+	ctx := sdk.Context{}.WithBlockHeight(1)
+
+	// a minter that never used the strategy starts from the minimum
+	minter := Minter{SubsidyHalvingInterval: params.SubsidyHalvingInterval}
+	coins, err := ic(ctx, &minter, params, math.LegacyZeroDec(), 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 0.07 + 0.13 / 100
+	if expected := math.LegacyNewDecWithPrec(713, 4); !minter.Inflation.Equal(expected) {
+		t.Fatalf("expected an inflation of %s, got %s", expected, minter.Inflation)
+	}
+	if expected := math.LegacyNewDec(71_300_000); !minter.AnnualProvisions.Equal(expected) {
+		t.Fatalf("expected annual provisions of %s, got %s", expected, minter.AnnualProvisions)
+	}
+	if expected := sdk.NewCoins(sdk.NewInt64Coin("ugd", 713_000)); !coins.Equal(expected) {
+		t.Fatalf("expected a provision of %s, got %s", expected, coins)
+	}
+
+	// above the goal the inflation falls, but never below the minimum
+	if _, err := ic(ctx, &minter, params, math.LegacyOneDec(), 5); err != nil {
+		t.Fatal(err)
+	}
+	if !minter.Inflation.LT(math.LegacyNewDecWithPrec(713, 4)) {
+		t.Fatalf("expected the inflation to fall, got %s", minter.Inflation)
+	}
+	for i := 0; i < 2; i++ {
+		if _, err := ic(ctx, &minter, params, math.LegacyOneDec(), 5); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if !minter.Inflation.Equal(params.InflationMin) {
+		t.Fatalf("expected the inflation to fall back to %s, got %s", params.InflationMin, minter.Inflation)
+	}
+
+	// the inflation never exceeds the maximum
+	minter.Inflation = params.InflationMax
+	if _, err := ic(ctx, &minter, params, math.LegacyZeroDec(), 5); err != nil {
+		t.Fatal(err)
+	}
+	if !minter.Inflation.Equal(params.InflationMax) {
+		t.Fatalf("expected the inflation to stay at %s, got %s", params.InflationMax, minter.Inflation)
+	}
+
+	failing := NewBondedRatioInflationCalculationFn(func(context.Context) (math.Int, error) {
+		return math.Int{}, fmt.Errorf("no staking supply")
+	})
+	if _, err := failing(ctx, &minter, params, math.LegacyZeroDec(), 5); err == nil {
+		t.Fatal("expected the strategy to fail without a staking supply")
+	}
+}
+
+func TestUGDAnnualProvisions(t *testing.T) {
+	params := DefaultParams()
+	minter := DefaultInitialMinter()
+
+	vectors := []struct {
+		blockTime uint64
+		expected  math.LegacyDec
+	}{
+		{5, math.LegacyNewDec(498423 * SecondsPerYear / 5)},
+		// the block time the params are calibrated for
+		{0, math.LegacyNewDec(498423 * SecondsPerYear / 5)},
+		{13, math.LegacyNewDec(1295902 * SecondsPerYear).QuoInt64(13)},
+		// slow blocks are only paid for the max block time delta
+		{120, math.LegacyNewDec(5981087 * SecondsPerYear / 120)},
+	}
+
+	for _, v := range vectors {
+		if got := minter.UGDAnnualProvisions(params, 1, v.blockTime); !got.Equal(v.expected) {
+			t.Errorf("block time %ds: expected %s, got %s", v.blockTime, v.expected, got)
+		}
+	}
+}
@@ -1,11 +1,20 @@
 package types
 
 import (
+	"fmt"
+
 	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-var _ sdk.Msg = &MsgUpdateParams{}
+var (
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgSetMintingPaused{}
+	_ sdk.Msg = &MsgScheduleParamsChange{}
+	_ sdk.Msg = &MsgCancelParamsChange{}
+	_ sdk.Msg = &MsgClaimVested{}
+	_ sdk.Msg = &MsgClawbackMint{}
+)
 
 // GetSigners returns the expected signers for a MsgUpdateParams message.
 func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
@@ -25,3 +34,144 @@ func (m *MsgUpdateParams) ValidateBasic() error {
 
 	return nil
 }
+
+// GetSigners returns the expected signers for a MsgSetMintingPaused message.
+func (m *MsgSetMintingPaused) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgSetMintingPaused) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errors.Wrap(err, "invalid authority address")
+	}
+
+	return m.Pause().Validate()
+}
+
+// Pause returns the minting pause requested by the message.
+func (m *MsgSetMintingPaused) Pause() MintingPause {
+	return MintingPause{
+		BlockProvisionsPaused: m.BlockProvisionsPaused,
+		HedgehogMintsPaused:   m.HedgehogMintsPaused,
+		ResumeHeight:          m.ResumeHeight,
+	}
+}
+
+// IsPaused reports whether any kind of minting is paused.
+func (p MintingPause) IsPaused() bool {
+	return p.BlockProvisionsPaused || p.HedgehogMintsPaused
+}
+
+// Validate validates the minting pause.
+func (p MintingPause) Validate() error {
+	if p.ResumeHeight < 0 {
+		return fmt.Errorf("resume height cannot be negative: %d", p.ResumeHeight)
+	}
+	if p.ResumeHeight != 0 && !p.IsPaused() {
+		return fmt.Errorf("resume height %d set without pausing any minting", p.ResumeHeight)
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgScheduleParamsChange message.
+func (m *MsgScheduleParamsChange) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgScheduleParamsChange) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errors.Wrap(err, "invalid authority address")
+	}
+
+	return m.Change().Validate()
+}
+
+// Change returns the params change scheduled by the message.
+func (m *MsgScheduleParamsChange) Change() ScheduledParamsChange {
+	return ScheduledParamsChange{
+		ActivationHeight: m.ActivationHeight,
+		Params:           m.Params,
+	}
+}
+
+// GetSigners returns the expected signers for a MsgCancelParamsChange message.
+func (m *MsgCancelParamsChange) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgCancelParamsChange) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errors.Wrap(err, "invalid authority address")
+	}
+
+	if m.ActivationHeight <= 0 {
+		return errors.Wrapf(ErrInvalidActivationHeight, "activation height must be positive: %d", m.ActivationHeight)
+	}
+
+	return nil
+}
+
+// Validate validates the scheduled params change.
+func (c ScheduledParamsChange) Validate() error {
+	if c.ActivationHeight <= 0 {
+		return errors.Wrapf(ErrInvalidActivationHeight, "activation height must be positive: %d", c.ActivationHeight)
+	}
+
+	return c.Params.Validate()
+}
+
+// GetSigners returns the expected signers for a MsgClaimVested message.
+func (m *MsgClaimVested) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Recipient)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgClaimVested) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Recipient); err != nil {
+		return errors.Wrap(err, "invalid recipient address")
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgClawbackMint message.
+func (m *MsgClawbackMint) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgClawbackMint) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errors.Wrap(err, "invalid authority address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Address); err != nil {
+		return errors.Wrap(err, "invalid address")
+	}
+
+	if m.MintId == 0 {
+		return errors.Wrap(ErrMintNotFound, "mint id must be positive")
+	}
+
+	switch m.Destination {
+	case DestinationCommunityPool, DestinationBurn:
+		return nil
+	}
+
+	return fmt.Errorf("clawback destination must be either %s or %s: %s", DestinationCommunityPool, DestinationBurn, m.Destination)
+}
+
+// MintKey returns the Hedgehog key of the mint to claw back, in the
+// address/height form of the processed mints.
+func (m *MsgClawbackMint) MintKey() string {
+	return HedgehogMint{Address: m.Address, Height: m.MintId}.Key()
+}
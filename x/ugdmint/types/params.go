@@ -11,25 +11,73 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// Destinations of the provision exceeding the bonded ratio goal
+const (
+	// ExcessDestinationCommunityPool mints the excess provision to the
+	// community pool.
+	ExcessDestinationCommunityPool = "community_pool"
+	// ExcessDestinationBurn does not mint the excess provision at all.
+	ExcessDestinationBurn = "burn"
+)
+
 // NewParams creates a new Params instance
 func NewParams(
 	mintDenom string, subsidyHalvingInterval, goalBonded math.LegacyDec, blocksPerYear uint64,
+	blocksPerMinute, heightOffset, decayStartHeight uint64, decayFactor math.LegacyDec,
+	referenceWindowSeconds, provisionScale, minBlockTimeDeltaSeconds, maxBlockTimeDeltaSeconds uint64,
+	maxSupply sdk.Coins, inflationRateChange, inflationMax, inflationMin math.LegacyDec,
+	bondedAdjustmentEnabled bool, belowGoalStakingShare math.LegacyDec, excessDestination string,
+	distributionSplit []DistributionDestination, vestingPolicy VestingPolicy,
 ) Params {
 	return Params{
-		MintDenom:              mintDenom,
-		SubsidyHalvingInterval: subsidyHalvingInterval,
-		GoalBonded:             goalBonded,
-		BlocksPerYear:          blocksPerYear,
+		MintDenom:                mintDenom,
+		SubsidyHalvingInterval:   subsidyHalvingInterval,
+		GoalBonded:               goalBonded,
+		BlocksPerYear:            blocksPerYear,
+		BlocksPerMinute:          blocksPerMinute,
+		HeightOffset:             heightOffset,
+		DecayStartHeight:         decayStartHeight,
+		DecayFactor:              decayFactor,
+		ReferenceWindowSeconds:   referenceWindowSeconds,
+		ProvisionScale:           provisionScale,
+		MinBlockTimeDeltaSeconds: minBlockTimeDeltaSeconds,
+		MaxBlockTimeDeltaSeconds: maxBlockTimeDeltaSeconds,
+		MaxSupply:                maxSupply,
+		InflationRateChange:      inflationRateChange,
+		InflationMax:             inflationMax,
+		InflationMin:             inflationMin,
+		BondedAdjustmentEnabled:  bondedAdjustmentEnabled,
+		BelowGoalStakingShare:    belowGoalStakingShare,
+		ExcessDestination:        excessDestination,
+		DistributionSplit:        distributionSplit,
+		VestingPolicy:            vestingPolicy,
 	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
 	return Params{
-		MintDenom:              "ugd",
-		SubsidyHalvingInterval: math.LegacyNewDecWithPrec(50000, 0),
-		GoalBonded:             math.LegacyNewDecWithPrec(67, 2),
-		BlocksPerYear:          uint64(60 * 60 * 8766 / 5),
+		MintDenom:                "ugd",
+		SubsidyHalvingInterval:   math.LegacyNewDecWithPrec(50000, 0),
+		GoalBonded:               math.LegacyNewDecWithPrec(67, 2),
+		BlocksPerYear:            uint64(60 * 60 * 8766 / 5),
+		BlocksPerMinute:          12,
+		HeightOffset:             2685066,
+		DecayStartHeight:         1000000,
+		DecayFactor:              math.LegacyNewDecWithPrec(99, 2),
+		ReferenceWindowSeconds:   60,
+		ProvisionScale:           100_000_000,
+		MinBlockTimeDeltaSeconds: 1,
+		MaxBlockTimeDeltaSeconds: 60,
+		MaxSupply:                sdk.Coins{},
+		InflationRateChange:      math.LegacyNewDecWithPrec(13, 2),
+		InflationMax:             math.LegacyNewDecWithPrec(20, 2),
+		InflationMin:             math.LegacyNewDecWithPrec(7, 2),
+		BondedAdjustmentEnabled:  false,
+		BelowGoalStakingShare:    math.LegacyNewDecWithPrec(10, 2),
+		ExcessDestination:        ExcessDestinationCommunityPool,
+		DistributionSplit:        DefaultDistributionSplit(),
+		VestingPolicy:            DefaultVestingPolicy(),
 	}
 }
 
@@ -47,6 +95,53 @@ func (p Params) Validate() error {
 	if err := validateBlocksPerYear(p.BlocksPerYear); err != nil {
 		return err
 	}
+	if err := validateBlocksPerMinute(p.BlocksPerMinute); err != nil {
+		return err
+	}
+	if err := validateDecayFactor(p.DecayFactor); err != nil {
+		return err
+	}
+	if err := validateReferenceWindowSeconds(p.ReferenceWindowSeconds); err != nil {
+		return err
+	}
+	if err := validateProvisionScale(p.ProvisionScale); err != nil {
+		return err
+	}
+	if err := validateMaxBlockTimeDeltaSeconds(p.MaxBlockTimeDeltaSeconds); err != nil {
+		return err
+	}
+	if p.MinBlockTimeDeltaSeconds > p.MaxBlockTimeDeltaSeconds {
+		return fmt.Errorf("min block time delta %d must not exceed max block time delta %d",
+			p.MinBlockTimeDeltaSeconds, p.MaxBlockTimeDeltaSeconds)
+	}
+	if err := validateMaxSupply(p.MaxSupply); err != nil {
+		return err
+	}
+	if err := validateInflationRateChange(p.InflationRateChange); err != nil {
+		return err
+	}
+	if err := validateInflationMax(p.InflationMax); err != nil {
+		return err
+	}
+	if err := validateInflationMin(p.InflationMin); err != nil {
+		return err
+	}
+	if p.InflationMax.LT(p.InflationMin) {
+		return fmt.Errorf("max inflation %s must not be less than min inflation %s",
+			p.InflationMax, p.InflationMin)
+	}
+	if err := validateBelowGoalStakingShare(p.BelowGoalStakingShare); err != nil {
+		return err
+	}
+	if err := validateExcessDestination(p.ExcessDestination); err != nil {
+		return err
+	}
+	if err := validateDistributionSplit(p.DistributionSplit); err != nil {
+		return err
+	}
+	if err := validateVestingPolicy(p.VestingPolicy); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -119,3 +214,178 @@ func validateBlocksPerYear(i interface{}) error {
 
 	return nil
 }
+
+func validateBlocksPerMinute(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("blocks per minute must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateDecayFactor(i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("decay factor cannot be nil: %s", v)
+	}
+	if v.IsNegative() || v.IsZero() {
+		return fmt.Errorf("decay factor must be positive: %s", v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("decay factor too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateReferenceWindowSeconds(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("reference window must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateProvisionScale(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("provision scale must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateMaxBlockTimeDeltaSeconds(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("max block time delta must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateMaxSupply(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := v.Validate(); err != nil {
+		return fmt.Errorf("invalid max supply: %w", err)
+	}
+
+	return nil
+}
+
+func validateInflationRateChange(i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("inflation rate change cannot be nil: %s", v)
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("inflation rate change cannot be negative: %s", v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("inflation rate change too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateInflationMax(i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("max inflation cannot be nil: %s", v)
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("max inflation cannot be negative: %s", v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("max inflation too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateInflationMin(i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("min inflation cannot be nil: %s", v)
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("min inflation cannot be negative: %s", v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("min inflation too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateBelowGoalStakingShare(i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("below goal staking share cannot be nil: %s", v)
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("below goal staking share cannot be negative: %s", v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("below goal staking share too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateExcessDestination(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	switch v {
+	case ExcessDestinationCommunityPool, ExcessDestinationBurn:
+		return nil
+	default:
+		return fmt.Errorf("excess destination must be %s or %s: %s",
+			ExcessDestinationCommunityPool, ExcessDestinationBurn, v)
+	}
+}
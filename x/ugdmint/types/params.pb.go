@@ -7,6 +7,8 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -30,6 +32,20 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Minter struct {
 	// current subsidy halving interval
 	SubsidyHalvingInterval cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=subsidy_halving_interval,json=subsidyHalvingInterval,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"subsidy_halving_interval"`
+	// total amount minted through block provisions
+	BlockProvisionMinted github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=block_provision_minted,json=blockProvisionMinted,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"block_provision_minted"`
+	// total amount minted through Hedgehog mints
+	HedgehogMinted github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=hedgehog_minted,json=hedgehogMinted,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"hedgehog_minted"`
+	// height of the last block the module minted coins at
+	LastMintHeight int64 `protobuf:"varint,4,opt,name=last_mint_height,json=lastMintHeight,proto3" json:"last_mint_height,omitempty"`
+	// block provision minted at the last block
+	BlockSubsidy github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=block_subsidy,json=blockSubsidy,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"block_subsidy"`
+	// current annual inflation rate of the bonded-ratio emission strategy
+	Inflation cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=inflation,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"inflation"`
+	// current annual expected provisions of the bonded-ratio emission strategy
+	AnnualProvisions cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=annual_provisions,json=annualProvisions,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"annual_provisions"`
+	// seconds between the last block and the block before it
+	ObservedBlockTimeSeconds uint64 `protobuf:"varint,8,opt,name=observed_block_time_seconds,json=observedBlockTimeSeconds,proto3" json:"observed_block_time_seconds,omitempty"`
 }
 
 func (m *Minter) Reset()         { *m = Minter{} }
@@ -65,6 +81,41 @@ func (m *Minter) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Minter proto.InternalMessageInfo
 
+func (m *Minter) GetBlockProvisionMinted() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BlockProvisionMinted
+	}
+	return nil
+}
+
+func (m *Minter) GetHedgehogMinted() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.HedgehogMinted
+	}
+	return nil
+}
+
+func (m *Minter) GetLastMintHeight() int64 {
+	if m != nil {
+		return m.LastMintHeight
+	}
+	return 0
+}
+
+func (m *Minter) GetBlockSubsidy() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BlockSubsidy
+	}
+	return nil
+}
+
+func (m *Minter) GetObservedBlockTimeSeconds() uint64 {
+	if m != nil {
+		return m.ObservedBlockTimeSeconds
+	}
+	return 0
+}
+
 // Params defines the parameters for the module.
 type Params struct {
 	// type of coin to mint
@@ -75,6 +126,44 @@ type Params struct {
 	GoalBonded cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=goal_bonded,json=goalBonded,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"goal_bonded"`
 	// expected blocks per year
 	BlocksPerYear uint64 `protobuf:"varint,4,opt,name=blocks_per_year,json=blocksPerYear,proto3" json:"blocks_per_year,omitempty"`
+	// number of blocks per minute the block provision is divided by
+	BlocksPerMinute uint64 `protobuf:"varint,5,opt,name=blocks_per_minute,json=blocksPerMinute,proto3" json:"blocks_per_minute,omitempty"`
+	// offset added to the block height before the decay is applied
+	HeightOffset uint64 `protobuf:"varint,6,opt,name=height_offset,json=heightOffset,proto3" json:"height_offset,omitempty"`
+	// adjusted height from which on the subsidy decays
+	DecayStartHeight uint64 `protobuf:"varint,7,opt,name=decay_start_height,json=decayStartHeight,proto3" json:"decay_start_height,omitempty"`
+	// factor the subsidy is multiplied with every subsidy halving interval
+	DecayFactor cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=decay_factor,json=decayFactor,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"decay_factor"`
+	// length in seconds of the block time window the subsidy is paid for
+	ReferenceWindowSeconds uint64 `protobuf:"varint,9,opt,name=reference_window_seconds,json=referenceWindowSeconds,proto3" json:"reference_window_seconds,omitempty"`
+	// number of units of the mint denom in one whole coin
+	ProvisionScale uint64 `protobuf:"varint,10,opt,name=provision_scale,json=provisionScale,proto3" json:"provision_scale,omitempty"`
+	// lower bound in seconds of the block time a block is paid for
+	MinBlockTimeDeltaSeconds uint64 `protobuf:"varint,11,opt,name=min_block_time_delta_seconds,json=minBlockTimeDeltaSeconds,proto3" json:"min_block_time_delta_seconds,omitempty"`
+	// upper bound in seconds of the block time a block is paid for
+	MaxBlockTimeDeltaSeconds uint64 `protobuf:"varint,12,opt,name=max_block_time_delta_seconds,json=maxBlockTimeDeltaSeconds,proto3" json:"max_block_time_delta_seconds,omitempty"`
+	// maximum amount of every listed denom the module mints in total, denoms
+	// that are not listed are not capped
+	MaxSupply github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,13,rep,name=max_supply,json=maxSupply,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_supply"`
+	// maximum annual change in inflation rate of the bonded-ratio emission
+	// strategy
+	InflationRateChange cosmossdk_io_math.LegacyDec `protobuf:"bytes,14,opt,name=inflation_rate_change,json=inflationRateChange,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"inflation_rate_change"`
+	// maximum inflation rate of the bonded-ratio emission strategy
+	InflationMax cosmossdk_io_math.LegacyDec `protobuf:"bytes,15,opt,name=inflation_max,json=inflationMax,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"inflation_max"`
+	// minimum inflation rate of the bonded-ratio emission strategy
+	InflationMin cosmossdk_io_math.LegacyDec `protobuf:"bytes,16,opt,name=inflation_min,json=inflationMin,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"inflation_min"`
+	// whether the block provision is adjusted by the bonded ratio
+	BondedAdjustmentEnabled bool `protobuf:"varint,17,opt,name=bonded_adjustment_enabled,json=bondedAdjustmentEnabled,proto3" json:"bonded_adjustment_enabled,omitempty"`
+	// share of the block provision additionally paid to the stakers while the
+	// bonded ratio is below goal_bonded
+	BelowGoalStakingShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,18,opt,name=below_goal_staking_share,json=belowGoalStakingShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"below_goal_staking_share"`
+	// destination of the excess provision while the bonded ratio is above
+	// goal_bonded, either community_pool or burn
+	ExcessDestination string `protobuf:"bytes,19,opt,name=excess_destination,json=excessDestination,proto3" json:"excess_destination,omitempty"`
+	// destinations the block provision is paid to, the weights sum to 1
+	DistributionSplit []DistributionDestination `protobuf:"bytes,20,rep,name=distribution_split,json=distributionSplit,proto3" json:"distribution_split"`
+	// vesting terms of the coins paid out by Hedgehog mints
+	VestingPolicy VestingPolicy `protobuf:"bytes,21,opt,name=vesting_policy,json=vestingPolicy,proto3" json:"vesting_policy"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -123,9 +212,296 @@ func (m *Params) GetBlocksPerYear() uint64 {
 	return 0
 }
 
+func (m *Params) GetBlocksPerMinute() uint64 {
+	if m != nil {
+		return m.BlocksPerMinute
+	}
+	return 0
+}
+
+func (m *Params) GetHeightOffset() uint64 {
+	if m != nil {
+		return m.HeightOffset
+	}
+	return 0
+}
+
+func (m *Params) GetDecayStartHeight() uint64 {
+	if m != nil {
+		return m.DecayStartHeight
+	}
+	return 0
+}
+
+func (m *Params) GetReferenceWindowSeconds() uint64 {
+	if m != nil {
+		return m.ReferenceWindowSeconds
+	}
+	return 0
+}
+
+func (m *Params) GetProvisionScale() uint64 {
+	if m != nil {
+		return m.ProvisionScale
+	}
+	return 0
+}
+
+func (m *Params) GetMinBlockTimeDeltaSeconds() uint64 {
+	if m != nil {
+		return m.MinBlockTimeDeltaSeconds
+	}
+	return 0
+}
+
+func (m *Params) GetMaxBlockTimeDeltaSeconds() uint64 {
+	if m != nil {
+		return m.MaxBlockTimeDeltaSeconds
+	}
+	return 0
+}
+
+func (m *Params) GetMaxSupply() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MaxSupply
+	}
+	return nil
+}
+
+func (m *Params) GetBondedAdjustmentEnabled() bool {
+	if m != nil {
+		return m.BondedAdjustmentEnabled
+	}
+	return false
+}
+
+func (m *Params) GetExcessDestination() string {
+	if m != nil {
+		return m.ExcessDestination
+	}
+	return ""
+}
+
+func (m *Params) GetDistributionSplit() []DistributionDestination {
+	if m != nil {
+		return m.DistributionSplit
+	}
+	return nil
+}
+
+func (m *Params) GetVestingPolicy() VestingPolicy {
+	if m != nil {
+		return m.VestingPolicy
+	}
+	return VestingPolicy{}
+}
+
+// VestingPolicy defines how the coins paid out by a Hedgehog mint vest.
+type VestingPolicy struct {
+	// kind of vesting, one of none, delayed, continuous or periodic
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// seconds from the mint until the coins are fully vested
+	DurationSeconds uint64 `protobuf:"varint,2,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	// number of equal periods the coins vest in, only set for periodic vesting
+	PeriodCount uint32 `protobuf:"varint,3,opt,name=period_count,json=periodCount,proto3" json:"period_count,omitempty"`
+	// seconds from the mint before continuous or periodic vesting starts
+	CliffSeconds uint64 `protobuf:"varint,4,opt,name=cliff_seconds,json=cliffSeconds,proto3" json:"cliff_seconds,omitempty"`
+	// whether the coins are held in the ugdmint_escrow module account and
+	// claimed by the recipient as they unlock, instead of vesting in the
+	// account of the recipient
+	Escrow bool `protobuf:"varint,5,opt,name=escrow,proto3" json:"escrow,omitempty"`
+}
+
+func (m *VestingPolicy) Reset()         { *m = VestingPolicy{} }
+func (m *VestingPolicy) String() string { return proto.CompactTextString(m) }
+func (*VestingPolicy) ProtoMessage()    {}
+func (*VestingPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_222a8558c6899467, []int{2}
+}
+func (m *VestingPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VestingPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VestingPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VestingPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VestingPolicy.Merge(m, src)
+}
+func (m *VestingPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *VestingPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_VestingPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VestingPolicy proto.InternalMessageInfo
+
+func (m *VestingPolicy) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *VestingPolicy) GetDurationSeconds() uint64 {
+	if m != nil {
+		return m.DurationSeconds
+	}
+	return 0
+}
+
+func (m *VestingPolicy) GetPeriodCount() uint32 {
+	if m != nil {
+		return m.PeriodCount
+	}
+	return 0
+}
+
+func (m *VestingPolicy) GetCliffSeconds() uint64 {
+	if m != nil {
+		return m.CliffSeconds
+	}
+	return 0
+}
+
+func (m *VestingPolicy) GetEscrow() bool {
+	if m != nil {
+		return m.Escrow
+	}
+	return false
+}
+
+// DistributionDestination is a destination of the block provision along with
+// the share of the provision it is paid.
+type DistributionDestination struct {
+	// kind of destination, one of fee_collector, community_pool,
+	// module_account, address or burn
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// name of the module account or bech32 address that is paid, only set for
+	// the module_account and address types
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// share of the block provision paid to the destination
+	Weight cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=weight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"weight"`
+}
+
+func (m *DistributionDestination) Reset()         { *m = DistributionDestination{} }
+func (m *DistributionDestination) String() string { return proto.CompactTextString(m) }
+func (*DistributionDestination) ProtoMessage()    {}
+func (*DistributionDestination) Descriptor() ([]byte, []int) {
+	return fileDescriptor_222a8558c6899467, []int{3}
+}
+func (m *DistributionDestination) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DistributionDestination) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DistributionDestination.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DistributionDestination) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DistributionDestination.Merge(m, src)
+}
+func (m *DistributionDestination) XXX_Size() int {
+	return m.Size()
+}
+func (m *DistributionDestination) XXX_DiscardUnknown() {
+	xxx_messageInfo_DistributionDestination.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DistributionDestination proto.InternalMessageInfo
+
+func (m *DistributionDestination) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *DistributionDestination) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+// ScheduledParamsChange is a set of params that replaces the params of the
+// module at the beginning of the block at activation_height.
+type ScheduledParamsChange struct {
+	// activation_height is the height of the block that applies the params.
+	ActivationHeight int64 `protobuf:"varint,1,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	// params are the params applied at activation_height.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *ScheduledParamsChange) Reset()         { *m = ScheduledParamsChange{} }
+func (m *ScheduledParamsChange) String() string { return proto.CompactTextString(m) }
+func (*ScheduledParamsChange) ProtoMessage()    {}
+func (*ScheduledParamsChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_222a8558c6899467, []int{4}
+}
+func (m *ScheduledParamsChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledParamsChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledParamsChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledParamsChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledParamsChange.Merge(m, src)
+}
+func (m *ScheduledParamsChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledParamsChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledParamsChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledParamsChange proto.InternalMessageInfo
+
+func (m *ScheduledParamsChange) GetActivationHeight() int64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
+func (m *ScheduledParamsChange) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 func init() {
 	proto.RegisterType((*Minter)(nil), "cosmos.ugdmint.v1beta1.Minter")
 	proto.RegisterType((*Params)(nil), "cosmos.ugdmint.v1beta1.Params")
+	proto.RegisterType((*VestingPolicy)(nil), "cosmos.ugdmint.v1beta1.VestingPolicy")
+	proto.RegisterType((*DistributionDestination)(nil), "cosmos.ugdmint.v1beta1.DistributionDestination")
+	proto.RegisterType((*ScheduledParamsChange)(nil), "cosmos.ugdmint.v1beta1.ScheduledParamsChange")
 }
 
 func init() {
@@ -133,32 +509,83 @@ func init() {
 }
 
 var fileDescriptor_222a8558c6899467 = []byte{
-	// 393 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0x3f, 0x8b, 0xdb, 0x30,
-	0x18, 0xc6, 0xed, 0x34, 0x04, 0xa2, 0x52, 0x4a, 0x4d, 0x09, 0x6e, 0x4a, 0x9d, 0x90, 0x96, 0x12,
-	0x0a, 0xb6, 0x08, 0x9d, 0xda, 0x31, 0x64, 0x68, 0xa1, 0x85, 0xe0, 0x4e, 0xed, 0x62, 0x64, 0x4b,
-	0x28, 0xaa, 0xff, 0xc8, 0x48, 0x72, 0xa8, 0xbf, 0x42, 0xa7, 0x8e, 0x1d, 0xef, 0x23, 0xdc, 0x70,
-	0xdc, 0x67, 0xc8, 0x18, 0x6e, 0x3a, 0x6e, 0x08, 0x47, 0x32, 0xdc, 0xd7, 0x38, 0x6c, 0xe9, 0xee,
-	0xb6, 0x1b, 0x8e, 0x5b, 0x84, 0x78, 0xde, 0x47, 0xbf, 0xf7, 0x7d, 0xc5, 0x03, 0xde, 0x26, 0x5c,
-	0xe6, 0x5c, 0xc2, 0x8a, 0xe2, 0x9c, 0x15, 0x0a, 0xae, 0x67, 0x31, 0x51, 0x68, 0x06, 0x4b, 0x24,
-	0x50, 0x2e, 0x83, 0x52, 0x70, 0xc5, 0x9d, 0x81, 0x36, 0x05, 0xc6, 0x14, 0x18, 0xd3, 0xf0, 0x25,
-	0xe5, 0x94, 0xb7, 0x16, 0xd8, 0xdc, 0xb4, 0x7b, 0xf8, 0x4a, 0xbb, 0x23, 0x5d, 0x30, 0x4f, 0x75,
-	0xe9, 0x05, 0xca, 0x59, 0xc1, 0x61, 0x7b, 0x6a, 0x69, 0x52, 0x81, 0xde, 0x77, 0x56, 0x28, 0x22,
-	0x9c, 0x14, 0xb8, 0xb2, 0x8a, 0x25, 0xc3, 0x75, 0xb4, 0x42, 0xd9, 0x9a, 0x15, 0x34, 0x6a, 0x0b,
-	0x6b, 0x94, 0xb9, 0xf6, 0xd8, 0x9e, 0xf6, 0xe7, 0xb3, 0xcd, 0x6e, 0x64, 0x5d, 0xec, 0x46, 0xaf,
-	0x35, 0x54, 0xe2, 0x34, 0x60, 0x1c, 0xe6, 0x48, 0xad, 0x82, 0x6f, 0x84, 0xa2, 0xa4, 0x5e, 0x90,
-	0xe4, 0xec, 0xc4, 0x07, 0xa6, 0xe7, 0x82, 0x24, 0xe1, 0xc0, 0x20, 0xbf, 0x68, 0xe2, 0x57, 0x03,
-	0x9c, 0x9c, 0x76, 0x40, 0x6f, 0xd9, 0xee, 0xe8, 0xbc, 0x01, 0xa0, 0xd9, 0x2a, 0xc2, 0xa4, 0xe0,
-	0xb9, 0xee, 0x14, 0xf6, 0x1b, 0x65, 0xd1, 0x08, 0xf7, 0x8e, 0xd5, 0x79, 0xe4, 0xb1, 0x9c, 0x10,
-	0x3c, 0xa5, 0x1c, 0x65, 0x51, 0xcc, 0x0b, 0x4c, 0xb0, 0xfb, 0xe4, 0xa1, 0x7c, 0xd0, 0x50, 0xe6,
-	0x2d, 0xc4, 0x79, 0x0f, 0x9e, 0xc7, 0x19, 0x4f, 0x52, 0x19, 0x95, 0x44, 0x44, 0x35, 0x41, 0xc2,
-	0xed, 0x8e, 0xed, 0x69, 0x37, 0x7c, 0xa6, 0xe5, 0x25, 0x11, 0x3f, 0x09, 0x12, 0x9f, 0xdf, 0xfd,
-	0x3f, 0x1a, 0x59, 0x7f, 0xaf, 0x8e, 0x3f, 0x98, 0x3e, 0xbe, 0xc4, 0x29, 0xfc, 0x73, 0x9b, 0x0c,
-	0xfd, 0x5b, 0xf3, 0x1f, 0x9b, 0xbd, 0x67, 0x6f, 0xf7, 0x9e, 0x7d, 0xb9, 0xf7, 0xec, 0x7f, 0x07,
-	0xcf, 0xda, 0x1e, 0x3c, 0xeb, 0xfc, 0xe0, 0x59, 0xbf, 0x3e, 0x51, 0xa6, 0x56, 0x55, 0x1c, 0x24,
-	0x3c, 0x87, 0x55, 0xc1, 0xa8, 0x60, 0xd8, 0x2f, 0x05, 0xff, 0x4d, 0x12, 0x65, 0x52, 0xe0, 0xdf,
-	0xb0, 0xee, 0xa8, 0xaa, 0x2e, 0x89, 0x8c, 0x7b, 0x6d, 0x16, 0x3e, 0x5e, 0x07, 0x00, 0x00, 0xff,
-	0xff, 0x6f, 0xa9, 0x14, 0x0b, 0x8e, 0x02, 0x00, 0x00,
+	// 1206 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xbf, 0x8f, 0x1b, 0x45,
+	0x14, 0xbe, 0x4d, 0x2e, 0xce, 0x79, 0x6c, 0xdf, 0xd9, 0x93, 0xdc, 0x65, 0xf3, 0x03, 0x9f, 0xb9,
+	0xf0, 0xc3, 0x1c, 0x9c, 0xad, 0x84, 0x06, 0x22, 0x81, 0x14, 0xc7, 0x84, 0x44, 0x22, 0xca, 0x69,
+	0x1d, 0x25, 0x82, 0x82, 0xd5, 0x78, 0xe7, 0x79, 0x3d, 0xf1, 0xee, 0x8c, 0xb5, 0x33, 0xeb, 0xb3,
+	0x3b, 0x2a, 0x90, 0x52, 0x51, 0x01, 0xa2, 0x42, 0xa2, 0x41, 0x14, 0x28, 0x05, 0x3d, 0x6d, 0xca,
+	0x88, 0x0a, 0x51, 0x04, 0x94, 0x14, 0xf9, 0x37, 0xd0, 0xce, 0xec, 0xae, 0x0d, 0xca, 0x51, 0x58,
+	0xd1, 0x35, 0x89, 0xef, 0xbd, 0xef, 0x7d, 0xef, 0xcd, 0xdb, 0xef, 0xbd, 0x19, 0x74, 0xd1, 0x13,
+	0x32, 0x14, 0xb2, 0x1d, 0xfb, 0x34, 0x64, 0x5c, 0xb5, 0x27, 0x97, 0xfa, 0xa0, 0xc8, 0xa5, 0xf6,
+	0x98, 0x44, 0x24, 0x94, 0xad, 0x71, 0x24, 0x94, 0xc0, 0x5b, 0x06, 0xd4, 0x4a, 0x41, 0xad, 0x14,
+	0x74, 0xee, 0xb4, 0x2f, 0x7c, 0xa1, 0x21, 0xed, 0xe4, 0x97, 0x41, 0x9f, 0x3b, 0x6b, 0xd0, 0xae,
+	0x71, 0xa4, 0xa1, 0xc6, 0x55, 0x23, 0x21, 0xe3, 0xa2, 0xad, 0xff, 0x4d, 0x4d, 0xf5, 0xb4, 0x80,
+	0x3e, 0x91, 0x90, 0x67, 0xf7, 0x04, 0xe3, 0xc6, 0xbf, 0xf3, 0x5b, 0x01, 0x15, 0x6e, 0x31, 0xae,
+	0x20, 0xc2, 0x23, 0x64, 0xcb, 0xb8, 0x2f, 0x19, 0x9d, 0xb9, 0x43, 0x12, 0x4c, 0x18, 0xf7, 0x5d,
+	0xed, 0x98, 0x90, 0xc0, 0xb6, 0x1a, 0x56, 0xb3, 0xd8, 0xb9, 0xf4, 0xe8, 0xc9, 0xf6, 0xca, 0x9f,
+	0x4f, 0xb6, 0xcf, 0x1b, 0x52, 0x49, 0x47, 0x2d, 0x26, 0xda, 0x21, 0x51, 0xc3, 0xd6, 0x27, 0xe0,
+	0x13, 0x6f, 0xd6, 0x05, 0xef, 0xf7, 0x5f, 0xf7, 0x50, 0x5a, 0x54, 0x17, 0x3c, 0x67, 0x2b, 0xa5,
+	0xbc, 0x61, 0x18, 0x6f, 0xa6, 0x84, 0xf8, 0x5b, 0x0b, 0x6d, 0xf5, 0x03, 0xe1, 0x8d, 0x92, 0x73,
+	0x4c, 0x98, 0x64, 0x82, 0xbb, 0xc9, 0xe1, 0x81, 0xda, 0xc7, 0x1a, 0xc7, 0x9b, 0xa5, 0xcb, 0x67,
+	0x5b, 0x29, 0x4b, 0x52, 0x79, 0xd6, 0x92, 0xd6, 0x35, 0xc1, 0x78, 0xe7, 0x7a, 0x52, 0xc6, 0xcf,
+	0x7f, 0x6d, 0x37, 0x7d, 0xa6, 0x86, 0x71, 0xbf, 0xe5, 0x89, 0x30, 0xed, 0x43, 0xfa, 0xdf, 0x9e,
+	0xa4, 0xa3, 0xb6, 0x9a, 0x8d, 0x41, 0xea, 0x00, 0xf9, 0xfd, 0xf3, 0x87, 0xbb, 0xe5, 0x40, 0x57,
+	0xe8, 0x26, 0x67, 0x97, 0x3f, 0x3d, 0x7f, 0xb8, 0x6b, 0x39, 0xa7, 0x75, 0x01, 0xfb, 0x59, 0x7e,
+	0xdd, 0x06, 0x8a, 0x1f, 0x58, 0x68, 0x63, 0x08, 0xd4, 0x87, 0xa1, 0xf0, 0xb3, 0x92, 0x8e, 0x1f,
+	0x55, 0x49, 0xeb, 0x59, 0xe6, 0xb4, 0x98, 0x26, 0xaa, 0x06, 0x44, 0x2a, 0x5d, 0x87, 0x3b, 0x04,
+	0xe6, 0x0f, 0x95, 0xbd, 0xda, 0xb0, 0x9a, 0xc7, 0x9d, 0xf5, 0xc4, 0x9e, 0xa0, 0x6e, 0x68, 0x2b,
+	0xfe, 0xd2, 0x42, 0x15, 0xd3, 0xd0, 0xb4, 0xe3, 0xf6, 0x89, 0xa3, 0x2a, 0xba, 0xac, 0xf3, 0xf6,
+	0x4c, 0x5a, 0x7c, 0x1b, 0x15, 0x19, 0x1f, 0x04, 0x44, 0x31, 0xc1, 0xed, 0xc2, 0xb2, 0xba, 0x99,
+	0x73, 0xe0, 0xcf, 0x51, 0x8d, 0x70, 0x1e, 0x93, 0x60, 0x2e, 0x15, 0x69, 0x9f, 0x5c, 0x96, 0xb8,
+	0x6a, 0xb8, 0xf2, 0xaf, 0x2e, 0xf1, 0x07, 0xe8, 0xbc, 0xe8, 0x4b, 0x88, 0x26, 0x40, 0x5d, 0xd3,
+	0x41, 0xc5, 0x42, 0x70, 0x25, 0x78, 0x82, 0x53, 0x69, 0xaf, 0x35, 0xac, 0xe6, 0xaa, 0x63, 0x67,
+	0x90, 0x4e, 0x82, 0xb8, 0xc3, 0x42, 0xe8, 0x19, 0xff, 0xce, 0x8f, 0x65, 0x54, 0xd8, 0xd7, 0xe3,
+	0x8c, 0x5f, 0x41, 0x48, 0x7f, 0x28, 0x0a, 0x5c, 0x84, 0x66, 0x66, 0x9c, 0x62, 0x62, 0xe9, 0x26,
+	0x86, 0xff, 0x1d, 0xb0, 0x63, 0x2f, 0x7b, 0xc0, 0x1c, 0x54, 0xf2, 0x05, 0x09, 0xdc, 0xbe, 0xe0,
+	0x54, 0x2b, 0x78, 0x49, 0x7e, 0x94, 0xb0, 0x74, 0x34, 0x09, 0x7e, 0x03, 0x6d, 0xe8, 0x06, 0x49,
+	0x77, 0x0c, 0x91, 0x3b, 0x03, 0x12, 0x69, 0x31, 0xae, 0x3a, 0x46, 0x79, 0x72, 0x1f, 0xa2, 0x4f,
+	0x81, 0x44, 0x78, 0x17, 0xd5, 0x16, 0x70, 0x21, 0xe3, 0xb1, 0x02, 0xfb, 0x84, 0x46, 0x6e, 0xe4,
+	0xc8, 0x5b, 0xda, 0x8c, 0x2f, 0xa2, 0x8a, 0xd1, 0xb5, 0x2b, 0x06, 0x03, 0x09, 0x4a, 0x4b, 0x66,
+	0xd5, 0x29, 0x1b, 0xe3, 0x6d, 0x6d, 0xc3, 0xef, 0x20, 0x4c, 0xc1, 0x23, 0x33, 0x57, 0x2a, 0x12,
+	0xe5, 0x83, 0x70, 0x52, 0x23, 0xab, 0xda, 0xd3, 0x4b, 0x1c, 0xe9, 0x28, 0xdc, 0x41, 0x65, 0x83,
+	0x1e, 0x10, 0x4f, 0x89, 0xc8, 0x5e, 0x5b, 0xf6, 0xec, 0x25, 0x4d, 0x73, 0x5d, 0xb3, 0xe0, 0xf7,
+	0x90, 0x1d, 0xc1, 0x00, 0x22, 0xe0, 0x1e, 0xb8, 0x07, 0x8c, 0x53, 0x71, 0x90, 0x6b, 0xa4, 0xa8,
+	0x2b, 0xd9, 0xca, 0xfd, 0xf7, 0xb4, 0x3b, 0x55, 0x08, 0x7e, 0x13, 0x6d, 0xcc, 0x97, 0x9c, 0xf4,
+	0x48, 0x00, 0x36, 0xd2, 0x01, 0xeb, 0xb9, 0xb9, 0x97, 0x58, 0xf1, 0x87, 0xe8, 0x42, 0xc8, 0xf8,
+	0xa2, 0x08, 0x29, 0x04, 0x8a, 0xe4, 0x69, 0x4a, 0x46, 0x8a, 0x21, 0xe3, 0xb9, 0x0a, 0xbb, 0x09,
+	0x20, 0x4b, 0x94, 0xc4, 0x93, 0xe9, 0xe1, 0xf1, 0xe5, 0x34, 0x9e, 0x4c, 0x5f, 0x1c, 0xff, 0x85,
+	0x85, 0x50, 0x42, 0x20, 0xe3, 0xf1, 0x38, 0x98, 0xd9, 0x95, 0xa3, 0x5a, 0x20, 0xc5, 0x90, 0x4c,
+	0x7b, 0x3a, 0x27, 0x06, 0xb4, 0x99, 0x4f, 0xbe, 0x1b, 0x11, 0x05, 0xae, 0x37, 0x24, 0xdc, 0x07,
+	0x7b, 0x7d, 0xd9, 0x8f, 0x78, 0x2a, 0xe7, 0x73, 0x88, 0x82, 0x6b, 0x9a, 0x0d, 0xdf, 0x45, 0x95,
+	0x79, 0x9a, 0x90, 0x4c, 0xed, 0x8d, 0x65, 0xe9, 0xcb, 0x39, 0xcf, 0x2d, 0x32, 0xfd, 0x0f, 0x2f,
+	0xe3, 0x76, 0xf5, 0x25, 0xf0, 0x32, 0x8e, 0xaf, 0xa0, 0xb3, 0x66, 0x90, 0x5d, 0x42, 0xef, 0xc7,
+	0x52, 0x85, 0xc0, 0x95, 0x0b, 0x9c, 0xf4, 0x03, 0xa0, 0x76, 0xad, 0x61, 0x35, 0xd7, 0x9c, 0x33,
+	0x06, 0x70, 0x35, 0xf7, 0x7f, 0x64, 0xdc, 0xf8, 0x3e, 0xb2, 0xfb, 0x10, 0x88, 0x03, 0x57, 0xef,
+	0x03, 0xa9, 0xc8, 0x28, 0xd9, 0x3c, 0x72, 0x48, 0x22, 0xb0, 0xf1, 0xb2, 0xe5, 0x6d, 0x6a, 0xca,
+	0x8f, 0x05, 0x09, 0x7a, 0x86, 0xb0, 0x97, 0xf0, 0xe1, 0x3d, 0x84, 0x61, 0xea, 0x81, 0x94, 0x2e,
+	0x05, 0xa9, 0x18, 0x37, 0xb7, 0xc0, 0x29, 0xbd, 0x09, 0x6b, 0xc6, 0xd3, 0x9d, 0x3b, 0x30, 0x43,
+	0x98, 0x32, 0xa9, 0x22, 0xd6, 0x8f, 0x75, 0xc7, 0xe4, 0x38, 0x60, 0xca, 0x3e, 0xad, 0x75, 0xd7,
+	0x6e, 0xbd, 0xf8, 0x59, 0xd4, 0xea, 0x2e, 0x44, 0x2c, 0x90, 0x75, 0x8a, 0xc9, 0x29, 0x8c, 0xa0,
+	0x6a, 0x8b, 0xac, 0xbd, 0x84, 0x14, 0xdf, 0x43, 0xeb, 0x13, 0x0d, 0xf6, 0xdd, 0xb1, 0x08, 0x98,
+	0x37, 0xb3, 0x37, 0x1b, 0x56, 0xb3, 0x74, 0xf9, 0xf5, 0xc3, 0xd2, 0xdc, 0x35, 0xe8, 0x7d, 0x0d,
+	0x5e, 0x24, 0xaf, 0x4c, 0x16, 0x3d, 0x57, 0x5e, 0xfb, 0xee, 0x87, 0xed, 0x95, 0x07, 0xcf, 0x1f,
+	0xee, 0x9e, 0x5f, 0xd0, 0xfc, 0x34, 0x7f, 0xf1, 0x99, 0xab, 0x61, 0xe7, 0x17, 0x0b, 0x55, 0xfe,
+	0xc5, 0x88, 0x31, 0x5a, 0x1d, 0x31, 0x4e, 0xd3, 0x6b, 0x42, 0xff, 0xc6, 0x6f, 0xa1, 0x2a, 0x8d,
+	0x23, 0xa3, 0x9e, 0x6c, 0x68, 0x8f, 0x99, 0xbd, 0x99, 0xd9, 0xb3, 0x59, 0x7d, 0x15, 0x95, 0xc7,
+	0x10, 0x31, 0x41, 0x5d, 0x4f, 0xc4, 0x5c, 0xe9, 0x05, 0x5f, 0x71, 0x4a, 0xc6, 0x76, 0x2d, 0x31,
+	0x25, 0xab, 0xd5, 0x0b, 0xd8, 0x60, 0x90, 0x53, 0x99, 0x65, 0x5d, 0xd6, 0xc6, 0x8c, 0x67, 0x0b,
+	0x15, 0x40, 0x7a, 0x91, 0x38, 0xd0, 0x0b, 0x7a, 0xcd, 0x49, 0xff, 0xda, 0xf9, 0xc6, 0x42, 0x67,
+	0x0e, 0xe9, 0xf4, 0x0b, 0x4b, 0xbf, 0x80, 0x8a, 0x11, 0x78, 0x6c, 0xcc, 0x80, 0x2b, 0x73, 0x9b,
+	0x39, 0x73, 0x03, 0xbe, 0x89, 0x0a, 0x07, 0x66, 0x69, 0x2f, 0x7d, 0x11, 0xa5, 0x04, 0x3b, 0x5f,
+	0x59, 0x68, 0xb3, 0xe7, 0x0d, 0x81, 0xc6, 0x01, 0x50, 0xd3, 0xdd, 0x74, 0xa8, 0xdf, 0x46, 0x35,
+	0xe2, 0x29, 0x36, 0x31, 0xfd, 0x4b, 0x2f, 0x09, 0x4b, 0xbf, 0x96, 0xaa, 0x73, 0x47, 0x7a, 0x49,
+	0x5c, 0x45, 0x05, 0xf3, 0x08, 0xd7, 0xc5, 0x96, 0x2e, 0xd7, 0x0f, 0xd3, 0x81, 0x49, 0xb1, 0x28,
+	0x80, 0x34, 0xb0, 0xd3, 0x7b, 0xf4, 0xb4, 0x6e, 0x3d, 0x7e, 0x5a, 0xb7, 0xfe, 0x7e, 0x5a, 0xb7,
+	0xbe, 0x7e, 0x56, 0x5f, 0x79, 0xfc, 0xac, 0xbe, 0xf2, 0xc7, 0xb3, 0xfa, 0xca, 0x67, 0xef, 0x2f,
+	0x2c, 0xc4, 0x98, 0x33, 0x3f, 0x62, 0x74, 0x6f, 0x1c, 0x89, 0xfb, 0xe0, 0xa9, 0x6c, 0x33, 0x66,
+	0xfa, 0x98, 0x2b, 0x45, 0xef, 0xc9, 0x7e, 0x41, 0xbf, 0xcb, 0xdf, 0xfd, 0x67, 0x00, 0xdb, 0xae,
+	0xdf, 0x5f, 0x3a, 0x0c, 0x00, 0x00,
 }
 
 func (m *Minter) Marshal() (dAtA []byte, err error) {
@@ -181,6 +608,78 @@ func (m *Minter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ObservedBlockTimeSeconds != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ObservedBlockTimeSeconds))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.AnnualProvisions.Size()
+		i -= size
+		if _, err := m.AnnualProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Inflation.Size()
+		i -= size
+		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.BlockSubsidy) > 0 {
+		for iNdEx := len(m.BlockSubsidy) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockSubsidy[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.LastMintHeight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.LastMintHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.HedgehogMinted) > 0 {
+		for iNdEx := len(m.HedgehogMinted) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HedgehogMinted[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.BlockProvisionMinted) > 0 {
+		for iNdEx := len(m.BlockProvisionMinted) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockProvisionMinted[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size := m.SubsidyHalvingInterval.Size()
 		i -= size
@@ -214,90 +713,1437 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.BlocksPerYear != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.BlocksPerYear))
+	{
+		size, err := m.VestingPolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	if len(m.DistributionSplit) > 0 {
+		for iNdEx := len(m.DistributionSplit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DistributionSplit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.ExcessDestination) > 0 {
+		i -= len(m.ExcessDestination)
+		copy(dAtA[i:], m.ExcessDestination)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.ExcessDestination)))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
 	}
 	{
-		size := m.GoalBonded.Size()
+		size := m.BelowGoalStakingShare.Size()
 		i -= size
-		if _, err := m.GoalBonded.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.BelowGoalStakingShare.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintParams(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	if m.BondedAdjustmentEnabled {
+		i--
+		if m.BondedAdjustmentEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
 	{
-		size := m.SubsidyHalvingInterval.Size()
+		size := m.InflationMin.Size()
 		i -= size
-		if _, err := m.SubsidyHalvingInterval.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.InflationMin.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	{
+		size := m.InflationMax.Size()
+		i -= size
+		if _, err := m.InflationMax.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i = encodeVarintParams(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.MintDenom) > 0 {
-		i -= len(m.MintDenom)
-		copy(dAtA[i:], m.MintDenom)
-		i = encodeVarintParams(dAtA, i, uint64(len(m.MintDenom)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
-	offset -= sovParams(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Minter) Size() (n int) {
-	if m == nil {
-		return 0
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	{
+		size := m.InflationRateChange.Size()
+		i -= size
+		if _, err := m.InflationRateChange.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	if len(m.MaxSupply) > 0 {
+		for iNdEx := len(m.MaxSupply) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxSupply[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.MaxBlockTimeDeltaSeconds != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxBlockTimeDeltaSeconds))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.MinBlockTimeDeltaSeconds != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinBlockTimeDeltaSeconds))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.ProvisionScale != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ProvisionScale))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.ReferenceWindowSeconds != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ReferenceWindowSeconds))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.DecayFactor.Size()
+		i -= size
+		if _, err := m.DecayFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.DecayStartHeight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DecayStartHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.HeightOffset != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.HeightOffset))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BlocksPerMinute != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BlocksPerMinute))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BlocksPerYear != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BlocksPerYear))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.GoalBonded.Size()
+		i -= size
+		if _, err := m.GoalBonded.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.SubsidyHalvingInterval.Size()
+		i -= size
+		if _, err := m.SubsidyHalvingInterval.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MintDenom) > 0 {
+		i -= len(m.MintDenom)
+		copy(dAtA[i:], m.MintDenom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.MintDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VestingPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VestingPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VestingPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Escrow {
+		i--
+		if m.Escrow {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.CliffSeconds != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CliffSeconds))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PeriodCount != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PeriodCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DurationSeconds != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DurationSeconds))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DistributionDestination) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DistributionDestination) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DistributionDestination) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledParamsChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledParamsChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledParamsChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.ActivationHeight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Minter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SubsidyHalvingInterval.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.BlockProvisionMinted) > 0 {
+		for _, e := range m.BlockProvisionMinted {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.HedgehogMinted) > 0 {
+		for _, e := range m.HedgehogMinted {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.LastMintHeight != 0 {
+		n += 1 + sovParams(uint64(m.LastMintHeight))
+	}
+	if len(m.BlockSubsidy) > 0 {
+		for _, e := range m.BlockSubsidy {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.Inflation.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.AnnualProvisions.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.ObservedBlockTimeSeconds != 0 {
+		n += 1 + sovParams(uint64(m.ObservedBlockTimeSeconds))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MintDenom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.SubsidyHalvingInterval.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.GoalBonded.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.BlocksPerYear != 0 {
+		n += 1 + sovParams(uint64(m.BlocksPerYear))
+	}
+	if m.BlocksPerMinute != 0 {
+		n += 1 + sovParams(uint64(m.BlocksPerMinute))
+	}
+	if m.HeightOffset != 0 {
+		n += 1 + sovParams(uint64(m.HeightOffset))
+	}
+	if m.DecayStartHeight != 0 {
+		n += 1 + sovParams(uint64(m.DecayStartHeight))
+	}
+	l = m.DecayFactor.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.ReferenceWindowSeconds != 0 {
+		n += 1 + sovParams(uint64(m.ReferenceWindowSeconds))
+	}
+	if m.ProvisionScale != 0 {
+		n += 1 + sovParams(uint64(m.ProvisionScale))
+	}
+	if m.MinBlockTimeDeltaSeconds != 0 {
+		n += 1 + sovParams(uint64(m.MinBlockTimeDeltaSeconds))
+	}
+	if m.MaxBlockTimeDeltaSeconds != 0 {
+		n += 1 + sovParams(uint64(m.MaxBlockTimeDeltaSeconds))
+	}
+	if len(m.MaxSupply) > 0 {
+		for _, e := range m.MaxSupply {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.InflationRateChange.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.InflationMax.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.InflationMin.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.BondedAdjustmentEnabled {
+		n += 3
+	}
+	l = m.BelowGoalStakingShare.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = len(m.ExcessDestination)
+	if l > 0 {
+		n += 2 + l + sovParams(uint64(l))
+	}
+	if len(m.DistributionSplit) > 0 {
+		for _, e := range m.DistributionSplit {
+			l = e.Size()
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.VestingPolicy.Size()
+	n += 2 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *VestingPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.DurationSeconds != 0 {
+		n += 1 + sovParams(uint64(m.DurationSeconds))
+	}
+	if m.PeriodCount != 0 {
+		n += 1 + sovParams(uint64(m.PeriodCount))
+	}
+	if m.CliffSeconds != 0 {
+		n += 1 + sovParams(uint64(m.CliffSeconds))
+	}
+	if m.Escrow {
+		n += 2
+	}
+	return n
+}
+
+func (m *DistributionDestination) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *ScheduledParamsChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ActivationHeight != 0 {
+		n += 1 + sovParams(uint64(m.ActivationHeight))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Minter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Minter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Minter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubsidyHalvingInterval", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SubsidyHalvingInterval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockProvisionMinted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockProvisionMinted = append(m.BlockProvisionMinted, types.Coin{})
+			if err := m.BlockProvisionMinted[len(m.BlockProvisionMinted)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HedgehogMinted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HedgehogMinted = append(m.HedgehogMinted, types.Coin{})
+			if err := m.HedgehogMinted[len(m.HedgehogMinted)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastMintHeight", wireType)
+			}
+			m.LastMintHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastMintHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockSubsidy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockSubsidy = append(m.BlockSubsidy, types.Coin{})
+			if err := m.BlockSubsidy[len(m.BlockSubsidy)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedBlockTimeSeconds", wireType)
+			}
+			m.ObservedBlockTimeSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObservedBlockTimeSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubsidyHalvingInterval", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SubsidyHalvingInterval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoalBonded", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GoalBonded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerYear", wireType)
+			}
+			m.BlocksPerYear = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksPerYear |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerMinute", wireType)
+			}
+			m.BlocksPerMinute = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksPerMinute |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeightOffset", wireType)
+			}
+			m.HeightOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeightOffset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayStartHeight", wireType)
+			}
+			m.DecayStartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecayStartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DecayFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceWindowSeconds", wireType)
+			}
+			m.ReferenceWindowSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReferenceWindowSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProvisionScale", wireType)
+			}
+			m.ProvisionScale = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProvisionScale |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBlockTimeDeltaSeconds", wireType)
+			}
+			m.MinBlockTimeDeltaSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinBlockTimeDeltaSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlockTimeDeltaSeconds", wireType)
+			}
+			m.MaxBlockTimeDeltaSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBlockTimeDeltaSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxSupply = append(m.MaxSupply, types.Coin{})
+			if err := m.MaxSupply[len(m.MaxSupply)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationRateChange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationRateChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationMax", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationMin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondedAdjustmentEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BondedAdjustmentEnabled = bool(v != 0)
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BelowGoalStakingShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BelowGoalStakingShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcessDestination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExcessDestination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionSplit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributionSplit = append(m.DistributionSplit, DistributionDestination{})
+			if err := m.DistributionSplit[len(m.DistributionSplit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VestingPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = m.SubsidyHalvingInterval.Size()
-	n += 1 + l + sovParams(uint64(l))
-	return n
-}
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.MintDenom)
-	if l > 0 {
-		n += 1 + l + sovParams(uint64(l))
-	}
-	l = m.SubsidyHalvingInterval.Size()
-	n += 1 + l + sovParams(uint64(l))
-	l = m.GoalBonded.Size()
-	n += 1 + l + sovParams(uint64(l))
-	if m.BlocksPerYear != 0 {
-		n += 1 + sovParams(uint64(m.BlocksPerYear))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovParams(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozParams(x uint64) (n int) {
-	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Minter) Unmarshal(dAtA []byte) error {
+func (m *VestingPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -320,15 +2166,15 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Minter: wiretype end group for non-group")
+			return fmt.Errorf("proto: VestingPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Minter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VestingPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubsidyHalvingInterval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -356,10 +2202,85 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SubsidyHalvingInterval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationSeconds", wireType)
+			}
+			m.DurationSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DurationSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodCount", wireType)
+			}
+			m.PeriodCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CliffSeconds", wireType)
+			}
+			m.CliffSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CliffSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrow", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Escrow = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -381,7 +2302,7 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *DistributionDestination) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -404,15 +2325,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: DistributionDestination: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DistributionDestination: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -440,11 +2361,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MintDenom = string(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubsidyHalvingInterval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -472,13 +2393,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SubsidyHalvingInterval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GoalBonded", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -506,15 +2425,65 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GoalBonded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledParamsChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledParamsChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledParamsChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerYear", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
 			}
-			m.BlocksPerYear = 0
+			m.ActivationHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -524,11 +2493,44 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlocksPerYear |= uint64(b&0x7F) << shift
+				m.ActivationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
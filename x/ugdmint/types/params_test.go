@@ -0,0 +1,72 @@
+package types
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+)
+
+func TestParamsValidate(t *testing.T) {
+	if err := DefaultParams().Validate(); err != nil {
+		t.Fatalf("default params are invalid: %v", err)
+	}
+
+	invalid := map[string]func(p *Params){
+		"zero blocks per minute":     func(p *Params) { p.BlocksPerMinute = 0 },
+		"nil decay factor":           func(p *Params) { p.DecayFactor = math.LegacyDec{} },
+		"zero decay factor":          func(p *Params) { p.DecayFactor = math.LegacyZeroDec() },
+		"negative decay factor":      func(p *Params) { p.DecayFactor = math.LegacyNewDecWithPrec(-99, 2) },
+		"growing decay factor":       func(p *Params) { p.DecayFactor = math.LegacyNewDecWithPrec(101, 2) },
+		"zero reference window":      func(p *Params) { p.ReferenceWindowSeconds = 0 },
+		"zero provision scale":       func(p *Params) { p.ProvisionScale = 0 },
+		"zero max block time":        func(p *Params) { p.MinBlockTimeDeltaSeconds, p.MaxBlockTimeDeltaSeconds = 0, 0 },
+		"min above max":              func(p *Params) { p.MinBlockTimeDeltaSeconds = p.MaxBlockTimeDeltaSeconds + 1 },
+		"nil inflation max":          func(p *Params) { p.InflationMax = math.LegacyDec{} },
+		"negative rate change":       func(p *Params) { p.InflationRateChange = math.LegacyNewDecWithPrec(-1, 2) },
+		"inflation min above max":    func(p *Params) { p.InflationMin = p.InflationMax.Add(math.LegacyNewDecWithPrec(1, 2)) },
+		"nil staking share":          func(p *Params) { p.BelowGoalStakingShare = math.LegacyDec{} },
+		"staking share above one":    func(p *Params) { p.BelowGoalStakingShare = math.LegacyNewDecWithPrec(101, 2) },
+		"unknown excess destination": func(p *Params) { p.ExcessDestination = "validators" },
+		"empty excess destination":   func(p *Params) { p.ExcessDestination = "" },
+		"empty distribution split":   func(p *Params) { p.DistributionSplit = nil },
+		"split below one": func(p *Params) {
+			p.DistributionSplit = []DistributionDestination{{Kind: DestinationFeeCollector, Weight: math.LegacyNewDecWithPrec(9, 1)}}
+		},
+		"split above one": func(p *Params) {
+			p.DistributionSplit = append(p.DistributionSplit, DistributionDestination{Kind: DestinationBurn, Weight: math.LegacyNewDecWithPrec(1, 1)})
+		},
+		"duplicate destination": func(p *Params) {
+			p.DistributionSplit = []DistributionDestination{
+				{Kind: DestinationFeeCollector, Weight: math.LegacyNewDecWithPrec(5, 1)},
+				{Kind: DestinationFeeCollector, Weight: math.LegacyNewDecWithPrec(5, 1)},
+			}
+		},
+		"unknown destination": func(p *Params) {
+			p.DistributionSplit = []DistributionDestination{{Kind: "validators", Weight: math.LegacyOneDec()}}
+		},
+		"unnamed module account": func(p *Params) {
+			p.DistributionSplit = []DistributionDestination{{Kind: DestinationModuleAccount, Weight: math.LegacyOneDec()}}
+		},
+		"invalid address": func(p *Params) {
+			p.DistributionSplit = []DistributionDestination{{Kind: DestinationAddress, Recipient: "unigrid1", Weight: math.LegacyOneDec()}}
+		},
+		"recipient of the fee collector": func(p *Params) {
+			p.DistributionSplit = []DistributionDestination{{Kind: DestinationFeeCollector, Recipient: "gridnode", Weight: math.LegacyOneDec()}}
+		},
+		"zero weight": func(p *Params) {
+			p.DistributionSplit = append(p.DistributionSplit, DistributionDestination{Kind: DestinationBurn, Weight: math.LegacyZeroDec()})
+		},
+		"unknown vesting policy":   func(p *Params) { p.VestingPolicy.Kind = "linear" },
+		"vesting without duration": func(p *Params) { p.VestingPolicy.DurationSeconds = 0 },
+	}
+
+	for name, modify := range invalid {
+		t.Run(name, func(t *testing.T) {
+			params := DefaultParams()
+			modify(&params)
+			if err := params.Validate(); err == nil {
+				t.Errorf("expected params to be invalid: %s", params)
+			}
+		})
+	}
+}
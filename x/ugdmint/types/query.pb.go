@@ -7,23 +7,31 @@ import (
 	context "context"
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -272,468 +280,6545 @@ func (m *QueryAllMintRecordsResponse) GetMintRecords() []MintRecord {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.ugdmint.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.ugdmint.v1beta1.QueryParamsResponse")
-	proto.RegisterType((*QuerySubsidyHalvingIntervalRequest)(nil), "cosmos.ugdmint.v1beta1.QuerySubsidyHalvingIntervalRequest")
-	proto.RegisterType((*QuerySubsidyHalvingIntervalResponse)(nil), "cosmos.ugdmint.v1beta1.QuerySubsidyHalvingIntervalResponse")
-	proto.RegisterType((*QueryAllMintRecordsRequest)(nil), "cosmos.ugdmint.v1beta1.QueryAllMintRecordsRequest")
-	proto.RegisterType((*QueryAllMintRecordsResponse)(nil), "cosmos.ugdmint.v1beta1.QueryAllMintRecordsResponse")
+// QueryProcessedMintsRequest is request type for the Query/ProcessedMints RPC method.
+type QueryProcessedMintsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("cosmos/ugdmint/v1beta1/query.proto", fileDescriptor_a0ce5c9676755241)
+func (m *QueryProcessedMintsRequest) Reset()         { *m = QueryProcessedMintsRequest{} }
+func (m *QueryProcessedMintsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProcessedMintsRequest) ProtoMessage()    {}
+func (*QueryProcessedMintsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0ce5c9676755241, []int{6}
 }
-
-var fileDescriptor_a0ce5c9676755241 = []byte{
-	// 527 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x4f, 0x6b, 0xd4, 0x40,
-	0x18, 0xc6, 0x77, 0xb4, 0x2e, 0x38, 0x5b, 0x04, 0xc7, 0xb2, 0x94, 0xb4, 0xa4, 0x25, 0x2d, 0x65,
-	0xa9, 0x36, 0x63, 0xb7, 0x17, 0xff, 0x9c, 0xba, 0x78, 0x50, 0x54, 0xd0, 0xed, 0x45, 0xbc, 0x2c,
-	0xb3, 0xd9, 0x21, 0x3b, 0x35, 0x99, 0x49, 0x33, 0x93, 0xc5, 0xbd, 0x7a, 0x2b, 0x5e, 0x04, 0x3f,
-	0x84, 0x1e, 0xfd, 0x18, 0x05, 0x2f, 0x05, 0x2f, 0xe2, 0xa1, 0xc8, 0xae, 0xe0, 0xd7, 0x90, 0xcc,
-	0xcc, 0xb6, 0x16, 0x93, 0xa8, 0xbd, 0x84, 0x64, 0xe6, 0x79, 0x9f, 0xe7, 0x97, 0x77, 0xde, 0x04,
-	0x7a, 0x81, 0x90, 0xb1, 0x90, 0x38, 0x0b, 0x07, 0x31, 0xe3, 0x0a, 0x8f, 0xb6, 0xfb, 0x54, 0x91,
-	0x6d, 0x7c, 0x90, 0xd1, 0x74, 0xec, 0x27, 0xa9, 0x50, 0x02, 0x35, 0x8d, 0xc6, 0xb7, 0x1a, 0xdf,
-	0x6a, 0x9c, 0x85, 0x50, 0x84, 0x42, 0x4b, 0x70, 0x7e, 0x67, 0xd4, 0xce, 0x72, 0x28, 0x44, 0x18,
-	0x51, 0x4c, 0x12, 0x86, 0x09, 0xe7, 0x42, 0x11, 0xc5, 0x04, 0x97, 0x76, 0x77, 0xad, 0x24, 0x2f,
-	0x21, 0x29, 0x89, 0x67, 0xa2, 0xeb, 0x24, 0x66, 0x5c, 0x60, 0x7d, 0xb5, 0x4b, 0xad, 0x92, 0xba,
-	0xfc, 0xa1, 0x97, 0xd2, 0x40, 0xa4, 0x03, 0xa3, 0xf4, 0x16, 0x20, 0x7a, 0x9e, 0xc3, 0x3f, 0xd3,
-	0x8e, 0x5d, 0x7a, 0x90, 0x51, 0xa9, 0xbc, 0x17, 0xf0, 0xc6, 0xb9, 0x55, 0x99, 0x08, 0x2e, 0x29,
-	0xda, 0x85, 0x75, 0x93, 0xbc, 0x08, 0x56, 0x41, 0xab, 0xd1, 0x76, 0xfd, 0xe2, 0x77, 0xf5, 0x4d,
-	0x5d, 0xe7, 0xea, 0xd1, 0xc9, 0x4a, 0xed, 0xe3, 0xcf, 0x4f, 0x9b, 0xa0, 0x6b, 0x0b, 0xbd, 0x75,
-	0xe8, 0x69, 0xe7, 0xbd, 0xac, 0x2f, 0xd9, 0x60, 0xfc, 0x90, 0x44, 0x23, 0xc6, 0xc3, 0x47, 0x5c,
-	0xd1, 0x74, 0x44, 0xa2, 0x59, 0xfe, 0x21, 0x80, 0x6b, 0x95, 0x32, 0x0b, 0xd4, 0x87, 0x8b, 0xd2,
-	0x28, 0x7a, 0x43, 0x23, 0xe9, 0x31, 0xab, 0xd1, 0x88, 0xf3, 0x9d, 0x56, 0x8e, 0xf0, 0xed, 0x64,
-	0x65, 0xc9, 0x90, 0xca, 0xc1, 0x2b, 0x9f, 0x09, 0x1c, 0x13, 0x35, 0xf4, 0x9f, 0xd0, 0x90, 0x04,
-	0xe3, 0x07, 0x34, 0x30, 0x84, 0x4d, 0x59, 0x98, 0xe5, 0x2d, 0x43, 0x47, 0xa3, 0xec, 0x46, 0xd1,
-	0x53, 0xc6, 0x55, 0x57, 0x77, 0xef, 0xb4, 0x53, 0xfb, 0x70, 0xa9, 0x70, 0xd7, 0x02, 0x3e, 0x86,
-	0xf3, 0xbf, 0xf5, 0x3c, 0xef, 0xdb, 0xe5, 0x56, 0xa3, 0xed, 0x95, 0xf5, 0xed, 0xcc, 0xa2, 0x33,
-	0x97, 0x83, 0x77, 0x1b, 0xf1, 0x99, 0x69, 0xfb, 0xed, 0x1c, 0xbc, 0xa2, 0xc3, 0xd0, 0x21, 0x80,
-	0x75, 0xd3, 0x63, 0xb4, 0x59, 0xe6, 0xf5, 0xe7, 0xb1, 0x3a, 0x37, 0xff, 0x49, 0x6b, 0xd0, 0xbd,
-	0x8d, 0x37, 0x5f, 0x7e, 0xbc, 0xbf, 0xb4, 0x8a, 0x5c, 0x5c, 0x39, 0x84, 0xe8, 0x33, 0x80, 0xcd,
-	0xe2, 0x63, 0x42, 0xf7, 0x2a, 0xf3, 0x2a, 0x47, 0xc0, 0xb9, 0x7f, 0xa1, 0x5a, 0xcb, 0x7e, 0x47,
-	0xb3, 0xb7, 0xd1, 0xed, 0x32, 0xf6, 0xb2, 0xa9, 0x41, 0x1f, 0x00, 0xbc, 0x76, 0xfe, 0x2c, 0x51,
-	0xbb, 0x92, 0xa4, 0x70, 0x2c, 0x9c, 0x9d, 0xff, 0xaa, 0xb1, 0xd4, 0xb7, 0x34, 0xf5, 0x06, 0x5a,
-	0xc7, 0x7f, 0xff, 0x7c, 0x65, 0x67, 0xef, 0x68, 0xe2, 0x82, 0xe3, 0x89, 0x0b, 0xbe, 0x4f, 0x5c,
-	0xf0, 0x6e, 0xea, 0xd6, 0x8e, 0xa7, 0x6e, 0xed, 0xeb, 0xd4, 0xad, 0xbd, 0xbc, 0x1b, 0x32, 0x35,
-	0xcc, 0xfa, 0x7e, 0x20, 0x62, 0x9c, 0x71, 0x16, 0xa6, 0x6c, 0xb0, 0x95, 0xa4, 0x62, 0x9f, 0x06,
-	0xca, 0x3a, 0x6f, 0xcd, 0x9c, 0x5f, 0x9f, 0x66, 0xa8, 0x71, 0x42, 0x65, 0xbf, 0xae, 0xff, 0x0a,
-	0x3b, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0xde, 0xf2, 0x34, 0xc8, 0xe9, 0x04, 0x00, 0x00,
+func (m *QueryProcessedMintsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Subsidy halving interval
-	SubsidyHalvingInterval(ctx context.Context, in *QuerySubsidyHalvingIntervalRequest, opts ...grpc.CallOption) (*QuerySubsidyHalvingIntervalResponse, error)
-	// AllMintRecords queries all mint records stored by the module.
-	AllMintRecords(ctx context.Context, in *QueryAllMintRecordsRequest, opts ...grpc.CallOption) (*QueryAllMintRecordsResponse, error)
+func (m *QueryProcessedMintsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProcessedMintsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryProcessedMintsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProcessedMintsRequest.Merge(m, src)
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryProcessedMintsRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.ugdmint.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryProcessedMintsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProcessedMintsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) SubsidyHalvingInterval(ctx context.Context, in *QuerySubsidyHalvingIntervalRequest, opts ...grpc.CallOption) (*QuerySubsidyHalvingIntervalResponse, error) {
-	out := new(QuerySubsidyHalvingIntervalResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.ugdmint.v1beta1.Query/SubsidyHalvingInterval", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_QueryProcessedMintsRequest proto.InternalMessageInfo
 
-func (c *queryClient) AllMintRecords(ctx context.Context, in *QueryAllMintRecordsRequest, opts ...grpc.CallOption) (*QueryAllMintRecordsResponse, error) {
-	out := new(QueryAllMintRecordsResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.ugdmint.v1beta1.Query/AllMintRecords", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryProcessedMintsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Subsidy halving interval
-	SubsidyHalvingInterval(context.Context, *QuerySubsidyHalvingIntervalRequest) (*QuerySubsidyHalvingIntervalResponse, error)
-	// AllMintRecords queries all mint records stored by the module.
-	AllMintRecords(context.Context, *QueryAllMintRecordsRequest) (*QueryAllMintRecordsResponse, error)
+// QueryProcessedMintsResponse is response type for the Query/ProcessedMints RPC method.
+type QueryProcessedMintsResponse struct {
+	// processed_mints holds the Hedgehog mints that have been paid out.
+	ProcessedMints []ProcessedMint `protobuf:"bytes,1,rep,name=processed_mints,json=processedMints,proto3" json:"processed_mints"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryProcessedMintsResponse) Reset()         { *m = QueryProcessedMintsResponse{} }
+func (m *QueryProcessedMintsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProcessedMintsResponse) ProtoMessage()    {}
+func (*QueryProcessedMintsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0ce5c9676755241, []int{7}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryProcessedMintsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) SubsidyHalvingInterval(ctx context.Context, req *QuerySubsidyHalvingIntervalRequest) (*QuerySubsidyHalvingIntervalResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubsidyHalvingInterval not implemented")
+func (m *QueryProcessedMintsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProcessedMintsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) AllMintRecords(ctx context.Context, req *QueryAllMintRecordsRequest) (*QueryAllMintRecordsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllMintRecords not implemented")
+func (m *QueryProcessedMintsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProcessedMintsResponse.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryProcessedMintsResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.ugdmint.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryProcessedMintsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProcessedMintsResponse.DiscardUnknown(m)
 }
 
-func _Query_SubsidyHalvingInterval_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySubsidyHalvingIntervalRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).SubsidyHalvingInterval(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.ugdmint.v1beta1.Query/SubsidyHalvingInterval",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SubsidyHalvingInterval(ctx, req.(*QuerySubsidyHalvingIntervalRequest))
+var xxx_messageInfo_QueryProcessedMintsResponse proto.InternalMessageInfo
+
+func (m *QueryProcessedMintsResponse) GetProcessedMints() []ProcessedMint {
+	if m != nil {
+		return m.ProcessedMints
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_AllMintRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllMintRecordsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AllMintRecords(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.ugdmint.v1beta1.Query/AllMintRecords",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllMintRecords(ctx, req.(*QueryAllMintRecordsRequest))
+func (m *QueryProcessedMintsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cosmos.ugdmint.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "SubsidyHalvingInterval",
-			Handler:    _Query_SubsidyHalvingInterval_Handler,
-		},
-		{
-			MethodName: "AllMintRecords",
-			Handler:    _Query_AllMintRecords_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "cosmos/ugdmint/v1beta1/query.proto",
+// QueryPreviousBlockTimeRequest is request type for the Query/PreviousBlockTime RPC method.
+type QueryPreviousBlockTimeRequest struct {
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPreviousBlockTimeRequest) Reset()         { *m = QueryPreviousBlockTimeRequest{} }
+func (m *QueryPreviousBlockTimeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPreviousBlockTimeRequest) ProtoMessage()    {}
+func (*QueryPreviousBlockTimeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0ce5c9676755241, []int{8}
+}
+func (m *QueryPreviousBlockTimeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPreviousBlockTimeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPreviousBlockTimeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPreviousBlockTimeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPreviousBlockTimeRequest.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryPreviousBlockTimeRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryPreviousBlockTimeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPreviousBlockTimeRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryPreviousBlockTimeRequest proto.InternalMessageInfo
+
+// QueryPreviousBlockTimeResponse is response type for the Query/PreviousBlockTime RPC method.
+type QueryPreviousBlockTimeResponse struct {
+	// previous_block_time is unset until the module has seen its first block.
+	PreviousBlockTime *time.Time `protobuf:"bytes,1,opt,name=previous_block_time,json=previousBlockTime,proto3,stdtime" json:"previous_block_time,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryPreviousBlockTimeResponse) Reset()         { *m = QueryPreviousBlockTimeResponse{} }
+func (m *QueryPreviousBlockTimeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPreviousBlockTimeResponse) ProtoMessage()    {}
+func (*QueryPreviousBlockTimeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0ce5c9676755241, []int{9}
+}
+func (m *QueryPreviousBlockTimeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPreviousBlockTimeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPreviousBlockTimeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryPreviousBlockTimeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPreviousBlockTimeResponse.Merge(m, src)
+}
+func (m *QueryPreviousBlockTimeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPreviousBlockTimeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPreviousBlockTimeResponse.DiscardUnknown(m)
 }
 
-func (m *QuerySubsidyHalvingIntervalRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPreviousBlockTimeResponse proto.InternalMessageInfo
+
+func (m *QueryPreviousBlockTimeResponse) GetPreviousBlockTime() *time.Time {
+	if m != nil {
+		return m.PreviousBlockTime
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QuerySubsidyHalvingIntervalRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryMinterRequest is request type for the Query/Minter RPC method.
+type QueryMinterRequest struct {
 }
 
-func (m *QuerySubsidyHalvingIntervalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryMinterRequest) Reset()         { *m = QueryMinterRequest{} }
+func (m *QueryMinterRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMinterRequest) ProtoMessage()    {}
+func (*QueryMinterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0ce5c9676755241, []int{10}
+}
+func (m *QueryMinterRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMinterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMinterRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMinterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMinterRequest.Merge(m, src)
+}
+func (m *QueryMinterRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMinterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMinterRequest.DiscardUnknown(m)
 }
 
-func (m *QuerySubsidyHalvingIntervalResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryMinterRequest proto.InternalMessageInfo
+
+// QueryMinterResponse is response type for the Query/Minter RPC method.
+type QueryMinterResponse struct {
+	// minter holds the minting state.
+	Minter Minter `protobuf:"bytes,1,opt,name=minter,proto3" json:"minter"`
+}
+
+func (m *QueryMinterResponse) Reset()         { *m = QueryMinterResponse{} }
+func (m *QueryMinterResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMinterResponse) ProtoMessage()    {}
+func (*QueryMinterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0ce5c9676755241, []int{11}
+}
+func (m *QueryMinterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMinterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMinterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryMinterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMinterResponse.Merge(m, src)
+}
+func (m *QueryMinterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMinterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMinterResponse.DiscardUnknown(m)
 }
 
-func (m *QuerySubsidyHalvingIntervalResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryMinterResponse proto.InternalMessageInfo
+
+func (m *QueryMinterResponse) GetMinter() Minter {
+	if m != nil {
+		return m.Minter
+	}
+	return Minter{}
 }
 
-func (m *QuerySubsidyHalvingIntervalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.SubsidyHalvingInterval.Size()
-		i -= size
-		if _, err := m.SubsidyHalvingInterval.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+// QuerySupplyHeadroomRequest is request type for the Query/SupplyHeadroom RPC method.
+type QuerySupplyHeadroomRequest struct {
+}
+
+func (m *QuerySupplyHeadroomRequest) Reset()         { *m = QuerySupplyHeadroomRequest{} }
+func (m *QuerySupplyHeadroomRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySupplyHeadroomRequest) ProtoMessage()    {}
+func (*QuerySupplyHeadroomRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0ce5c9676755241, []int{12}
+}
+func (m *QuerySupplyHeadroomRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySupplyHeadroomRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySupplyHeadroomRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QuerySupplyHeadroomRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySupplyHeadroomRequest.Merge(m, src)
+}
+func (m *QuerySupplyHeadroomRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySupplyHeadroomRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySupplyHeadroomRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllMintRecordsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySupplyHeadroomRequest proto.InternalMessageInfo
+
+// QuerySupplyHeadroomResponse is response type for the Query/SupplyHeadroom RPC method.
+type QuerySupplyHeadroomResponse struct {
+	// max_supply is the supply cap of every capped denom.
+	MaxSupply github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=max_supply,json=maxSupply,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_supply"`
+	// total_minted is the amount of every denom the module has minted so far.
+	TotalMinted github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=total_minted,json=totalMinted,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_minted"`
+	// headroom is the amount of every capped denom that may still be minted. A
+	// denom whose cap has been reached is listed with a zero amount.
+	Headroom github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=headroom,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"headroom"`
+}
+
+func (m *QuerySupplyHeadroomResponse) Reset()         { *m = QuerySupplyHeadroomResponse{} }
+func (m *QuerySupplyHeadroomResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySupplyHeadroomResponse) ProtoMessage()    {}
+func (*QuerySupplyHeadroomResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0ce5c9676755241, []int{13}
+}
+func (m *QuerySupplyHeadroomResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySupplyHeadroomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySupplyHeadroomResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QuerySupplyHeadroomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySupplyHeadroomResponse.Merge(m, src)
+}
+func (m *QuerySupplyHeadroomResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySupplyHeadroomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySupplyHeadroomResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllMintRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QuerySupplyHeadroomResponse proto.InternalMessageInfo
+
+func (m *QuerySupplyHeadroomResponse) GetMaxSupply() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MaxSupply
+	}
+	return nil
 }
 
-func (m *QueryAllMintRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QuerySupplyHeadroomResponse) GetTotalMinted() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalMinted
+	}
+	return nil
 }
 
-func (m *QueryAllMintRecordsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySupplyHeadroomResponse) GetHeadroom() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Headroom
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllMintRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryEmissionProjectionRequest is request type for the Query/EmissionProjection RPC method.
+// The range is given either by heights or by times, which are converted to
+// heights using the assumed block time.
+type QueryEmissionProjectionRequest struct {
+	// start_height is the first projected height, it defaults to the next block.
+	StartHeight uint64 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height is the last projected height.
+	EndHeight uint64 `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// start_time projects from the first block produced at or after it.
+	StartTime *time.Time `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time,omitempty"`
+	// end_time projects up to the last block produced at or before it.
+	EndTime *time.Time `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty"`
+	// block_time_seconds is the assumed time between two blocks, it defaults to
+	// the reference window divided by the blocks per minute.
+	BlockTimeSeconds uint64 `protobuf:"varint,5,opt,name=block_time_seconds,json=blockTimeSeconds,proto3" json:"block_time_seconds,omitempty"`
 }
 
-func (m *QueryAllMintRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MintRecords) > 0 {
-		for iNdEx := len(m.MintRecords) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MintRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryEmissionProjectionRequest) Reset()         { *m = QueryEmissionProjectionRequest{} }
+func (m *QueryEmissionProjectionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEmissionProjectionRequest) ProtoMessage()    {}
+func (*QueryEmissionProjectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0ce5c9676755241, []int{14}
+}
+func (m *QueryEmissionProjectionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEmissionProjectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEmissionProjectionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryEmissionProjectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEmissionProjectionRequest.Merge(m, src)
+}
+func (m *QueryEmissionProjectionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEmissionProjectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEmissionProjectionRequest.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryEmissionProjectionRequest proto.InternalMessageInfo
+
+func (m *QueryEmissionProjectionRequest) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryEmissionProjectionRequest) GetEndHeight() uint64 {
+	if m != nil {
+		return m.EndHeight
 	}
-	var l int
-	_ = l
-	return n
+	return 0
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryEmissionProjectionRequest) GetStartTime() *time.Time {
+	if m != nil {
+		return m.StartTime
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QuerySubsidyHalvingIntervalRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryEmissionProjectionRequest) GetEndTime() *time.Time {
+	if m != nil {
+		return m.EndTime
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *QuerySubsidyHalvingIntervalResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryEmissionProjectionRequest) GetBlockTimeSeconds() uint64 {
+	if m != nil {
+		return m.BlockTimeSeconds
 	}
-	var l int
-	_ = l
-	l = m.SubsidyHalvingInterval.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return 0
 }
 
-func (m *QueryAllMintRecordsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+// QueryEmissionProjectionResponse is response type for the Query/EmissionProjection RPC method.
+type QueryEmissionProjectionResponse struct {
+	// current_height is the height the projection starts from.
+	CurrentHeight uint64 `protobuf:"varint,1,opt,name=current_height,json=currentHeight,proto3" json:"current_height,omitempty"`
+	// current_supply is the supply of the mint denom at the current height.
+	CurrentSupply types.Coin `protobuf:"bytes,2,opt,name=current_supply,json=currentSupply,proto3" json:"current_supply"`
+	// block_time_seconds is the block time the projection assumed.
+	BlockTimeSeconds uint64 `protobuf:"varint,3,opt,name=block_time_seconds,json=blockTimeSeconds,proto3" json:"block_time_seconds,omitempty"`
+	// epochs holds the projected emission of every decay epoch in the range.
+	Epochs []EmissionEpoch `protobuf:"bytes,4,rep,name=epochs,proto3" json:"epochs"`
+}
+
+func (m *QueryEmissionProjectionResponse) Reset()         { *m = QueryEmissionProjectionResponse{} }
+func (m *QueryEmissionProjectionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEmissionProjectionResponse) ProtoMessage()    {}
+func (*QueryEmissionProjectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0ce5c9676755241, []int{15}
+}
+func (m *QueryEmissionProjectionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEmissionProjectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEmissionProjectionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *QueryEmissionProjectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEmissionProjectionResponse.Merge(m, src)
+}
+func (m *QueryEmissionProjectionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEmissionProjectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEmissionProjectionResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllMintRecordsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryEmissionProjectionResponse proto.InternalMessageInfo
+
+func (m *QueryEmissionProjectionResponse) GetCurrentHeight() uint64 {
+	if m != nil {
+		return m.CurrentHeight
 	}
-	var l int
-	_ = l
-	if len(m.MintRecords) > 0 {
-		for _, e := range m.MintRecords {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	return 0
+}
+
+func (m *QueryEmissionProjectionResponse) GetCurrentSupply() types.Coin {
+	if m != nil {
+		return m.CurrentSupply
 	}
-	return n
+	return types.Coin{}
+}
+
+func (m *QueryEmissionProjectionResponse) GetBlockTimeSeconds() uint64 {
+	if m != nil {
+		return m.BlockTimeSeconds
+	}
+	return 0
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QueryEmissionProjectionResponse) GetEpochs() []EmissionEpoch {
+	if m != nil {
+		return m.Epochs
+	}
+	return nil
+}
+
+// EmissionEpoch is the projected emission of a range of heights that share
+// the same subsidy decay.
+type EmissionEpoch struct {
+	// start_height is the first height of the epoch.
+	StartHeight uint64 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height is the last height of the epoch.
+	EndHeight uint64 `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// block_provision is the provision of every block of the epoch before the
+	// supply cap is applied.
+	BlockProvision types.Coin `protobuf:"bytes,3,opt,name=block_provision,json=blockProvision,proto3" json:"block_provision"`
+	// provisions is the amount minted over the whole epoch.
+	Provisions types.Coin `protobuf:"bytes,4,opt,name=provisions,proto3" json:"provisions"`
+	// cumulative_supply is the supply of the mint denom at the end of the epoch.
+	CumulativeSupply types.Coin `protobuf:"bytes,5,opt,name=cumulative_supply,json=cumulativeSupply,proto3" json:"cumulative_supply"`
+}
+
+func (m *EmissionEpoch) Reset()         { *m = EmissionEpoch{} }
+func (m *EmissionEpoch) String() string { return proto.CompactTextString(m) }
+func (*EmissionEpoch) ProtoMessage()    {}
+func (*EmissionEpoch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0ce5c9676755241, []int{16}
+}
+func (m *EmissionEpoch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmissionEpoch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EmissionEpoch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EmissionEpoch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmissionEpoch.Merge(m, src)
+}
+func (m *EmissionEpoch) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmissionEpoch) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmissionEpoch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmissionEpoch proto.InternalMessageInfo
+
+func (m *EmissionEpoch) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *EmissionEpoch) GetEndHeight() uint64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *EmissionEpoch) GetBlockProvision() types.Coin {
+	if m != nil {
+		return m.BlockProvision
+	}
+	return types.Coin{}
+}
+
+func (m *EmissionEpoch) GetProvisions() types.Coin {
+	if m != nil {
+		return m.Provisions
+	}
+	return types.Coin{}
+}
+
+func (m *EmissionEpoch) GetCumulativeSupply() types.Coin {
+	if m != nil {
+		return m.CumulativeSupply
+	}
+	return types.Coin{}
+}
+
+// QueryMintingPauseRequest is request type for the Query/MintingPause RPC method.
+type QueryMintingPauseRequest struct {
+}
+
+func (m *QueryMintingPauseRequest) Reset()         { *m = QueryMintingPauseRequest{} }
+func (m *QueryMintingPauseRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMintingPauseRequest) ProtoMessage()    {}
+func (*QueryMintingPauseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0ce5c9676755241, []int{17}
+}
+func (m *QueryMintingPauseRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintingPauseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintingPauseRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMintingPauseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintingPauseRequest.Merge(m, src)
+}
+func (m *QueryMintingPauseRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintingPauseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintingPauseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMintingPauseRequest proto.InternalMessageInfo
+
+// QueryMintingPauseResponse is response type for the Query/MintingPause RPC method.
+type QueryMintingPauseResponse struct {
+	// minting_pause holds the kinds of minting that are paused.
+	MintingPause MintingPause `protobuf:"bytes,1,opt,name=minting_pause,json=mintingPause,proto3" json:"minting_pause"`
+}
+
+func (m *QueryMintingPauseResponse) Reset()         { *m = QueryMintingPauseResponse{} }
+func (m *QueryMintingPauseResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMintingPauseResponse) ProtoMessage()    {}
+func (*QueryMintingPauseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0ce5c9676755241, []int{18}
+}
+func (m *QueryMintingPauseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintingPauseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintingPauseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMintingPauseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintingPauseResponse.Merge(m, src)
+}
+func (m *QueryMintingPauseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintingPauseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintingPauseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMintingPauseResponse proto.InternalMessageInfo
+
+func (m *QueryMintingPauseResponse) GetMintingPause() MintingPause {
+	if m != nil {
+		return m.MintingPause
+	}
+	return MintingPause{}
+}
+
+// QueryScheduledParamsChangesRequest is request type for the Query/ScheduledParamsChanges RPC method.
+type QueryScheduledParamsChangesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryScheduledParamsChangesRequest) Reset()         { *m = QueryScheduledParamsChangesRequest{} }
+func (m *QueryScheduledParamsChangesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledParamsChangesRequest) ProtoMessage()    {}
+func (*QueryScheduledParamsChangesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0ce5c9676755241, []int{19}
+}
+func (m *QueryScheduledParamsChangesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduledParamsChangesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledParamsChangesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScheduledParamsChangesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledParamsChangesRequest.Merge(m, src)
+}
+func (m *QueryScheduledParamsChangesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduledParamsChangesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledParamsChangesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduledParamsChangesRequest proto.InternalMessageInfo
+
+func (m *QueryScheduledParamsChangesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryScheduledParamsChangesResponse is response type for the Query/ScheduledParamsChanges RPC method.
+type QueryScheduledParamsChangesResponse struct {
+	// scheduled_params_changes holds the upcoming params changes.
+	ScheduledParamsChanges []ScheduledParamsChange `protobuf:"bytes,1,rep,name=scheduled_params_changes,json=scheduledParamsChanges,proto3" json:"scheduled_params_changes"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryScheduledParamsChangesResponse) Reset()         { *m = QueryScheduledParamsChangesResponse{} }
+func (m *QueryScheduledParamsChangesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledParamsChangesResponse) ProtoMessage()    {}
+func (*QueryScheduledParamsChangesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0ce5c9676755241, []int{20}
+}
+func (m *QueryScheduledParamsChangesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduledParamsChangesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledParamsChangesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScheduledParamsChangesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledParamsChangesResponse.Merge(m, src)
+}
+func (m *QueryScheduledParamsChangesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduledParamsChangesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledParamsChangesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduledParamsChangesResponse proto.InternalMessageInfo
+
+func (m *QueryScheduledParamsChangesResponse) GetScheduledParamsChanges() []ScheduledParamsChange {
+	if m != nil {
+		return m.ScheduledParamsChanges
+	}
+	return nil
+}
+
+func (m *QueryScheduledParamsChangesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryInflationRequest is request type for the Query/Inflation RPC method.
+type QueryInflationRequest struct {
+}
+
+func (m *QueryInflationRequest) Reset()         { *m = QueryInflationRequest{} }
+func (m *QueryInflationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInflationRequest) ProtoMessage()    {}
+func (*QueryInflationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0ce5c9676755241, []int{21}
+}
+func (m *QueryInflationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInflationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInflationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInflationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInflationRequest.Merge(m, src)
+}
+func (m *QueryInflationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInflationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInflationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInflationRequest proto.InternalMessageInfo
+
+// QueryInflationResponse is response type for the Query/Inflation RPC method.
+type QueryInflationResponse struct {
+	// inflation is the current annual inflation rate of the mint denom.
+	Inflation cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=inflation,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"inflation"`
+}
+
+func (m *QueryInflationResponse) Reset()         { *m = QueryInflationResponse{} }
+func (m *QueryInflationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInflationResponse) ProtoMessage()    {}
+func (*QueryInflationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0ce5c9676755241, []int{22}
+}
+func (m *QueryInflationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInflationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInflationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInflationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInflationResponse.Merge(m, src)
+}
+func (m *QueryInflationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInflationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInflationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInflationResponse proto.InternalMessageInfo
+
+// QueryAnnualProvisionsRequest is request type for the Query/AnnualProvisions RPC method.
+type QueryAnnualProvisionsRequest struct {
+}
+
+func (m *QueryAnnualProvisionsRequest) Reset()         { *m = QueryAnnualProvisionsRequest{} }
+func (m *QueryAnnualProvisionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAnnualProvisionsRequest) ProtoMessage()    {}
+func (*QueryAnnualProvisionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0ce5c9676755241, []int{23}
+}
+func (m *QueryAnnualProvisionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAnnualProvisionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAnnualProvisionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAnnualProvisionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAnnualProvisionsRequest.Merge(m, src)
+}
+func (m *QueryAnnualProvisionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAnnualProvisionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAnnualProvisionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAnnualProvisionsRequest proto.InternalMessageInfo
+
+// QueryAnnualProvisionsResponse is response type for the Query/AnnualProvisions RPC method.
+type QueryAnnualProvisionsResponse struct {
+	// annual_provisions is the current annual provisions of the mint denom.
+	AnnualProvisions cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=annual_provisions,json=annualProvisions,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"annual_provisions"`
+}
+
+func (m *QueryAnnualProvisionsResponse) Reset()         { *m = QueryAnnualProvisionsResponse{} }
+func (m *QueryAnnualProvisionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAnnualProvisionsResponse) ProtoMessage()    {}
+func (*QueryAnnualProvisionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0ce5c9676755241, []int{24}
+}
+func (m *QueryAnnualProvisionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAnnualProvisionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAnnualProvisionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAnnualProvisionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAnnualProvisionsResponse.Merge(m, src)
+}
+func (m *QueryAnnualProvisionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAnnualProvisionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAnnualProvisionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAnnualProvisionsResponse proto.InternalMessageInfo
+
+// QueryClaimableRequest is request type for the Query/Claimable RPC method.
+type QueryClaimableRequest struct {
+	// address is the bech32 address of the recipient.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryClaimableRequest) Reset()         { *m = QueryClaimableRequest{} }
+func (m *QueryClaimableRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimableRequest) ProtoMessage()    {}
+func (*QueryClaimableRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0ce5c9676755241, []int{25}
+}
+func (m *QueryClaimableRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClaimableRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimableRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClaimableRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimableRequest.Merge(m, src)
+}
+func (m *QueryClaimableRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClaimableRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimableRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClaimableRequest proto.InternalMessageInfo
+
+func (m *QueryClaimableRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryClaimableResponse is response type for the Query/Claimable RPC method.
+type QueryClaimableResponse struct {
+	// claimable holds the escrowed coins that have unlocked and can be claimed.
+	Claimable github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=claimable,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"claimable"`
+	// locked holds the escrowed coins that have not unlocked yet.
+	Locked github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=locked,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"locked"`
+	// escrow_schedule holds the escrowed coins of the recipient.
+	EscrowSchedule EscrowSchedule `protobuf:"bytes,3,opt,name=escrow_schedule,json=escrowSchedule,proto3" json:"escrow_schedule"`
+}
+
+func (m *QueryClaimableResponse) Reset()         { *m = QueryClaimableResponse{} }
+func (m *QueryClaimableResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimableResponse) ProtoMessage()    {}
+func (*QueryClaimableResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0ce5c9676755241, []int{26}
+}
+func (m *QueryClaimableResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClaimableResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimableResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClaimableResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimableResponse.Merge(m, src)
+}
+func (m *QueryClaimableResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClaimableResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimableResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClaimableResponse proto.InternalMessageInfo
+
+func (m *QueryClaimableResponse) GetClaimable() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Claimable
+	}
+	return nil
+}
+
+func (m *QueryClaimableResponse) GetLocked() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Locked
+	}
+	return nil
+}
+
+func (m *QueryClaimableResponse) GetEscrowSchedule() EscrowSchedule {
+	if m != nil {
+		return m.EscrowSchedule
+	}
+	return EscrowSchedule{}
+}
+
+// QueryEscrowSchedulesRequest is request type for the Query/EscrowSchedules RPC method.
+type QueryEscrowSchedulesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryEscrowSchedulesRequest) Reset()         { *m = QueryEscrowSchedulesRequest{} }
+func (m *QueryEscrowSchedulesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEscrowSchedulesRequest) ProtoMessage()    {}
+func (*QueryEscrowSchedulesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0ce5c9676755241, []int{27}
+}
+func (m *QueryEscrowSchedulesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEscrowSchedulesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEscrowSchedulesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEscrowSchedulesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEscrowSchedulesRequest.Merge(m, src)
+}
+func (m *QueryEscrowSchedulesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEscrowSchedulesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEscrowSchedulesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEscrowSchedulesRequest proto.InternalMessageInfo
+
+func (m *QueryEscrowSchedulesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryEscrowSchedulesResponse is response type for the Query/EscrowSchedules RPC method.
+type QueryEscrowSchedulesResponse struct {
+	// escrow_schedules holds the escrowed coins of the recipients.
+	EscrowSchedules []EscrowSchedule `protobuf:"bytes,1,rep,name=escrow_schedules,json=escrowSchedules,proto3" json:"escrow_schedules"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryEscrowSchedulesResponse) Reset()         { *m = QueryEscrowSchedulesResponse{} }
+func (m *QueryEscrowSchedulesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEscrowSchedulesResponse) ProtoMessage()    {}
+func (*QueryEscrowSchedulesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0ce5c9676755241, []int{28}
+}
+func (m *QueryEscrowSchedulesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEscrowSchedulesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEscrowSchedulesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEscrowSchedulesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEscrowSchedulesResponse.Merge(m, src)
+}
+func (m *QueryEscrowSchedulesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEscrowSchedulesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEscrowSchedulesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEscrowSchedulesResponse proto.InternalMessageInfo
+
+func (m *QueryEscrowSchedulesResponse) GetEscrowSchedules() []EscrowSchedule {
+	if m != nil {
+		return m.EscrowSchedules
+	}
+	return nil
+}
+
+func (m *QueryEscrowSchedulesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryMintRecipientRequest is request type for the Query/MintRecipient RPC method.
+type QueryMintRecipientRequest struct {
+	// address is the bech32 address of the recipient.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryMintRecipientRequest) Reset()         { *m = QueryMintRecipientRequest{} }
+func (m *QueryMintRecipientRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMintRecipientRequest) ProtoMessage()    {}
+func (*QueryMintRecipientRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0ce5c9676755241, []int{29}
+}
+func (m *QueryMintRecipientRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintRecipientRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintRecipientRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMintRecipientRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintRecipientRequest.Merge(m, src)
+}
+func (m *QueryMintRecipientRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintRecipientRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintRecipientRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMintRecipientRequest proto.InternalMessageInfo
+
+func (m *QueryMintRecipientRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryMintRecipientResponse is response type for the Query/MintRecipient RPC method.
+type QueryMintRecipientResponse struct {
+	// mints holds every Hedgehog mint the address received, by block height.
+	Mints []RecipientMint `protobuf:"bytes,1,rep,name=mints,proto3" json:"mints"`
+	// vested holds the minted coins that have vested at the block time.
+	Vested github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=vested,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"vested"`
+	// unvested holds the minted coins that have not vested at the block time.
+	Unvested github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=unvested,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"unvested"`
+	// clawed_back holds the minted coins that governance has clawed back.
+	ClawedBack github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=clawed_back,json=clawedBack,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"clawed_back"`
+	// unlocks holds the unvested coins by the time they unlock at.
+	Unlocks []EscrowUnlock `protobuf:"bytes,5,rep,name=unlocks,proto3" json:"unlocks"`
+	// next_unlock_time is the unix time the next unvested coins unlock at, zero
+	// when nothing is left vesting.
+	NextUnlockTime int64 `protobuf:"varint,6,opt,name=next_unlock_time,json=nextUnlockTime,proto3" json:"next_unlock_time,omitempty"`
+}
+
+func (m *QueryMintRecipientResponse) Reset()         { *m = QueryMintRecipientResponse{} }
+func (m *QueryMintRecipientResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMintRecipientResponse) ProtoMessage()    {}
+func (*QueryMintRecipientResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0ce5c9676755241, []int{30}
+}
+func (m *QueryMintRecipientResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintRecipientResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintRecipientResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMintRecipientResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintRecipientResponse.Merge(m, src)
+}
+func (m *QueryMintRecipientResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintRecipientResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintRecipientResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMintRecipientResponse proto.InternalMessageInfo
+
+func (m *QueryMintRecipientResponse) GetMints() []RecipientMint {
+	if m != nil {
+		return m.Mints
+	}
+	return nil
+}
+
+func (m *QueryMintRecipientResponse) GetVested() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Vested
+	}
+	return nil
+}
+
+func (m *QueryMintRecipientResponse) GetUnvested() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Unvested
+	}
+	return nil
+}
+
+func (m *QueryMintRecipientResponse) GetClawedBack() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ClawedBack
+	}
+	return nil
+}
+
+func (m *QueryMintRecipientResponse) GetUnlocks() []EscrowUnlock {
+	if m != nil {
+		return m.Unlocks
+	}
+	return nil
+}
+
+func (m *QueryMintRecipientResponse) GetNextUnlockTime() int64 {
+	if m != nil {
+		return m.NextUnlockTime
+	}
+	return 0
+}
+
+// RecipientMint is a Hedgehog mint received by an address along with the
+// vesting applied to it.
+type RecipientMint struct {
+	// height the mint was scheduled for by Hedgehog
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// block height at which the mint was paid out
+	BlockHeight int64 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// coins that were minted
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// timestamp of the Hedgehog payload the mint was taken from
+	Timestamp string `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// vesting of the minted coins, unset for mints paid before it was recorded
+	Vesting *MintVesting `protobuf:"bytes,5,opt,name=vesting,proto3" json:"vesting,omitempty"`
+	// clawback of the unvested coins, unset unless the mint has been clawed back
+	Clawback *MintClawback `protobuf:"bytes,6,opt,name=clawback,proto3" json:"clawback,omitempty"`
+	// minted coins that have vested at the block time
+	Vested github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=vested,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"vested"`
+	// minted coins that have not vested at the block time
+	Unvested github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=unvested,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"unvested"`
+}
+
+func (m *RecipientMint) Reset()         { *m = RecipientMint{} }
+func (m *RecipientMint) String() string { return proto.CompactTextString(m) }
+func (*RecipientMint) ProtoMessage()    {}
+func (*RecipientMint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0ce5c9676755241, []int{31}
+}
+func (m *RecipientMint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecipientMint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecipientMint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecipientMint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecipientMint.Merge(m, src)
+}
+func (m *RecipientMint) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecipientMint) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecipientMint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecipientMint proto.InternalMessageInfo
+
+func (m *RecipientMint) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *RecipientMint) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *RecipientMint) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *RecipientMint) GetTimestamp() string {
+	if m != nil {
+		return m.Timestamp
+	}
+	return ""
+}
+
+func (m *RecipientMint) GetVesting() *MintVesting {
+	if m != nil {
+		return m.Vesting
+	}
+	return nil
+}
+
+func (m *RecipientMint) GetClawback() *MintClawback {
+	if m != nil {
+		return m.Clawback
+	}
+	return nil
+}
+
+func (m *RecipientMint) GetVested() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Vested
+	}
+	return nil
+}
+
+func (m *RecipientMint) GetUnvested() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Unvested
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.ugdmint.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.ugdmint.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QuerySubsidyHalvingIntervalRequest)(nil), "cosmos.ugdmint.v1beta1.QuerySubsidyHalvingIntervalRequest")
+	proto.RegisterType((*QuerySubsidyHalvingIntervalResponse)(nil), "cosmos.ugdmint.v1beta1.QuerySubsidyHalvingIntervalResponse")
+	proto.RegisterType((*QueryAllMintRecordsRequest)(nil), "cosmos.ugdmint.v1beta1.QueryAllMintRecordsRequest")
+	proto.RegisterType((*QueryAllMintRecordsResponse)(nil), "cosmos.ugdmint.v1beta1.QueryAllMintRecordsResponse")
+	proto.RegisterType((*QueryProcessedMintsRequest)(nil), "cosmos.ugdmint.v1beta1.QueryProcessedMintsRequest")
+	proto.RegisterType((*QueryProcessedMintsResponse)(nil), "cosmos.ugdmint.v1beta1.QueryProcessedMintsResponse")
+	proto.RegisterType((*QueryPreviousBlockTimeRequest)(nil), "cosmos.ugdmint.v1beta1.QueryPreviousBlockTimeRequest")
+	proto.RegisterType((*QueryPreviousBlockTimeResponse)(nil), "cosmos.ugdmint.v1beta1.QueryPreviousBlockTimeResponse")
+	proto.RegisterType((*QueryMinterRequest)(nil), "cosmos.ugdmint.v1beta1.QueryMinterRequest")
+	proto.RegisterType((*QueryMinterResponse)(nil), "cosmos.ugdmint.v1beta1.QueryMinterResponse")
+	proto.RegisterType((*QuerySupplyHeadroomRequest)(nil), "cosmos.ugdmint.v1beta1.QuerySupplyHeadroomRequest")
+	proto.RegisterType((*QuerySupplyHeadroomResponse)(nil), "cosmos.ugdmint.v1beta1.QuerySupplyHeadroomResponse")
+	proto.RegisterType((*QueryEmissionProjectionRequest)(nil), "cosmos.ugdmint.v1beta1.QueryEmissionProjectionRequest")
+	proto.RegisterType((*QueryEmissionProjectionResponse)(nil), "cosmos.ugdmint.v1beta1.QueryEmissionProjectionResponse")
+	proto.RegisterType((*EmissionEpoch)(nil), "cosmos.ugdmint.v1beta1.EmissionEpoch")
+	proto.RegisterType((*QueryMintingPauseRequest)(nil), "cosmos.ugdmint.v1beta1.QueryMintingPauseRequest")
+	proto.RegisterType((*QueryMintingPauseResponse)(nil), "cosmos.ugdmint.v1beta1.QueryMintingPauseResponse")
+	proto.RegisterType((*QueryScheduledParamsChangesRequest)(nil), "cosmos.ugdmint.v1beta1.QueryScheduledParamsChangesRequest")
+	proto.RegisterType((*QueryScheduledParamsChangesResponse)(nil), "cosmos.ugdmint.v1beta1.QueryScheduledParamsChangesResponse")
+	proto.RegisterType((*QueryInflationRequest)(nil), "cosmos.ugdmint.v1beta1.QueryInflationRequest")
+	proto.RegisterType((*QueryInflationResponse)(nil), "cosmos.ugdmint.v1beta1.QueryInflationResponse")
+	proto.RegisterType((*QueryAnnualProvisionsRequest)(nil), "cosmos.ugdmint.v1beta1.QueryAnnualProvisionsRequest")
+	proto.RegisterType((*QueryAnnualProvisionsResponse)(nil), "cosmos.ugdmint.v1beta1.QueryAnnualProvisionsResponse")
+	proto.RegisterType((*QueryClaimableRequest)(nil), "cosmos.ugdmint.v1beta1.QueryClaimableRequest")
+	proto.RegisterType((*QueryClaimableResponse)(nil), "cosmos.ugdmint.v1beta1.QueryClaimableResponse")
+	proto.RegisterType((*QueryEscrowSchedulesRequest)(nil), "cosmos.ugdmint.v1beta1.QueryEscrowSchedulesRequest")
+	proto.RegisterType((*QueryEscrowSchedulesResponse)(nil), "cosmos.ugdmint.v1beta1.QueryEscrowSchedulesResponse")
+	proto.RegisterType((*QueryMintRecipientRequest)(nil), "cosmos.ugdmint.v1beta1.QueryMintRecipientRequest")
+	proto.RegisterType((*QueryMintRecipientResponse)(nil), "cosmos.ugdmint.v1beta1.QueryMintRecipientResponse")
+	proto.RegisterType((*RecipientMint)(nil), "cosmos.ugdmint.v1beta1.RecipientMint")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/ugdmint/v1beta1/query.proto", fileDescriptor_a0ce5c9676755241)
+}
+
+var fileDescriptor_a0ce5c9676755241 = []byte{
+	// 1996 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xdb, 0x6b, 0x1c, 0xd7,
+	0x19, 0xf7, 0x68, 0xd7, 0x2b, 0xed, 0xa7, 0xfb, 0x89, 0xab, 0xae, 0xc7, 0xf2, 0xca, 0x19, 0xdf,
+	0x64, 0x39, 0xda, 0xb5, 0xa5, 0x38, 0xbd, 0x98, 0xd2, 0x7a, 0x9d, 0xb8, 0x36, 0xae, 0xa9, 0xb2,
+	0x72, 0x4a, 0x1b, 0x0a, 0xc3, 0xec, 0xcc, 0xc9, 0xee, 0x44, 0x73, 0x59, 0xcf, 0x45, 0xb1, 0x28,
+	0x81, 0x52, 0xf2, 0x92, 0x87, 0x96, 0x40, 0xe9, 0x4b, 0x5f, 0x0a, 0xa5, 0xb4, 0x25, 0x85, 0x60,
+	0x4a, 0xa0, 0xb4, 0x6f, 0x7d, 0x28, 0x04, 0x0a, 0x25, 0xa4, 0x2f, 0xa5, 0x0f, 0x49, 0x91, 0x0b,
+	0xfe, 0x37, 0xca, 0x9c, 0xf3, 0x9d, 0xd9, 0x99, 0xd5, 0xcc, 0x5e, 0x54, 0xc9, 0x2f, 0xb6, 0xf6,
+	0x9c, 0xef, 0xf2, 0xfb, 0xce, 0x77, 0x99, 0xef, 0xfb, 0x40, 0xd1, 0x5d, 0xdf, 0x76, 0xfd, 0x7a,
+	0xd8, 0x36, 0x6c, 0xd3, 0x09, 0xea, 0xbb, 0xd7, 0x5b, 0x34, 0xd0, 0xae, 0xd7, 0x1f, 0x85, 0xd4,
+	0xdb, 0xab, 0x75, 0x3d, 0x37, 0x70, 0xc9, 0x12, 0xa7, 0xa9, 0x21, 0x4d, 0x0d, 0x69, 0xe4, 0x53,
+	0x6d, 0xb7, 0xed, 0x32, 0x92, 0x7a, 0xf4, 0x17, 0xa7, 0x96, 0x97, 0xdb, 0xae, 0xdb, 0xb6, 0x68,
+	0x5d, 0xeb, 0x9a, 0x75, 0xcd, 0x71, 0xdc, 0x40, 0x0b, 0x4c, 0xd7, 0xf1, 0xf1, 0x76, 0x05, 0x6f,
+	0xd9, 0xaf, 0x56, 0xf8, 0x56, 0x3d, 0x30, 0x6d, 0xea, 0x07, 0x9a, 0xdd, 0x45, 0x82, 0xf3, 0x39,
+	0x80, 0xba, 0x9a, 0xa7, 0xd9, 0x42, 0xca, 0xa2, 0x66, 0x9b, 0x8e, 0x5b, 0x67, 0xff, 0xe2, 0xd1,
+	0x69, 0xce, 0xa7, 0x72, 0x3c, 0x88, 0x98, 0x5f, 0xad, 0xe6, 0x88, 0x8c, 0x7e, 0xa8, 0x1e, 0xd5,
+	0x5d, 0xcf, 0x40, 0xca, 0x35, 0xa4, 0x6c, 0x69, 0x3e, 0xe5, 0x4f, 0x90, 0xd0, 0xdf, 0x36, 0x1d,
+	0x66, 0x0a, 0xd2, 0x56, 0x93, 0xb4, 0x82, 0x4a, 0x77, 0x4d, 0xbc, 0x57, 0x4e, 0x01, 0x79, 0x3d,
+	0x92, 0xb0, 0xc5, 0x80, 0x37, 0xe9, 0xa3, 0x90, 0xfa, 0x81, 0xf2, 0x7d, 0x78, 0x21, 0x75, 0xea,
+	0x77, 0x5d, 0xc7, 0xa7, 0xe4, 0x16, 0x94, 0xb8, 0x81, 0x15, 0xe9, 0x9c, 0xb4, 0x3a, 0xbd, 0x51,
+	0xad, 0x65, 0xbf, 0x79, 0x8d, 0xf3, 0x35, 0xca, 0x9f, 0x7c, 0xbe, 0x72, 0xe2, 0xf7, 0xcf, 0x9e,
+	0xac, 0x49, 0x4d, 0x64, 0x54, 0x2e, 0x80, 0xc2, 0x24, 0x6f, 0x87, 0x2d, 0xdf, 0x34, 0xf6, 0xee,
+	0x6a, 0xd6, 0xae, 0xe9, 0xb4, 0xef, 0x39, 0x01, 0xf5, 0x76, 0x35, 0x4b, 0xe8, 0x7f, 0x5f, 0x82,
+	0xf3, 0x03, 0xc9, 0x10, 0x50, 0x0b, 0x2a, 0x3e, 0xa7, 0x50, 0x3b, 0x9c, 0x44, 0x35, 0x91, 0x86,
+	0x41, 0x9c, 0x69, 0xac, 0x46, 0x10, 0xfe, 0xfd, 0xf9, 0xca, 0x19, 0x8e, 0xd4, 0x37, 0x76, 0x6a,
+	0xa6, 0x5b, 0xb7, 0xb5, 0xa0, 0x53, 0xfb, 0x0e, 0x6d, 0x6b, 0xfa, 0xde, 0xab, 0x54, 0xe7, 0x08,
+	0x97, 0xfc, 0x4c, 0x5d, 0xca, 0x32, 0xc8, 0x0c, 0xca, 0x2d, 0xcb, 0x7a, 0x60, 0x3a, 0x41, 0x93,
+	0x79, 0x22, 0x7e, 0xa9, 0xb7, 0xe1, 0x4c, 0xe6, 0x2d, 0x02, 0xbc, 0x0f, 0x33, 0x09, 0xff, 0x45,
+	0xef, 0x56, 0x58, 0x9d, 0xde, 0x50, 0xf2, 0xde, 0xad, 0x27, 0xa2, 0x51, 0x8c, 0x80, 0x37, 0xa7,
+	0xed, 0x9e, 0x50, 0xc5, 0x40, 0x24, 0x5b, 0x9e, 0xab, 0x53, 0xdf, 0xa7, 0x46, 0x44, 0x2e, 0x90,
+	0x90, 0x3b, 0x00, 0x3d, 0xef, 0xa3, 0x83, 0x2e, 0x09, 0x45, 0x91, 0xfb, 0x6b, 0x3c, 0x5b, 0x7a,
+	0x3e, 0x6a, 0x53, 0xe4, 0x6d, 0x26, 0x38, 0x95, 0xbf, 0x4a, 0x70, 0x26, 0x53, 0x0d, 0x9a, 0xf4,
+	0x03, 0x98, 0xef, 0x8a, 0x1b, 0x35, 0x82, 0x27, 0xac, 0xba, 0x98, 0x1b, 0x0d, 0x49, 0x41, 0xc9,
+	0xa0, 0x98, 0xeb, 0xa6, 0x54, 0x90, 0x6f, 0xa7, 0x4c, 0x98, 0x60, 0x26, 0x5c, 0x1e, 0x6a, 0x02,
+	0xc7, 0x95, 0xb2, 0x61, 0x05, 0xce, 0xa2, 0x09, 0x74, 0xd7, 0x74, 0x43, 0xbf, 0x61, 0xb9, 0xfa,
+	0xce, 0x43, 0xd3, 0x16, 0x06, 0x2b, 0x1e, 0x54, 0xf3, 0x08, 0xd0, 0xcc, 0x2d, 0x78, 0xa1, 0x8b,
+	0x97, 0x6a, 0x2b, 0xba, 0x55, 0xa3, 0x1a, 0x80, 0xef, 0x2a, 0xd7, 0x78, 0x81, 0xa8, 0x89, 0x02,
+	0x51, 0x7b, 0x28, 0x0a, 0x44, 0xa3, 0xf8, 0xc1, 0x17, 0x2b, 0x52, 0x73, 0xb1, 0xdb, 0x2f, 0x39,
+	0x4e, 0xb5, 0x07, 0x2c, 0x44, 0xfb, 0x53, 0x4d, 0x9c, 0xf6, 0x52, 0xcd, 0x66, 0x27, 0xc3, 0x52,
+	0x8d, 0xf3, 0xa5, 0x52, 0x8d, 0x33, 0xc6, 0x81, 0xbb, 0x1d, 0x76, 0xbb, 0xd6, 0xde, 0x5d, 0xaa,
+	0x19, 0x9e, 0xeb, 0xda, 0x42, 0xef, 0x6f, 0x0b, 0x70, 0x26, 0xf3, 0x1a, 0x01, 0xfc, 0x58, 0x02,
+	0xb0, 0xb5, 0xc7, 0xaa, 0xcf, 0xae, 0xd1, 0xc5, 0xa7, 0x53, 0xce, 0x10, 0x10, 0x6e, 0xbb, 0xa6,
+	0xd3, 0xb8, 0x13, 0x01, 0xf8, 0xf0, 0x8b, 0x95, 0xd5, 0xb6, 0x19, 0x74, 0xc2, 0x56, 0x4d, 0x77,
+	0x6d, 0xac, 0x6f, 0xf8, 0xdf, 0xba, 0x6f, 0xec, 0xd4, 0x83, 0xbd, 0x2e, 0xf5, 0x19, 0x83, 0xff,
+	0xcb, 0x67, 0x4f, 0xd6, 0x66, 0x2c, 0x96, 0x83, 0x6a, 0x54, 0x90, 0x7c, 0x8e, 0xbe, 0x6c, 0x6b,
+	0x8f, 0x39, 0x24, 0xf2, 0x9e, 0x04, 0x33, 0x81, 0x1b, 0x68, 0x16, 0x0b, 0x33, 0x6a, 0x54, 0x26,
+	0x9e, 0x17, 0x88, 0x69, 0xa6, 0x96, 0x3d, 0xad, 0x41, 0xde, 0x85, 0xa9, 0x0e, 0xbe, 0x4e, 0xa5,
+	0xf0, 0xbc, 0x10, 0xc4, 0x2a, 0x95, 0x9f, 0x4d, 0x60, 0xac, 0xbe, 0x66, 0x9b, 0xbe, 0x6f, 0xba,
+	0xce, 0x96, 0xe7, 0xbe, 0x4d, 0xf5, 0x28, 0xce, 0x45, 0xea, 0xbf, 0x08, 0x33, 0x7e, 0xa0, 0x79,
+	0x81, 0xda, 0xa1, 0x66, 0xbb, 0x13, 0xb0, 0x90, 0x29, 0x36, 0xa7, 0xd9, 0xd9, 0x5d, 0x76, 0x44,
+	0xce, 0x02, 0x50, 0xc7, 0x10, 0x04, 0x13, 0x8c, 0xa0, 0x4c, 0x1d, 0x03, 0xaf, 0xbf, 0x09, 0xc0,
+	0x25, 0xb0, 0x20, 0x2f, 0x8c, 0x18, 0xe4, 0x65, 0xc6, 0x13, 0x9d, 0x92, 0x9b, 0x30, 0x15, 0xc9,
+	0x67, 0xec, 0xc5, 0x11, 0xd9, 0x27, 0xa9, 0x63, 0x30, 0xe6, 0x97, 0x80, 0xf4, 0x52, 0x4c, 0xf5,
+	0xa9, 0xee, 0x3a, 0x86, 0x5f, 0x39, 0xc9, 0x40, 0x2e, 0xb4, 0x44, 0x02, 0x6d, 0xf3, 0x73, 0xe5,
+	0xa7, 0x13, 0xb0, 0x92, 0xfb, 0x20, 0x18, 0xbd, 0x17, 0x61, 0x4e, 0x0f, 0x3d, 0x8f, 0x3a, 0x7d,
+	0x6f, 0x32, 0x8b, 0xa7, 0x68, 0xf6, 0xfd, 0x1e, 0x19, 0xc6, 0x39, 0x2f, 0x3a, 0x03, 0x1c, 0x9c,
+	0x48, 0x34, 0x21, 0x0c, 0xc3, 0x35, 0xdb, 0x8a, 0x42, 0xb6, 0x15, 0xe4, 0x2e, 0x94, 0x68, 0xd7,
+	0xd5, 0x3b, 0x7e, 0xa5, 0x38, 0xb8, 0x7a, 0x0a, 0x2b, 0x5f, 0x8b, 0xa8, 0x53, 0x79, 0xce, 0xf9,
+	0x95, 0x3f, 0x4f, 0xc0, 0x6c, 0x8a, 0xe8, 0x08, 0xe2, 0xe1, 0x01, 0xcc, 0x73, 0x5b, 0xba, 0x9e,
+	0xbb, 0x6b, 0x46, 0x92, 0x2b, 0x85, 0x31, 0x5e, 0x66, 0x8e, 0x31, 0x6f, 0x09, 0x5e, 0xf2, 0x2a,
+	0x40, 0x2c, 0xc8, 0xaf, 0x14, 0xc7, 0x90, 0x94, 0xe0, 0x23, 0xaf, 0xc3, 0xa2, 0x1e, 0xda, 0xa1,
+	0xa5, 0x05, 0xe6, 0x2e, 0x15, 0x0e, 0x3b, 0x39, 0x86, 0xb0, 0x85, 0x1e, 0x3b, 0xf7, 0x99, 0x22,
+	0x43, 0x25, 0xae, 0xbe, 0xa6, 0xd3, 0xde, 0xd2, 0x42, 0x3f, 0xfe, 0x46, 0x3c, 0x82, 0xd3, 0x19,
+	0x77, 0x18, 0x60, 0x0f, 0x61, 0xd6, 0xe6, 0xe7, 0x6a, 0x37, 0xba, 0xc0, 0x32, 0x7d, 0x61, 0x50,
+	0x99, 0x16, 0x42, 0x92, 0x90, 0x66, 0xec, 0xc4, 0x85, 0x62, 0x89, 0xee, 0x48, 0xef, 0x50, 0x23,
+	0xb4, 0xa8, 0xc1, 0x1b, 0xa9, 0xdb, 0x1d, 0xcd, 0x69, 0xd3, 0x23, 0xff, 0xd2, 0xef, 0xc7, 0x5d,
+	0x56, 0x8e, 0x3a, 0xb4, 0xd5, 0x83, 0x8a, 0x2f, 0x28, 0x54, 0xde, 0xc7, 0xa9, 0x3a, 0xa7, 0xc1,
+	0xef, 0xc2, 0x7a, 0x9e, 0xd9, 0x99, 0x92, 0x93, 0xf6, 0x2f, 0xf9, 0x99, 0xba, 0x8f, 0xae, 0x15,
+	0xf8, 0x32, 0x7c, 0x89, 0xd9, 0x78, 0xcf, 0x79, 0xcb, 0xd2, 0x12, 0x45, 0x53, 0x71, 0x60, 0xa9,
+	0xff, 0x22, 0xf6, 0x6d, 0xd9, 0x14, 0x87, 0xd8, 0x46, 0xbe, 0x32, 0x42, 0x1b, 0xf9, 0xd9, 0xc7,
+	0xeb, 0x80, 0x00, 0xe3, 0xa6, 0xb2, 0x27, 0x48, 0xa9, 0xc2, 0x32, 0xef, 0x14, 0x1d, 0x27, 0xd4,
+	0xac, 0x38, 0x37, 0xe2, 0x4e, 0xf2, 0x3d, 0x09, 0xce, 0xe6, 0x10, 0x20, 0x2e, 0x1d, 0x16, 0x35,
+	0x76, 0xa7, 0x26, 0x92, 0xe9, 0xff, 0xc3, 0xb7, 0xa0, 0xf5, 0x29, 0x53, 0xee, 0xe3, 0x7b, 0xdd,
+	0xb6, 0x34, 0xd3, 0xd6, 0x5a, 0x96, 0x88, 0x1c, 0xb2, 0x01, 0x93, 0x9a, 0x61, 0x78, 0xd4, 0xe7,
+	0x3a, 0xcb, 0x8d, 0xca, 0x67, 0x1f, 0xaf, 0x9f, 0x42, 0x81, 0xb7, 0xf8, 0xcd, 0x76, 0xe0, 0x99,
+	0x4e, 0xbb, 0x29, 0x08, 0x95, 0x7f, 0x4c, 0xc0, 0x52, 0xbf, 0x34, 0x34, 0xc6, 0x81, 0xb2, 0x2e,
+	0x0e, 0x87, 0x77, 0x17, 0x37, 0xc6, 0xfd, 0xac, 0xe2, 0xf3, 0xc7, 0x2a, 0x48, 0x07, 0x4a, 0x51,
+	0x4d, 0x1a, 0xa5, 0x8b, 0x38, 0xa4, 0x32, 0x94, 0x4f, 0xde, 0x84, 0x79, 0xea, 0xeb, 0x9e, 0xfb,
+	0x8e, 0x2a, 0x62, 0xbb, 0x52, 0x48, 0xe7, 0xe8, 0x81, 0x12, 0xcf, 0xc8, 0x45, 0xae, 0xa4, 0x0a,
+	0x29, 0x4d, 0x5d, 0x29, 0x14, 0x9b, 0xb6, 0x34, 0xc7, 0x91, 0x57, 0x86, 0xbf, 0x49, 0xb0, 0x9c,
+	0xad, 0x07, 0xbd, 0xf7, 0x43, 0x58, 0xe8, 0xb3, 0x51, 0x94, 0x82, 0x43, 0x18, 0x39, 0x9f, 0x36,
+	0xf2, 0x08, 0x93, 0xff, 0xbb, 0x89, 0x12, 0xde, 0xa4, 0xba, 0xd9, 0x35, 0xa9, 0x13, 0xa0, 0xc1,
+	0x87, 0x0a, 0xe8, 0xdf, 0x14, 0x41, 0xce, 0x92, 0x88, 0xcf, 0x72, 0x07, 0x4e, 0x8e, 0x34, 0x11,
+	0xc5, 0x9c, 0xfd, 0x13, 0x11, 0x67, 0x8f, 0x82, 0x75, 0x97, 0xfa, 0xc1, 0x71, 0x06, 0x2b, 0x97,
+	0x4f, 0x2c, 0x98, 0x0a, 0x1d, 0xd4, 0x55, 0x38, 0x26, 0x5d, 0xb1, 0x06, 0xf2, 0x08, 0xa6, 0x75,
+	0x4b, 0x7b, 0x87, 0x1a, 0x6a, 0x4b, 0xd3, 0x77, 0x2a, 0xc5, 0x63, 0x52, 0x08, 0x5c, 0x49, 0x43,
+	0xd3, 0x77, 0xc8, 0x3d, 0x98, 0x0c, 0x9d, 0x28, 0x33, 0xa3, 0x86, 0xb2, 0x30, 0xe8, 0x13, 0xcd,
+	0x03, 0xf4, 0x0d, 0x46, 0x9c, 0xf4, 0x89, 0xe0, 0x27, 0xab, 0xb0, 0xe0, 0xd0, 0xc7, 0x81, 0x1a,
+	0x3a, 0x71, 0x9b, 0x57, 0x29, 0x9d, 0x93, 0x56, 0x0b, 0xcd, 0xb9, 0xe8, 0xfc, 0x0d, 0x47, 0xf4,
+	0x78, 0xca, 0x1f, 0x8a, 0x30, 0x9b, 0xf2, 0x31, 0x59, 0x82, 0x52, 0xaa, 0x19, 0xc3, 0x5f, 0x51,
+	0xab, 0xc6, 0x1b, 0xad, 0x44, 0x27, 0x56, 0x68, 0x4e, 0xb3, 0x33, 0xec, 0xc5, 0x3a, 0x50, 0xd2,
+	0x6c, 0x37, 0x74, 0x82, 0x63, 0x73, 0x10, 0xca, 0x27, 0xcb, 0x50, 0x8e, 0x17, 0x5d, 0xac, 0x4b,
+	0x2b, 0x37, 0x7b, 0x07, 0xe4, 0x1b, 0x30, 0x19, 0xb9, 0xd1, 0x74, 0xda, 0xd8, 0x74, 0x9d, 0x1f,
+	0xd4, 0xec, 0x7c, 0x8f, 0x93, 0x36, 0x05, 0x0f, 0xf9, 0x16, 0x4c, 0x45, 0x6e, 0x61, 0x8e, 0x2f,
+	0x0d, 0x6f, 0x96, 0x6e, 0x23, 0x6d, 0x33, 0xe6, 0x4a, 0x64, 0xc5, 0xe4, 0x73, 0xcc, 0x8a, 0xa9,
+	0xe3, 0xce, 0x8a, 0x8d, 0x8f, 0x4e, 0xc1, 0x49, 0x56, 0x54, 0xc8, 0xfb, 0x12, 0x94, 0x78, 0x1f,
+	0x44, 0xd6, 0xf2, 0x1e, 0xe7, 0xe0, 0xba, 0x4e, 0xbe, 0x3a, 0x12, 0x2d, 0xaf, 0x51, 0xca, 0xa5,
+	0x9f, 0xfc, 0xf3, 0xbf, 0x3f, 0x9f, 0x38, 0x47, 0xaa, 0xf5, 0x81, 0x3b, 0x4c, 0xf2, 0x77, 0x09,
+	0x96, 0xb2, 0xd7, 0x6f, 0xe4, 0xeb, 0x03, 0xf5, 0x0d, 0x5c, 0xed, 0xc9, 0x37, 0x0f, 0xc5, 0x8b,
+	0xd8, 0xbf, 0xca, 0xb0, 0x6f, 0x90, 0x6b, 0x79, 0xd8, 0xf3, 0xb6, 0x81, 0xe4, 0x77, 0x12, 0xcc,
+	0xa5, 0x77, 0x74, 0x64, 0x63, 0x20, 0x92, 0xcc, 0x75, 0x9f, 0xbc, 0x39, 0x16, 0x0f, 0xa2, 0x7e,
+	0x89, 0xa1, 0xbe, 0x44, 0x2e, 0xd4, 0x87, 0xaf, 0x78, 0x7d, 0xf2, 0xa1, 0x04, 0x73, 0xe9, 0xd5,
+	0xdb, 0x10, 0xa4, 0x99, 0xeb, 0x40, 0x79, 0x73, 0x2c, 0x1e, 0x44, 0x5a, 0x67, 0x48, 0xaf, 0x90,
+	0xcb, 0xb9, 0xb1, 0x91, 0xde, 0xfc, 0x91, 0x3f, 0x49, 0xb0, 0x78, 0x60, 0x87, 0x46, 0x6e, 0x0c,
+	0xd1, 0x9d, 0xbd, 0x94, 0x93, 0x5f, 0x19, 0x97, 0x0d, 0x51, 0x6f, 0x32, 0xd4, 0xeb, 0xe4, 0x6a,
+	0x3e, 0xea, 0x03, 0x8b, 0x3c, 0x96, 0x6a, 0x7c, 0x77, 0x36, 0x24, 0xd5, 0x52, 0xeb, 0x3a, 0xf9,
+	0xea, 0x48, 0xb4, 0xa3, 0xa6, 0x9a, 0xcd, 0x01, 0x44, 0x2e, 0x4f, 0xaf, 0xe1, 0x86, 0xb8, 0x3c,
+	0x73, 0xa5, 0x27, 0x6f, 0x8e, 0xc5, 0x33, 0xaa, 0xcb, 0xf9, 0x9c, 0xad, 0x8a, 0x7d, 0x14, 0xf9,
+	0x8b, 0x04, 0xe4, 0xe0, 0xe6, 0x85, 0x0c, 0x76, 0x5e, 0xee, 0xee, 0x4a, 0xfe, 0xca, 0xd8, 0x7c,
+	0xa3, 0x7a, 0x9d, 0x22, 0xaf, 0xda, 0x8d, 0x99, 0xc9, 0xaf, 0x25, 0x98, 0x49, 0x8e, 0xe2, 0xe4,
+	0xda, 0x50, 0x7f, 0xf6, 0xad, 0x05, 0xe4, 0xeb, 0x63, 0x70, 0x20, 0xd4, 0x75, 0x06, 0xf5, 0x32,
+	0xb9, 0x38, 0x28, 0x0e, 0xe2, 0x55, 0x02, 0xaf, 0xbc, 0xd9, 0x63, 0xf1, 0x90, 0xca, 0x3b, 0x68,
+	0x6d, 0x20, 0xdf, 0x3c, 0x14, 0xef, 0xc8, 0x95, 0x37, 0x67, 0x43, 0x40, 0x7e, 0x21, 0x41, 0x39,
+	0x9e, 0xb1, 0xc9, 0xfa, 0x40, 0x10, 0xfd, 0x43, 0xba, 0x5c, 0x1b, 0x95, 0x1c, 0x61, 0x5e, 0x61,
+	0x30, 0xcf, 0x93, 0x17, 0xf3, 0x60, 0xc6, 0xf3, 0x38, 0xf9, 0xa3, 0x04, 0x0b, 0xfd, 0xa3, 0x36,
+	0x79, 0x79, 0x70, 0x7d, 0xcf, 0x1e, 0xdd, 0xe5, 0x1b, 0x63, 0x72, 0x21, 0xd8, 0xeb, 0x0c, 0xec,
+	0x55, 0x72, 0x25, 0x0f, 0xec, 0x81, 0x69, 0x9f, 0xfc, 0x4a, 0x82, 0x72, 0x3c, 0x4b, 0x0f, 0x79,
+	0xcc, 0xfe, 0x09, 0x5e, 0xae, 0x8d, 0x4a, 0x3e, 0x6a, 0x86, 0xc5, 0xd3, 0x75, 0xfd, 0x47, 0x38,
+	0x20, 0xbd, 0x4b, 0x3e, 0x92, 0x60, 0xbe, 0x6f, 0x6a, 0x24, 0x83, 0x0b, 0x53, 0xf6, 0x2c, 0x2b,
+	0xbf, 0x3c, 0x1e, 0x13, 0x62, 0xbe, 0xc6, 0x30, 0xaf, 0x91, 0xd5, 0xdc, 0xaa, 0xd0, 0x37, 0xb6,
+	0x92, 0x27, 0x12, 0xcc, 0xa6, 0xa6, 0x39, 0x32, 0x3c, 0xc3, 0xfb, 0x67, 0x49, 0x79, 0x63, 0x1c,
+	0x96, 0x51, 0x53, 0x4a, 0xb4, 0x05, 0x9c, 0xaf, 0xf7, 0xc6, 0x8d, 0xed, 0x4f, 0xf6, 0xab, 0xd2,
+	0xa7, 0xfb, 0x55, 0xe9, 0x3f, 0xfb, 0x55, 0xe9, 0x83, 0xa7, 0xd5, 0x13, 0x9f, 0x3e, 0xad, 0x9e,
+	0xf8, 0xd7, 0xd3, 0xea, 0x89, 0x37, 0xbf, 0x96, 0xe8, 0x41, 0x43, 0xc7, 0x6c, 0x7b, 0xa6, 0xb1,
+	0x8e, 0xe5, 0x4f, 0x34, 0xa3, 0x42, 0xcb, 0xe3, 0x58, 0x1f, 0x6b, 0x4d, 0x5b, 0x25, 0xb6, 0xa7,
+	0xdf, 0xfc, 0xdf, 0x00, 0x61, 0x5e, 0xbc, 0xe0, 0x6c, 0x1f, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Subsidy halving interval
+	SubsidyHalvingInterval(ctx context.Context, in *QuerySubsidyHalvingIntervalRequest, opts ...grpc.CallOption) (*QuerySubsidyHalvingIntervalResponse, error)
+	// AllMintRecords queries all mint records stored by the module.
+	AllMintRecords(ctx context.Context, in *QueryAllMintRecordsRequest, opts ...grpc.CallOption) (*QueryAllMintRecordsResponse, error)
+	// ProcessedMints queries the Hedgehog mints that have already been paid out.
+	ProcessedMints(ctx context.Context, in *QueryProcessedMintsRequest, opts ...grpc.CallOption) (*QueryProcessedMintsResponse, error)
+	// PreviousBlockTime queries the time of the last block the module has seen.
+	PreviousBlockTime(ctx context.Context, in *QueryPreviousBlockTimeRequest, opts ...grpc.CallOption) (*QueryPreviousBlockTimeResponse, error)
+	// Minter queries the minting state, including the running emission totals.
+	Minter(ctx context.Context, in *QueryMinterRequest, opts ...grpc.CallOption) (*QueryMinterResponse, error)
+	// SupplyHeadroom queries how much of every capped denom may still be minted.
+	SupplyHeadroom(ctx context.Context, in *QuerySupplyHeadroomRequest, opts ...grpc.CallOption) (*QuerySupplyHeadroomResponse, error)
+	// EmissionProjection projects the block provisions and the supply of the
+	// mint denom over a range of future heights without changing any state.
+	EmissionProjection(ctx context.Context, in *QueryEmissionProjectionRequest, opts ...grpc.CallOption) (*QueryEmissionProjectionResponse, error)
+	// MintingPause queries the kinds of minting paused by governance.
+	MintingPause(ctx context.Context, in *QueryMintingPauseRequest, opts ...grpc.CallOption) (*QueryMintingPauseResponse, error)
+	// ScheduledParamsChanges queries the params changes that are still to be
+	// applied, ordered by activation height.
+	ScheduledParamsChanges(ctx context.Context, in *QueryScheduledParamsChangesRequest, opts ...grpc.CallOption) (*QueryScheduledParamsChangesResponse, error)
+	// Inflation queries the current annual inflation rate of the mint denom,
+	// like the Query/Inflation RPC method of x/mint.
+	Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error)
+	// AnnualProvisions queries the current annual provisions of the mint denom,
+	// like the Query/AnnualProvisions RPC method of x/mint.
+	AnnualProvisions(ctx context.Context, in *QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualProvisionsResponse, error)
+	// Claimable queries the escrowed coins of a recipient, along with the coins
+	// that can be claimed.
+	Claimable(ctx context.Context, in *QueryClaimableRequest, opts ...grpc.CallOption) (*QueryClaimableResponse, error)
+	// EscrowSchedules queries the escrowed coins of every recipient.
+	EscrowSchedules(ctx context.Context, in *QueryEscrowSchedulesRequest, opts ...grpc.CallOption) (*QueryEscrowSchedulesResponse, error)
+	// MintRecipient queries the Hedgehog mints an address received and how
+	// much of them has vested.
+	MintRecipient(ctx context.Context, in *QueryMintRecipientRequest, opts ...grpc.CallOption) (*QueryMintRecipientResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.ugdmint.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SubsidyHalvingInterval(ctx context.Context, in *QuerySubsidyHalvingIntervalRequest, opts ...grpc.CallOption) (*QuerySubsidyHalvingIntervalResponse, error) {
+	out := new(QuerySubsidyHalvingIntervalResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.ugdmint.v1beta1.Query/SubsidyHalvingInterval", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllMintRecords(ctx context.Context, in *QueryAllMintRecordsRequest, opts ...grpc.CallOption) (*QueryAllMintRecordsResponse, error) {
+	out := new(QueryAllMintRecordsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.ugdmint.v1beta1.Query/AllMintRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProcessedMints(ctx context.Context, in *QueryProcessedMintsRequest, opts ...grpc.CallOption) (*QueryProcessedMintsResponse, error) {
+	out := new(QueryProcessedMintsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.ugdmint.v1beta1.Query/ProcessedMints", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PreviousBlockTime(ctx context.Context, in *QueryPreviousBlockTimeRequest, opts ...grpc.CallOption) (*QueryPreviousBlockTimeResponse, error) {
+	out := new(QueryPreviousBlockTimeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.ugdmint.v1beta1.Query/PreviousBlockTime", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Minter(ctx context.Context, in *QueryMinterRequest, opts ...grpc.CallOption) (*QueryMinterResponse, error) {
+	out := new(QueryMinterResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.ugdmint.v1beta1.Query/Minter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SupplyHeadroom(ctx context.Context, in *QuerySupplyHeadroomRequest, opts ...grpc.CallOption) (*QuerySupplyHeadroomResponse, error) {
+	out := new(QuerySupplyHeadroomResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.ugdmint.v1beta1.Query/SupplyHeadroom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EmissionProjection(ctx context.Context, in *QueryEmissionProjectionRequest, opts ...grpc.CallOption) (*QueryEmissionProjectionResponse, error) {
+	out := new(QueryEmissionProjectionResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.ugdmint.v1beta1.Query/EmissionProjection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MintingPause(ctx context.Context, in *QueryMintingPauseRequest, opts ...grpc.CallOption) (*QueryMintingPauseResponse, error) {
+	out := new(QueryMintingPauseResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.ugdmint.v1beta1.Query/MintingPause", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ScheduledParamsChanges(ctx context.Context, in *QueryScheduledParamsChangesRequest, opts ...grpc.CallOption) (*QueryScheduledParamsChangesResponse, error) {
+	out := new(QueryScheduledParamsChangesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.ugdmint.v1beta1.Query/ScheduledParamsChanges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error) {
+	out := new(QueryInflationResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.ugdmint.v1beta1.Query/Inflation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AnnualProvisions(ctx context.Context, in *QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualProvisionsResponse, error) {
+	out := new(QueryAnnualProvisionsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.ugdmint.v1beta1.Query/AnnualProvisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Claimable(ctx context.Context, in *QueryClaimableRequest, opts ...grpc.CallOption) (*QueryClaimableResponse, error) {
+	out := new(QueryClaimableResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.ugdmint.v1beta1.Query/Claimable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EscrowSchedules(ctx context.Context, in *QueryEscrowSchedulesRequest, opts ...grpc.CallOption) (*QueryEscrowSchedulesResponse, error) {
+	out := new(QueryEscrowSchedulesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.ugdmint.v1beta1.Query/EscrowSchedules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MintRecipient(ctx context.Context, in *QueryMintRecipientRequest, opts ...grpc.CallOption) (*QueryMintRecipientResponse, error) {
+	out := new(QueryMintRecipientResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.ugdmint.v1beta1.Query/MintRecipient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Subsidy halving interval
+	SubsidyHalvingInterval(context.Context, *QuerySubsidyHalvingIntervalRequest) (*QuerySubsidyHalvingIntervalResponse, error)
+	// AllMintRecords queries all mint records stored by the module.
+	AllMintRecords(context.Context, *QueryAllMintRecordsRequest) (*QueryAllMintRecordsResponse, error)
+	// ProcessedMints queries the Hedgehog mints that have already been paid out.
+	ProcessedMints(context.Context, *QueryProcessedMintsRequest) (*QueryProcessedMintsResponse, error)
+	// PreviousBlockTime queries the time of the last block the module has seen.
+	PreviousBlockTime(context.Context, *QueryPreviousBlockTimeRequest) (*QueryPreviousBlockTimeResponse, error)
+	// Minter queries the minting state, including the running emission totals.
+	Minter(context.Context, *QueryMinterRequest) (*QueryMinterResponse, error)
+	// SupplyHeadroom queries how much of every capped denom may still be minted.
+	SupplyHeadroom(context.Context, *QuerySupplyHeadroomRequest) (*QuerySupplyHeadroomResponse, error)
+	// EmissionProjection projects the block provisions and the supply of the
+	// mint denom over a range of future heights without changing any state.
+	EmissionProjection(context.Context, *QueryEmissionProjectionRequest) (*QueryEmissionProjectionResponse, error)
+	// MintingPause queries the kinds of minting paused by governance.
+	MintingPause(context.Context, *QueryMintingPauseRequest) (*QueryMintingPauseResponse, error)
+	// ScheduledParamsChanges queries the params changes that are still to be
+	// applied, ordered by activation height.
+	ScheduledParamsChanges(context.Context, *QueryScheduledParamsChangesRequest) (*QueryScheduledParamsChangesResponse, error)
+	// Inflation queries the current annual inflation rate of the mint denom,
+	// like the Query/Inflation RPC method of x/mint.
+	Inflation(context.Context, *QueryInflationRequest) (*QueryInflationResponse, error)
+	// AnnualProvisions queries the current annual provisions of the mint denom,
+	// like the Query/AnnualProvisions RPC method of x/mint.
+	AnnualProvisions(context.Context, *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error)
+	// Claimable queries the escrowed coins of a recipient, along with the coins
+	// that can be claimed.
+	Claimable(context.Context, *QueryClaimableRequest) (*QueryClaimableResponse, error)
+	// EscrowSchedules queries the escrowed coins of every recipient.
+	EscrowSchedules(context.Context, *QueryEscrowSchedulesRequest) (*QueryEscrowSchedulesResponse, error)
+	// MintRecipient queries the Hedgehog mints an address received and how
+	// much of them has vested.
+	MintRecipient(context.Context, *QueryMintRecipientRequest) (*QueryMintRecipientResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) SubsidyHalvingInterval(ctx context.Context, req *QuerySubsidyHalvingIntervalRequest) (*QuerySubsidyHalvingIntervalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubsidyHalvingInterval not implemented")
+}
+func (*UnimplementedQueryServer) AllMintRecords(ctx context.Context, req *QueryAllMintRecordsRequest) (*QueryAllMintRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllMintRecords not implemented")
+}
+func (*UnimplementedQueryServer) ProcessedMints(ctx context.Context, req *QueryProcessedMintsRequest) (*QueryProcessedMintsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProcessedMints not implemented")
+}
+func (*UnimplementedQueryServer) PreviousBlockTime(ctx context.Context, req *QueryPreviousBlockTimeRequest) (*QueryPreviousBlockTimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviousBlockTime not implemented")
+}
+func (*UnimplementedQueryServer) Minter(ctx context.Context, req *QueryMinterRequest) (*QueryMinterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Minter not implemented")
+}
+func (*UnimplementedQueryServer) SupplyHeadroom(ctx context.Context, req *QuerySupplyHeadroomRequest) (*QuerySupplyHeadroomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplyHeadroom not implemented")
+}
+func (*UnimplementedQueryServer) EmissionProjection(ctx context.Context, req *QueryEmissionProjectionRequest) (*QueryEmissionProjectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmissionProjection not implemented")
+}
+func (*UnimplementedQueryServer) MintingPause(ctx context.Context, req *QueryMintingPauseRequest) (*QueryMintingPauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintingPause not implemented")
+}
+func (*UnimplementedQueryServer) ScheduledParamsChanges(ctx context.Context, req *QueryScheduledParamsChangesRequest) (*QueryScheduledParamsChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledParamsChanges not implemented")
+}
+func (*UnimplementedQueryServer) Inflation(ctx context.Context, req *QueryInflationRequest) (*QueryInflationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Inflation not implemented")
+}
+func (*UnimplementedQueryServer) AnnualProvisions(ctx context.Context, req *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnnualProvisions not implemented")
+}
+func (*UnimplementedQueryServer) Claimable(ctx context.Context, req *QueryClaimableRequest) (*QueryClaimableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Claimable not implemented")
+}
+func (*UnimplementedQueryServer) EscrowSchedules(ctx context.Context, req *QueryEscrowSchedulesRequest) (*QueryEscrowSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EscrowSchedules not implemented")
+}
+func (*UnimplementedQueryServer) MintRecipient(ctx context.Context, req *QueryMintRecipientRequest) (*QueryMintRecipientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintRecipient not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.ugdmint.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SubsidyHalvingInterval_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySubsidyHalvingIntervalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SubsidyHalvingInterval(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.ugdmint.v1beta1.Query/SubsidyHalvingInterval",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SubsidyHalvingInterval(ctx, req.(*QuerySubsidyHalvingIntervalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllMintRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllMintRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllMintRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.ugdmint.v1beta1.Query/AllMintRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllMintRecords(ctx, req.(*QueryAllMintRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProcessedMints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProcessedMintsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProcessedMints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.ugdmint.v1beta1.Query/ProcessedMints",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProcessedMints(ctx, req.(*QueryProcessedMintsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PreviousBlockTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPreviousBlockTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PreviousBlockTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.ugdmint.v1beta1.Query/PreviousBlockTime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PreviousBlockTime(ctx, req.(*QueryPreviousBlockTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Minter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMinterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Minter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.ugdmint.v1beta1.Query/Minter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Minter(ctx, req.(*QueryMinterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SupplyHeadroom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySupplyHeadroomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SupplyHeadroom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.ugdmint.v1beta1.Query/SupplyHeadroom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SupplyHeadroom(ctx, req.(*QuerySupplyHeadroomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EmissionProjection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEmissionProjectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EmissionProjection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.ugdmint.v1beta1.Query/EmissionProjection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EmissionProjection(ctx, req.(*QueryEmissionProjectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MintingPause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintingPauseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MintingPause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.ugdmint.v1beta1.Query/MintingPause",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MintingPause(ctx, req.(*QueryMintingPauseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScheduledParamsChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledParamsChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledParamsChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.ugdmint.v1beta1.Query/ScheduledParamsChanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledParamsChanges(ctx, req.(*QueryScheduledParamsChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Inflation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInflationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Inflation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.ugdmint.v1beta1.Query/Inflation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Inflation(ctx, req.(*QueryInflationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AnnualProvisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAnnualProvisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AnnualProvisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.ugdmint.v1beta1.Query/AnnualProvisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AnnualProvisions(ctx, req.(*QueryAnnualProvisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Claimable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClaimableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Claimable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.ugdmint.v1beta1.Query/Claimable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Claimable(ctx, req.(*QueryClaimableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EscrowSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEscrowSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EscrowSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.ugdmint.v1beta1.Query/EscrowSchedules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EscrowSchedules(ctx, req.(*QueryEscrowSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MintRecipient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintRecipientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MintRecipient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.ugdmint.v1beta1.Query/MintRecipient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MintRecipient(ctx, req.(*QueryMintRecipientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.ugdmint.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "SubsidyHalvingInterval",
+			Handler:    _Query_SubsidyHalvingInterval_Handler,
+		},
+		{
+			MethodName: "AllMintRecords",
+			Handler:    _Query_AllMintRecords_Handler,
+		},
+		{
+			MethodName: "ProcessedMints",
+			Handler:    _Query_ProcessedMints_Handler,
+		},
+		{
+			MethodName: "PreviousBlockTime",
+			Handler:    _Query_PreviousBlockTime_Handler,
+		},
+		{
+			MethodName: "Minter",
+			Handler:    _Query_Minter_Handler,
+		},
+		{
+			MethodName: "SupplyHeadroom",
+			Handler:    _Query_SupplyHeadroom_Handler,
+		},
+		{
+			MethodName: "EmissionProjection",
+			Handler:    _Query_EmissionProjection_Handler,
+		},
+		{
+			MethodName: "MintingPause",
+			Handler:    _Query_MintingPause_Handler,
+		},
+		{
+			MethodName: "ScheduledParamsChanges",
+			Handler:    _Query_ScheduledParamsChanges_Handler,
+		},
+		{
+			MethodName: "Inflation",
+			Handler:    _Query_Inflation_Handler,
+		},
+		{
+			MethodName: "AnnualProvisions",
+			Handler:    _Query_AnnualProvisions_Handler,
+		},
+		{
+			MethodName: "Claimable",
+			Handler:    _Query_Claimable_Handler,
+		},
+		{
+			MethodName: "EscrowSchedules",
+			Handler:    _Query_EscrowSchedules_Handler,
+		},
+		{
+			MethodName: "MintRecipient",
+			Handler:    _Query_MintRecipient_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/ugdmint/v1beta1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySubsidyHalvingIntervalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySubsidyHalvingIntervalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySubsidyHalvingIntervalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySubsidyHalvingIntervalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySubsidyHalvingIntervalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySubsidyHalvingIntervalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SubsidyHalvingInterval.Size()
+		i -= size
+		if _, err := m.SubsidyHalvingInterval.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllMintRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllMintRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllMintRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllMintRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllMintRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllMintRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MintRecords) > 0 {
+		for iNdEx := len(m.MintRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProcessedMintsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProcessedMintsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProcessedMintsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProcessedMintsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProcessedMintsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProcessedMintsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProcessedMints) > 0 {
+		for iNdEx := len(m.ProcessedMints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProcessedMints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPreviousBlockTimeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPreviousBlockTimeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPreviousBlockTimeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPreviousBlockTimeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPreviousBlockTimeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPreviousBlockTimeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PreviousBlockTime != nil {
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.PreviousBlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.PreviousBlockTime):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintQuery(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMinterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMinterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMinterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMinterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMinterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMinterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Minter.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySupplyHeadroomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySupplyHeadroomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySupplyHeadroomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySupplyHeadroomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySupplyHeadroomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySupplyHeadroomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Headroom) > 0 {
+		for iNdEx := len(m.Headroom) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Headroom[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.TotalMinted) > 0 {
+		for iNdEx := len(m.TotalMinted) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalMinted[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.MaxSupply) > 0 {
+		for iNdEx := len(m.MaxSupply) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxSupply[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEmissionProjectionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEmissionProjectionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEmissionProjectionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockTimeSeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockTimeSeconds))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.EndTime != nil {
+		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintQuery(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.StartTime != nil {
+		n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintQuery(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEmissionProjectionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEmissionProjectionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEmissionProjectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Epochs) > 0 {
+		for iNdEx := len(m.Epochs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Epochs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.BlockTimeSeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockTimeSeconds))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.CurrentSupply.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.CurrentHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EmissionEpoch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmissionEpoch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmissionEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CumulativeSupply.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Provisions.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.BlockProvision.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.EndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintingPauseRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintingPauseRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintingPauseRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintingPauseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintingPauseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintingPauseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MintingPause.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledParamsChangesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledParamsChangesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledParamsChangesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledParamsChangesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledParamsChangesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledParamsChangesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScheduledParamsChanges) > 0 {
+		for iNdEx := len(m.ScheduledParamsChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledParamsChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInflationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInflationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInflationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInflationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInflationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInflationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Inflation.Size()
+		i -= size
+		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAnnualProvisionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAnnualProvisionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAnnualProvisionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAnnualProvisionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAnnualProvisionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAnnualProvisionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.AnnualProvisions.Size()
+		i -= size
+		if _, err := m.AnnualProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimableRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimableRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimableRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimableResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimableResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimableResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.EscrowSchedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Locked) > 0 {
+		for iNdEx := len(m.Locked) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locked[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Claimable) > 0 {
+		for iNdEx := len(m.Claimable) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Claimable[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEscrowSchedulesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEscrowSchedulesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEscrowSchedulesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEscrowSchedulesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEscrowSchedulesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEscrowSchedulesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.EscrowSchedules) > 0 {
+		for iNdEx := len(m.EscrowSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EscrowSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintRecipientRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintRecipientRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintRecipientRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintRecipientResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintRecipientResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintRecipientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextUnlockTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextUnlockTime))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Unlocks) > 0 {
+		for iNdEx := len(m.Unlocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unlocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ClawedBack) > 0 {
+		for iNdEx := len(m.ClawedBack) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClawedBack[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Unvested) > 0 {
+		for iNdEx := len(m.Unvested) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unvested[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Vested) > 0 {
+		for iNdEx := len(m.Vested) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Vested[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Mints) > 0 {
+		for iNdEx := len(m.Mints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Mints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecipientMint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecipientMint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecipientMint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Unvested) > 0 {
+		for iNdEx := len(m.Unvested) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unvested[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Vested) > 0 {
+		for iNdEx := len(m.Vested) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Vested[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.Clawback != nil {
+		{
+			size, err := m.Clawback.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Vesting != nil {
+		{
+			size, err := m.Vesting.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Timestamp) > 0 {
+		i -= len(m.Timestamp)
+		copy(dAtA[i:], m.Timestamp)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Timestamp)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySubsidyHalvingIntervalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySubsidyHalvingIntervalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SubsidyHalvingInterval.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllMintRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAllMintRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MintRecords) > 0 {
+		for _, e := range m.MintRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryProcessedMintsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProcessedMintsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ProcessedMints) > 0 {
+		for _, e := range m.ProcessedMints {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPreviousBlockTimeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPreviousBlockTimeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PreviousBlockTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.PreviousBlockTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMinterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryMinterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Minter.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySupplyHeadroomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySupplyHeadroomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MaxSupply) > 0 {
+		for _, e := range m.MaxSupply {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.TotalMinted) > 0 {
+		for _, e := range m.TotalMinted {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Headroom) > 0 {
+		for _, e := range m.Headroom {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryEmissionProjectionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EndHeight))
+	}
+	if m.StartTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.EndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BlockTimeSeconds != 0 {
+		n += 1 + sovQuery(uint64(m.BlockTimeSeconds))
+	}
+	return n
+}
+
+func (m *QueryEmissionProjectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CurrentHeight != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentHeight))
+	}
+	l = m.CurrentSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.BlockTimeSeconds != 0 {
+		n += 1 + sovQuery(uint64(m.BlockTimeSeconds))
+	}
+	if len(m.Epochs) > 0 {
+		for _, e := range m.Epochs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EmissionEpoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EndHeight))
+	}
+	l = m.BlockProvision.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Provisions.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CumulativeSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMintingPauseRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryMintingPauseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MintingPause.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryScheduledParamsChangesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScheduledParamsChangesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ScheduledParamsChanges) > 0 {
+		for _, e := range m.ScheduledParamsChanges {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInflationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryInflationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Inflation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAnnualProvisionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAnnualProvisionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AnnualProvisions.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryClaimableRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClaimableResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Claimable) > 0 {
+		for _, e := range m.Claimable {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Locked) > 0 {
+		for _, e := range m.Locked {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.EscrowSchedule.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEscrowSchedulesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEscrowSchedulesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.EscrowSchedules) > 0 {
+		for _, e := range m.EscrowSchedules {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMintRecipientRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMintRecipientResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Mints) > 0 {
+		for _, e := range m.Mints {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Vested) > 0 {
+		for _, e := range m.Vested {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Unvested) > 0 {
+		for _, e := range m.Unvested {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ClawedBack) > 0 {
+		for _, e := range m.ClawedBack {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Unlocks) > 0 {
+		for _, e := range m.Unlocks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.NextUnlockTime != 0 {
+		n += 1 + sovQuery(uint64(m.NextUnlockTime))
+	}
+	return n
+}
+
+func (m *RecipientMint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.Timestamp)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Vesting != nil {
+		l = m.Vesting.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Clawback != nil {
+		l = m.Clawback.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Vested) > 0 {
+		for _, e := range m.Vested {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Unvested) > 0 {
+		for _, e := range m.Unvested {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySubsidyHalvingIntervalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySubsidyHalvingIntervalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySubsidyHalvingIntervalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySubsidyHalvingIntervalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySubsidyHalvingIntervalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySubsidyHalvingIntervalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubsidyHalvingInterval", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SubsidyHalvingInterval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllMintRecordsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllMintRecordsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllMintRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllMintRecordsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllMintRecordsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllMintRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintRecords = append(m.MintRecords, MintRecord{})
+			if err := m.MintRecords[len(m.MintRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProcessedMintsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProcessedMintsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProcessedMintsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProcessedMintsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProcessedMintsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProcessedMintsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessedMints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessedMints = append(m.ProcessedMints, ProcessedMint{})
+			if err := m.ProcessedMints[len(m.ProcessedMints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPreviousBlockTimeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPreviousBlockTimeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPreviousBlockTimeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPreviousBlockTimeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPreviousBlockTimeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPreviousBlockTimeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousBlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PreviousBlockTime == nil {
+				m.PreviousBlockTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.PreviousBlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMinterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMinterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMinterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMinterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMinterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMinterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Minter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySupplyHeadroomRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySupplyHeadroomRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySupplyHeadroomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySupplyHeadroomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySupplyHeadroomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySupplyHeadroomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxSupply = append(m.MaxSupply, types.Coin{})
+			if err := m.MaxSupply[len(m.MaxSupply)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalMinted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalMinted = append(m.TotalMinted, types.Coin{})
+			if err := m.TotalMinted[len(m.TotalMinted)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headroom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Headroom = append(m.Headroom, types.Coin{})
+			if err := m.Headroom[len(m.Headroom)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEmissionProjectionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEmissionProjectionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEmissionProjectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartTime == nil {
+				m.StartTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimeSeconds", wireType)
+			}
+			m.BlockTimeSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockTimeSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEmissionProjectionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEmissionProjectionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEmissionProjectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentHeight", wireType)
+			}
+			m.CurrentHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentSupply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimeSeconds", wireType)
+			}
+			m.BlockTimeSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockTimeSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Epochs = append(m.Epochs, EmissionEpoch{})
+			if err := m.Epochs[len(m.Epochs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EmissionEpoch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EmissionEpoch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EmissionEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockProvision", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockProvision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provisions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Provisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeSupply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CumulativeSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMintingPauseRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMintingPauseRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMintingPauseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMintingPauseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMintingPauseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMintingPauseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintingPause", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintingPause.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScheduledParamsChangesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduledParamsChangesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduledParamsChangesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScheduledParamsChangesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduledParamsChangesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduledParamsChangesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledParamsChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledParamsChanges = append(m.ScheduledParamsChanges, ScheduledParamsChange{})
+			if err := m.ScheduledParamsChanges[len(m.ScheduledParamsChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInflationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInflationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInflationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInflationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInflationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInflationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAnnualProvisionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAnnualProvisionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAnnualProvisionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAnnualProvisionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAnnualProvisionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAnnualProvisionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClaimableRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClaimableRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClaimableRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClaimableResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClaimableResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClaimableResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimable", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claimable = append(m.Claimable, types.Coin{})
+			if err := m.Claimable[len(m.Claimable)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locked = append(m.Locked, types.Coin{})
+			if err := m.Locked[len(m.Locked)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EscrowSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEscrowSchedulesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEscrowSchedulesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEscrowSchedulesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEscrowSchedulesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -756,12 +6841,82 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEscrowSchedulesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEscrowSchedulesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EscrowSchedules = append(m.EscrowSchedules, EscrowSchedule{})
+			if err := m.EscrowSchedules[len(m.EscrowSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -783,7 +6938,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMintRecipientRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -806,17 +6961,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMintRecipientRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMintRecipientRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -826,24 +6981,23 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -866,7 +7020,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySubsidyHalvingIntervalRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMintRecipientResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -879,22 +7033,211 @@ func (m *QuerySubsidyHalvingIntervalRequest) Unmarshal(dAtA []byte) error {
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMintRecipientResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMintRecipientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mints = append(m.Mints, RecipientMint{})
+			if err := m.Mints[len(m.Mints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vested", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vested = append(m.Vested, types.Coin{})
+			if err := m.Vested[len(m.Vested)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unvested", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unvested = append(m.Unvested, types.Coin{})
+			if err := m.Unvested[len(m.Unvested)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClawedBack", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClawedBack = append(m.ClawedBack, types.Coin{})
+			if err := m.ClawedBack[len(m.ClawedBack)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unlocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unlocks = append(m.Unlocks, EscrowUnlock{})
+			if err := m.Unlocks[len(m.Unlocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextUnlockTime", wireType)
+			}
+			m.NextUnlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextUnlockTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySubsidyHalvingIntervalRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySubsidyHalvingIntervalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -916,7 +7259,7 @@ func (m *QuerySubsidyHalvingIntervalRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySubsidyHalvingIntervalResponse) Unmarshal(dAtA []byte) error {
+func (m *RecipientMint) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -939,17 +7282,55 @@ func (m *QuerySubsidyHalvingIntervalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySubsidyHalvingIntervalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecipientMint: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySubsidyHalvingIntervalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecipientMint: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubsidyHalvingInterval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -959,128 +7340,167 @@ func (m *QuerySubsidyHalvingIntervalResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SubsidyHalvingInterval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAllMintRecordsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.Timestamp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vesting", wireType)
 			}
-			if iNdEx >= l {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Vesting == nil {
+				m.Vesting = &MintVesting{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllMintRecordsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllMintRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Vesting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Clawback", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAllMintRecordsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if m.Clawback == nil {
+				m.Clawback = &MintClawback{}
 			}
-			if iNdEx >= l {
+			if err := m.Clawback.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vested", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Vested = append(m.Vested, types.Coin{})
+			if err := m.Vested[len(m.Vested)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllMintRecordsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllMintRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintRecords", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Unvested", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1107,8 +7527,8 @@ func (m *QueryAllMintRecordsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MintRecords = append(m.MintRecords, MintRecord{})
-			if err := m.MintRecords[len(m.MintRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Unvested = append(m.Unvested, types.Coin{})
+			if err := m.Unvested[len(m.Unvested)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
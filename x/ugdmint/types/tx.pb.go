@@ -7,6 +7,8 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -130,233 +132,2367 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.ugdmint.v1beta1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.ugdmint.v1beta1.MsgUpdateParamsResponse")
+// MsgSetMintingPaused is the Msg/SetMintingPaused request type. It replaces the
+// current pause; resuming everything is done by pausing nothing.
+type MsgSetMintingPaused struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// block_provisions_paused stops the block provision from being minted.
+	BlockProvisionsPaused bool `protobuf:"varint,2,opt,name=block_provisions_paused,json=blockProvisionsPaused,proto3" json:"block_provisions_paused,omitempty"`
+	// hedgehog_mints_paused stops the agreed Hedgehog mints from being paid out.
+	HedgehogMintsPaused bool `protobuf:"varint,3,opt,name=hedgehog_mints_paused,json=hedgehogMintsPaused,proto3" json:"hedgehog_mints_paused,omitempty"`
+	// resume_height optionally sets a future height from which minting resumes
+	// by itself. Zero keeps minting paused until it is lifted by another
+	// MsgSetMintingPaused.
+	ResumeHeight int64 `protobuf:"varint,4,opt,name=resume_height,json=resumeHeight,proto3" json:"resume_height,omitempty"`
 }
 
-func init() { proto.RegisterFile("cosmos/ugdmint/v1beta1/tx.proto", fileDescriptor_7cb34e3ab58be18c) }
-
-var fileDescriptor_7cb34e3ab58be18c = []byte{
-	// 366 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x2f, 0x4d, 0x4f, 0xc9, 0xcd, 0xcc, 0x2b, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d,
-	0x49, 0x34, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x83, 0x28, 0xd0,
-	0x83, 0x2a, 0xd0, 0x83, 0x2a, 0x90, 0x12, 0x87, 0x6a, 0xcc, 0x2d, 0x4e, 0xd7, 0x2f, 0x33, 0x04,
-	0x51, 0x10, 0x0d, 0x52, 0x82, 0x89, 0xb9, 0x99, 0x79, 0xf9, 0xfa, 0x60, 0x12, 0x2a, 0xa4, 0x8c,
-	0xc3, 0x92, 0x82, 0xc4, 0xa2, 0xc4, 0xdc, 0x62, 0xa8, 0x22, 0x91, 0xf4, 0xfc, 0xf4, 0x7c, 0x30,
-	0x53, 0x1f, 0xc4, 0x82, 0x8a, 0x4a, 0x42, 0xb4, 0xc6, 0x43, 0x24, 0xa0, 0x6e, 0x01, 0x73, 0x94,
-	0x8e, 0x32, 0x72, 0xf1, 0xfb, 0x16, 0xa7, 0x87, 0x16, 0xa4, 0x24, 0x96, 0xa4, 0x06, 0x80, 0x8d,
-	0x12, 0x32, 0xe3, 0xe2, 0x4c, 0x2c, 0x2d, 0xc9, 0xc8, 0x2f, 0xca, 0x2c, 0xa9, 0x94, 0x60, 0x54,
-	0x60, 0xd4, 0xe0, 0x74, 0x92, 0xb8, 0xb4, 0x45, 0x57, 0x04, 0xaa, 0xd1, 0x31, 0x25, 0xa5, 0x28,
-	0xb5, 0xb8, 0x38, 0xb8, 0xa4, 0x28, 0x33, 0x2f, 0x3d, 0x08, 0xa1, 0x54, 0xc8, 0x91, 0x8b, 0x0d,
-	0xe2, 0x18, 0x09, 0x26, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x39, 0x3d, 0xec, 0xde, 0xd6, 0x83, 0xd8,
-	0xe3, 0xc4, 0x79, 0xe2, 0x9e, 0x3c, 0xc3, 0x8a, 0xe7, 0x1b, 0xb4, 0x18, 0x83, 0xa0, 0x1a, 0xad,
-	0xcc, 0x9b, 0x9e, 0x6f, 0xd0, 0x42, 0x18, 0xd9, 0xf5, 0x7c, 0x83, 0x96, 0x0a, 0xc4, 0x10, 0xdd,
-	0xe2, 0x94, 0x6c, 0xfd, 0x0a, 0xb8, 0xef, 0xd1, 0xdc, 0xac, 0x24, 0xc9, 0x25, 0x8e, 0x26, 0x14,
-	0x94, 0x5a, 0x5c, 0x90, 0x9f, 0x57, 0x9c, 0x6a, 0x54, 0xc6, 0xc5, 0xec, 0x5b, 0x9c, 0x2e, 0x94,
-	0xc1, 0xc5, 0x83, 0xe2, 0x4b, 0x75, 0x5c, 0xae, 0x43, 0x33, 0x47, 0x4a, 0x9f, 0x48, 0x85, 0x30,
-	0x0b, 0xa5, 0x58, 0x1b, 0x40, 0x7e, 0x72, 0x0a, 0x3e, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39,
-	0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63,
-	0x39, 0x86, 0x28, 0xcb, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0xfd, 0xd2,
-	0xbc, 0xcc, 0xf4, 0xa2, 0xcc, 0x14, 0xdd, 0x82, 0xa2, 0xfc, 0xac, 0xd4, 0xe4, 0x12, 0x68, 0xec,
-	0xe8, 0xc2, 0xfc, 0x89, 0xf0, 0x71, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0x38, 0xda, 0x8c,
-	0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x89, 0x4e, 0x20, 0x0d, 0x73, 0x02, 0x00, 0x00,
+func (m *MsgSetMintingPaused) Reset()         { *m = MsgSetMintingPaused{} }
+func (m *MsgSetMintingPaused) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMintingPaused) ProtoMessage()    {}
+func (*MsgSetMintingPaused) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7cb34e3ab58be18c, []int{2}
+}
+func (m *MsgSetMintingPaused) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMintingPaused) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMintingPaused.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetMintingPaused) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMintingPaused.Merge(m, src)
+}
+func (m *MsgSetMintingPaused) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMintingPaused) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMintingPaused.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_MsgSetMintingPaused proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *MsgSetMintingPaused) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateParams defines a governance operation for updating the x/ugdmint module
-	// parameters. The authority is defaults to the x/gov module account.
-	//
-	// Since: cosmos-sdk 0.47
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+func (m *MsgSetMintingPaused) GetBlockProvisionsPaused() bool {
+	if m != nil {
+		return m.BlockProvisionsPaused
+	}
+	return false
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgSetMintingPaused) GetHedgehogMintsPaused() bool {
+	if m != nil {
+		return m.HedgehogMintsPaused
+	}
+	return false
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgSetMintingPaused) GetResumeHeight() int64 {
+	if m != nil {
+		return m.ResumeHeight
+	}
+	return 0
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.ugdmint.v1beta1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+// MsgSetMintingPausedResponse defines the response structure for executing a
+// MsgSetMintingPaused message.
+type MsgSetMintingPausedResponse struct {
+}
+
+func (m *MsgSetMintingPausedResponse) Reset()         { *m = MsgSetMintingPausedResponse{} }
+func (m *MsgSetMintingPausedResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMintingPausedResponse) ProtoMessage()    {}
+func (*MsgSetMintingPausedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7cb34e3ab58be18c, []int{3}
+}
+func (m *MsgSetMintingPausedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMintingPausedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMintingPausedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSetMintingPausedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMintingPausedResponse.Merge(m, src)
+}
+func (m *MsgSetMintingPausedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMintingPausedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMintingPausedResponse.DiscardUnknown(m)
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UpdateParams defines a governance operation for updating the x/ugdmint module
-	// parameters. The authority is defaults to the x/gov module account.
+var xxx_messageInfo_MsgSetMintingPausedResponse proto.InternalMessageInfo
+
+// MsgScheduleParamsChange is the Msg/ScheduleParamsChange request type. It
+// replaces any change already scheduled for the same height.
+type MsgScheduleParamsChange struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// activation_height is the future height of the block that applies the
+	// params.
+	ActivationHeight int64 `protobuf:"varint,2,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	// params defines the x/ugdmint parameters applied at activation_height.
 	//
-	// Since: cosmos-sdk 0.47
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgScheduleParamsChange) Reset()         { *m = MsgScheduleParamsChange{} }
+func (m *MsgScheduleParamsChange) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleParamsChange) ProtoMessage()    {}
+func (*MsgScheduleParamsChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7cb34e3ab58be18c, []int{4}
+}
+func (m *MsgScheduleParamsChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleParamsChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleParamsChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleParamsChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleParamsChange.Merge(m, src)
+}
+func (m *MsgScheduleParamsChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleParamsChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleParamsChange.DiscardUnknown(m)
 }
 
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+var xxx_messageInfo_MsgScheduleParamsChange proto.InternalMessageInfo
+
+func (m *MsgScheduleParamsChange) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgScheduleParamsChange) GetActivationHeight() int64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgScheduleParamsChange) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
+	return Params{}
+}
+
+// MsgScheduleParamsChangeResponse defines the response structure for executing a
+// MsgScheduleParamsChange message.
+type MsgScheduleParamsChangeResponse struct {
+}
+
+func (m *MsgScheduleParamsChangeResponse) Reset()         { *m = MsgScheduleParamsChangeResponse{} }
+func (m *MsgScheduleParamsChangeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleParamsChangeResponse) ProtoMessage()    {}
+func (*MsgScheduleParamsChangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7cb34e3ab58be18c, []int{5}
+}
+func (m *MsgScheduleParamsChangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleParamsChangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleParamsChangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.ugdmint.v1beta1.Msg/UpdateParams",
+}
+func (m *MsgScheduleParamsChangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleParamsChangeResponse.Merge(m, src)
+}
+func (m *MsgScheduleParamsChangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleParamsChangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleParamsChangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleParamsChangeResponse proto.InternalMessageInfo
+
+// MsgCancelParamsChange is the Msg/CancelParamsChange request type.
+type MsgCancelParamsChange struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// activation_height is the height of the scheduled change to cancel.
+	ActivationHeight int64 `protobuf:"varint,2,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+}
+
+func (m *MsgCancelParamsChange) Reset()         { *m = MsgCancelParamsChange{} }
+func (m *MsgCancelParamsChange) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelParamsChange) ProtoMessage()    {}
+func (*MsgCancelParamsChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7cb34e3ab58be18c, []int{6}
+}
+func (m *MsgCancelParamsChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelParamsChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelParamsChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+}
+func (m *MsgCancelParamsChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelParamsChange.Merge(m, src)
+}
+func (m *MsgCancelParamsChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelParamsChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelParamsChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelParamsChange proto.InternalMessageInfo
+
+func (m *MsgCancelParamsChange) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cosmos.ugdmint.v1beta1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "cosmos/ugdmint/v1beta1/tx.proto",
+func (m *MsgCancelParamsChange) GetActivationHeight() int64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// MsgCancelParamsChangeResponse defines the response structure for executing a
+// MsgCancelParamsChange message.
+type MsgCancelParamsChangeResponse struct {
+}
+
+func (m *MsgCancelParamsChangeResponse) Reset()         { *m = MsgCancelParamsChangeResponse{} }
+func (m *MsgCancelParamsChangeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelParamsChangeResponse) ProtoMessage()    {}
+func (*MsgCancelParamsChangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7cb34e3ab58be18c, []int{7}
+}
+func (m *MsgCancelParamsChangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelParamsChangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelParamsChangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgCancelParamsChangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelParamsChangeResponse.Merge(m, src)
+}
+func (m *MsgCancelParamsChangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelParamsChangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelParamsChangeResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgCancelParamsChangeResponse proto.InternalMessageInfo
+
+// MsgClaimVested is the Msg/ClaimVested request type.
+type MsgClaimVested struct {
+	// recipient is the address the escrowed coins were minted to.
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgClaimVested) Reset()         { *m = MsgClaimVested{} }
+func (m *MsgClaimVested) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimVested) ProtoMessage()    {}
+func (*MsgClaimVested) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7cb34e3ab58be18c, []int{8}
+}
+func (m *MsgClaimVested) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimVested) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimVested.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimVested) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimVested.Merge(m, src)
+}
+func (m *MsgClaimVested) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimVested) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimVested.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimVested proto.InternalMessageInfo
+
+func (m *MsgClaimVested) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+// MsgClaimVestedResponse defines the response structure for executing a
+// MsgClaimVested message.
+type MsgClaimVestedResponse struct {
+	// amount is the coins paid to the recipient.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgClaimVestedResponse) Reset()         { *m = MsgClaimVestedResponse{} }
+func (m *MsgClaimVestedResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimVestedResponse) ProtoMessage()    {}
+func (*MsgClaimVestedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7cb34e3ab58be18c, []int{9}
+}
+func (m *MsgClaimVestedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimVestedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimVestedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimVestedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimVestedResponse.Merge(m, src)
+}
+func (m *MsgClaimVestedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimVestedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimVestedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimVestedResponse proto.InternalMessageInfo
+
+func (m *MsgClaimVestedResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// MsgClawbackMint is the Msg/ClawbackMint request type.
+type MsgClawbackMint struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// address is the bech32 address the mint was paid to.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// mint_id is the height the mint was scheduled for by Hedgehog, which
+	// identifies the mint of the address like the address/height key of the
+	// processed mints.
+	MintId uint64 `protobuf:"varint,3,opt,name=mint_id,json=mintId,proto3" json:"mint_id,omitempty"`
+	// destination of the clawed back coins, either community_pool or burn.
+	Destination string `protobuf:"bytes,4,opt,name=destination,proto3" json:"destination,omitempty"`
+}
+
+func (m *MsgClawbackMint) Reset()         { *m = MsgClawbackMint{} }
+func (m *MsgClawbackMint) String() string { return proto.CompactTextString(m) }
+func (*MsgClawbackMint) ProtoMessage()    {}
+func (*MsgClawbackMint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7cb34e3ab58be18c, []int{10}
+}
+func (m *MsgClawbackMint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClawbackMint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClawbackMint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClawbackMint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClawbackMint.Merge(m, src)
+}
+func (m *MsgClawbackMint) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClawbackMint) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClawbackMint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClawbackMint proto.InternalMessageInfo
+
+func (m *MsgClawbackMint) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgClawbackMint) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MsgClawbackMint) GetMintId() uint64 {
+	if m != nil {
+		return m.MintId
+	}
+	return 0
+}
+
+func (m *MsgClawbackMint) GetDestination() string {
+	if m != nil {
+		return m.Destination
+	}
+	return ""
+}
+
+// MsgClawbackMintResponse defines the response structure for executing a
+// MsgClawbackMint message.
+type MsgClawbackMintResponse struct {
+	// amount is the coins that were clawed back.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgClawbackMintResponse) Reset()         { *m = MsgClawbackMintResponse{} }
+func (m *MsgClawbackMintResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClawbackMintResponse) ProtoMessage()    {}
+func (*MsgClawbackMintResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7cb34e3ab58be18c, []int{11}
+}
+func (m *MsgClawbackMintResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClawbackMintResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClawbackMintResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClawbackMintResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClawbackMintResponse.Merge(m, src)
+}
+func (m *MsgClawbackMintResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClawbackMintResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClawbackMintResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClawbackMintResponse proto.InternalMessageInfo
+
+func (m *MsgClawbackMintResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.ugdmint.v1beta1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.ugdmint.v1beta1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSetMintingPaused)(nil), "cosmos.ugdmint.v1beta1.MsgSetMintingPaused")
+	proto.RegisterType((*MsgSetMintingPausedResponse)(nil), "cosmos.ugdmint.v1beta1.MsgSetMintingPausedResponse")
+	proto.RegisterType((*MsgScheduleParamsChange)(nil), "cosmos.ugdmint.v1beta1.MsgScheduleParamsChange")
+	proto.RegisterType((*MsgScheduleParamsChangeResponse)(nil), "cosmos.ugdmint.v1beta1.MsgScheduleParamsChangeResponse")
+	proto.RegisterType((*MsgCancelParamsChange)(nil), "cosmos.ugdmint.v1beta1.MsgCancelParamsChange")
+	proto.RegisterType((*MsgCancelParamsChangeResponse)(nil), "cosmos.ugdmint.v1beta1.MsgCancelParamsChangeResponse")
+	proto.RegisterType((*MsgClaimVested)(nil), "cosmos.ugdmint.v1beta1.MsgClaimVested")
+	proto.RegisterType((*MsgClaimVestedResponse)(nil), "cosmos.ugdmint.v1beta1.MsgClaimVestedResponse")
+	proto.RegisterType((*MsgClawbackMint)(nil), "cosmos.ugdmint.v1beta1.MsgClawbackMint")
+	proto.RegisterType((*MsgClawbackMintResponse)(nil), "cosmos.ugdmint.v1beta1.MsgClawbackMintResponse")
+}
+
+func init() { proto.RegisterFile("cosmos/ugdmint/v1beta1/tx.proto", fileDescriptor_7cb34e3ab58be18c) }
+
+var fileDescriptor_7cb34e3ab58be18c = []byte{
+	// 876 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xbf, 0x6f, 0x23, 0x45,
+	0x14, 0xce, 0xc6, 0x77, 0x39, 0x32, 0x09, 0x70, 0xb7, 0x97, 0x5c, 0x9c, 0x3d, 0x65, 0x1d, 0x36,
+	0x88, 0xb3, 0x72, 0xca, 0x2e, 0xf1, 0x89, 0x9c, 0x48, 0x77, 0x89, 0x84, 0xa0, 0xb0, 0x14, 0xd9,
+	0x82, 0x82, 0xc6, 0x1a, 0xef, 0x8e, 0x66, 0x87, 0x78, 0x77, 0x56, 0x3b, 0xb3, 0xe6, 0x4c, 0x15,
+	0x51, 0x52, 0x20, 0x24, 0xa8, 0xf8, 0x0b, 0x10, 0x05, 0x72, 0x41, 0x49, 0x8b, 0x74, 0xe5, 0x89,
+	0x8a, 0x0a, 0x50, 0x52, 0xb8, 0xa6, 0xa0, 0x47, 0x3b, 0xb3, 0x3b, 0xde, 0xf8, 0xd7, 0xc5, 0x2e,
+	0x10, 0x4d, 0x62, 0xbf, 0xf7, 0xbd, 0x1f, 0xdf, 0xb7, 0xef, 0xbd, 0x35, 0xa8, 0xb8, 0x94, 0x05,
+	0x94, 0x39, 0x09, 0xf6, 0x02, 0x12, 0x72, 0xa7, 0x7b, 0xd8, 0x46, 0x1c, 0x1e, 0x3a, 0xfc, 0xb9,
+	0x1d, 0xc5, 0x94, 0x53, 0xfd, 0x81, 0x04, 0xd8, 0x19, 0xc0, 0xce, 0x00, 0xc6, 0x56, 0x16, 0x18,
+	0x30, 0xec, 0x74, 0x0f, 0xd3, 0x7f, 0x32, 0xc0, 0xb8, 0x07, 0x03, 0x12, 0x52, 0x47, 0xfc, 0xcd,
+	0x4c, 0x7b, 0x53, 0x8a, 0x44, 0x30, 0x86, 0x01, 0xcb, 0x40, 0x1b, 0x98, 0x62, 0x2a, 0x3e, 0x3a,
+	0xe9, 0xa7, 0xcc, 0xba, 0x2d, 0x43, 0x5b, 0xd2, 0x91, 0xf5, 0x22, 0x5d, 0x66, 0x96, 0xb5, 0x0d,
+	0x19, 0x52, 0x29, 0x5d, 0x4a, 0x42, 0xe9, 0xb7, 0x7e, 0xd5, 0xc0, 0x9b, 0x75, 0x86, 0x3f, 0x8e,
+	0x3c, 0xc8, 0xd1, 0x99, 0x28, 0xa5, 0x1f, 0x81, 0x55, 0x98, 0x70, 0x9f, 0xc6, 0x84, 0xf7, 0xca,
+	0xda, 0xae, 0x56, 0x5d, 0x3d, 0x29, 0xff, 0xf6, 0xf3, 0xc1, 0x46, 0x96, 0xf8, 0x99, 0xe7, 0xc5,
+	0x88, 0xb1, 0x26, 0x8f, 0x49, 0x88, 0x1b, 0x43, 0xa8, 0xfe, 0x0c, 0xac, 0xc8, 0x66, 0xcb, 0xcb,
+	0xbb, 0x5a, 0x75, 0xad, 0x66, 0xda, 0x93, 0x65, 0xb1, 0x65, 0x9d, 0x93, 0xd5, 0x17, 0x7f, 0x54,
+	0x96, 0x7e, 0x18, 0xf4, 0xf7, 0xb5, 0x46, 0x16, 0x78, 0xfc, 0xf4, 0xcb, 0x41, 0x7f, 0x7f, 0x98,
+	0xf2, 0xab, 0x41, 0x7f, 0xff, 0x6d, 0x99, 0xe4, 0x80, 0x79, 0xe7, 0xce, 0x73, 0xa5, 0xce, 0x48,
+	0xcf, 0xd6, 0x36, 0xd8, 0x1a, 0x31, 0x35, 0x10, 0x8b, 0x68, 0xc8, 0x90, 0xf5, 0xf5, 0x32, 0xb8,
+	0x5f, 0x67, 0xb8, 0x89, 0x78, 0x9d, 0x84, 0x9c, 0x84, 0xf8, 0x0c, 0x26, 0x0c, 0x79, 0x0b, 0xd3,
+	0x3c, 0x02, 0x5b, 0xed, 0x0e, 0x75, 0xcf, 0x53, 0xb9, 0xbb, 0x84, 0x11, 0x1a, 0xb2, 0x56, 0x24,
+	0x52, 0x0a, 0xde, 0xaf, 0x35, 0x36, 0x85, 0xfb, 0x4c, 0x79, 0xb3, 0x7a, 0x35, 0xb0, 0xe9, 0x23,
+	0x0f, 0x23, 0x9f, 0xe2, 0x56, 0xca, 0x41, 0x45, 0x95, 0x44, 0xd4, 0xfd, 0xdc, 0x99, 0x76, 0x99,
+	0xc7, 0xec, 0x81, 0xd7, 0x63, 0xc4, 0x92, 0x00, 0xb5, 0x7c, 0x44, 0xb0, 0xcf, 0xcb, 0xb7, 0x76,
+	0xb5, 0x6a, 0xa9, 0xb1, 0x2e, 0x8d, 0x1f, 0x0a, 0xdb, 0xb1, 0x3d, 0x2e, 0xda, 0xc3, 0x82, 0x4e,
+	0xa3, 0xc4, 0xad, 0x1d, 0xf0, 0x70, 0x82, 0x59, 0xe9, 0xf5, 0x8f, 0x26, 0xb4, 0x6c, 0xba, 0x3e,
+	0xf2, 0x92, 0x4e, 0xa6, 0xe6, 0xa9, 0x0f, 0x43, 0x8c, 0x16, 0xd6, 0xec, 0x31, 0xb8, 0x07, 0x5d,
+	0x4e, 0xba, 0x90, 0x13, 0x1a, 0xe6, 0x5c, 0x96, 0x05, 0x97, 0xbb, 0x43, 0x87, 0xe4, 0x53, 0x98,
+	0xa3, 0xd2, 0xa2, 0x73, 0x54, 0x1b, 0x97, 0xa4, 0x52, 0x94, 0x64, 0x02, 0x37, 0xeb, 0x2d, 0x50,
+	0x99, 0xe2, 0x52, 0xd2, 0xfc, 0xa4, 0x81, 0xcd, 0x3a, 0xc3, 0xa7, 0x30, 0x74, 0x51, 0xe7, 0x3f,
+	0x17, 0xe6, 0xf8, 0xdd, 0x71, 0x56, 0x3b, 0x05, 0x56, 0xe3, 0x6d, 0x59, 0x15, 0xb0, 0x33, 0xd1,
+	0xa1, 0x18, 0x5d, 0x68, 0xe0, 0x8d, 0x14, 0xd1, 0x81, 0x24, 0xf8, 0x04, 0x31, 0x2e, 0xf7, 0x22,
+	0x46, 0x2e, 0x89, 0x08, 0x0a, 0xf9, 0xab, 0xa9, 0x28, 0xe8, 0xf1, 0x91, 0xe8, 0x4e, 0x7d, 0x4f,
+	0xbb, 0xdb, 0x9b, 0xb6, 0xbb, 0x85, 0x7a, 0xd6, 0xb7, 0x1a, 0x78, 0x70, 0xdd, 0x94, 0x77, 0xa7,
+	0xf7, 0xc0, 0x0a, 0x0c, 0x68, 0x22, 0xfa, 0x28, 0x55, 0xd7, 0x6a, 0xdb, 0xf9, 0x24, 0xa4, 0xe7,
+	0x4c, 0x8d, 0xc1, 0x29, 0x25, 0xe1, 0xc9, 0x07, 0xe9, 0x10, 0xfc, 0xf8, 0x67, 0xa5, 0x8a, 0x09,
+	0xf7, 0x93, 0xb6, 0xed, 0xd2, 0x20, 0xbb, 0x84, 0x4e, 0xa1, 0x09, 0xde, 0x8b, 0x10, 0x13, 0x01,
+	0xec, 0xfb, 0x41, 0x7f, 0x7f, 0xbd, 0x83, 0x30, 0x74, 0x7b, 0xad, 0xf4, 0x20, 0xb2, 0x6c, 0x82,
+	0x64, 0x41, 0xeb, 0x6f, 0x79, 0x18, 0x4f, 0x3b, 0xf0, 0xf3, 0x36, 0x74, 0xcf, 0xd3, 0x55, 0x59,
+	0xf8, 0x21, 0xd7, 0xc0, 0x1d, 0x28, 0x7d, 0xe5, 0xe5, 0x57, 0x44, 0xe5, 0x40, 0x7d, 0x0b, 0xdc,
+	0x49, 0xc5, 0x6a, 0x11, 0x79, 0x1f, 0x6e, 0x35, 0x56, 0xd2, 0xaf, 0x1f, 0x79, 0xfa, 0x2e, 0x58,
+	0xf3, 0x10, 0xe3, 0x24, 0x14, 0x93, 0x21, 0x0e, 0xc2, 0x6a, 0xa3, 0x68, 0x9a, 0xeb, 0x88, 0x16,
+	0xf9, 0x59, 0xdf, 0xc9, 0xcd, 0x2f, 0xda, 0xfe, 0x07, 0x8f, 0xa2, 0xf6, 0xcb, 0x6d, 0x50, 0xaa,
+	0x33, 0xac, 0xfb, 0x60, 0xfd, 0xda, 0x7b, 0xea, 0xd1, 0xb4, 0xbb, 0x30, 0xf2, 0x26, 0x30, 0x9c,
+	0x1b, 0x02, 0x15, 0x59, 0x0e, 0xee, 0x8e, 0xbd, 0x2e, 0x1e, 0xcf, 0x48, 0x32, 0x0a, 0x36, 0x9e,
+	0xcc, 0x01, 0x56, 0x55, 0x2f, 0x34, 0xb0, 0x31, 0xf1, 0xea, 0xce, 0xea, 0x7f, 0x52, 0x80, 0xf1,
+	0x74, 0xce, 0x00, 0xd5, 0xc2, 0x17, 0x40, 0x9f, 0x70, 0xdc, 0x0e, 0x66, 0xa4, 0x1b, 0x87, 0x1b,
+	0xef, 0xcd, 0x05, 0x57, 0xb5, 0x11, 0x58, 0x2b, 0x9e, 0xa1, 0x77, 0x66, 0x65, 0x19, 0xe2, 0x0c,
+	0xfb, 0x66, 0x38, 0x55, 0xc6, 0x07, 0xeb, 0xd7, 0x96, 0xfa, 0xd1, 0xec, 0x78, 0x05, 0x34, 0x9c,
+	0x1b, 0x02, 0xf3, 0x4a, 0xc6, 0xed, 0x8b, 0x74, 0x8c, 0x4f, 0x9a, 0x2f, 0x2e, 0x4d, 0xed, 0xe5,
+	0xa5, 0xa9, 0xfd, 0x75, 0x69, 0x6a, 0xdf, 0x5c, 0x99, 0x4b, 0x2f, 0xaf, 0xcc, 0xa5, 0xdf, 0xaf,
+	0xcc, 0xa5, 0x4f, 0xdf, 0x2f, 0x2c, 0x48, 0x12, 0x12, 0x1c, 0x13, 0xef, 0x20, 0x8a, 0xe9, 0x67,
+	0xc8, 0xe5, 0xf9, 0xa6, 0xe4, 0xab, 0x3a, 0x5c, 0x5a, 0xb1, 0x37, 0xed, 0x15, 0xf1, 0xf3, 0xed,
+	0xc9, 0xbf, 0x03, 0x00, 0x85, 0x33, 0xa1, 0xc3, 0x9b, 0x0a, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defines a governance operation for updating the x/ugdmint module
+	// parameters. The authority is defaults to the x/gov module account.
+	//
+	// Since: cosmos-sdk 0.47
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SetMintingPaused defines a governance operation for pausing and resuming
+	// the block provisions and the Hedgehog mints.
+	SetMintingPaused(ctx context.Context, in *MsgSetMintingPaused, opts ...grpc.CallOption) (*MsgSetMintingPausedResponse, error)
+	// ScheduleParamsChange defines a governance operation for replacing the
+	// x/ugdmint module parameters at a future height.
+	ScheduleParamsChange(ctx context.Context, in *MsgScheduleParamsChange, opts ...grpc.CallOption) (*MsgScheduleParamsChangeResponse, error)
+	// CancelParamsChange defines a governance operation for cancelling a
+	// scheduled params change.
+	CancelParamsChange(ctx context.Context, in *MsgCancelParamsChange, opts ...grpc.CallOption) (*MsgCancelParamsChangeResponse, error)
+	// ClaimVested pays a recipient the escrowed coins of its Hedgehog mints
+	// that have unlocked.
+	ClaimVested(ctx context.Context, in *MsgClaimVested, opts ...grpc.CallOption) (*MsgClaimVestedResponse, error)
+	// ClawbackMint defines a governance operation for taking back the coins of
+	// a Hedgehog mint that have not vested yet.
+	ClawbackMint(ctx context.Context, in *MsgClawbackMint, opts ...grpc.CallOption) (*MsgClawbackMintResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.ugdmint.v1beta1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetMintingPaused(ctx context.Context, in *MsgSetMintingPaused, opts ...grpc.CallOption) (*MsgSetMintingPausedResponse, error) {
+	out := new(MsgSetMintingPausedResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.ugdmint.v1beta1.Msg/SetMintingPaused", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ScheduleParamsChange(ctx context.Context, in *MsgScheduleParamsChange, opts ...grpc.CallOption) (*MsgScheduleParamsChangeResponse, error) {
+	out := new(MsgScheduleParamsChangeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.ugdmint.v1beta1.Msg/ScheduleParamsChange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelParamsChange(ctx context.Context, in *MsgCancelParamsChange, opts ...grpc.CallOption) (*MsgCancelParamsChangeResponse, error) {
+	out := new(MsgCancelParamsChangeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.ugdmint.v1beta1.Msg/CancelParamsChange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimVested(ctx context.Context, in *MsgClaimVested, opts ...grpc.CallOption) (*MsgClaimVestedResponse, error) {
+	out := new(MsgClaimVestedResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.ugdmint.v1beta1.Msg/ClaimVested", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClawbackMint(ctx context.Context, in *MsgClawbackMint, opts ...grpc.CallOption) (*MsgClawbackMintResponse, error) {
+	out := new(MsgClawbackMintResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.ugdmint.v1beta1.Msg/ClawbackMint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams defines a governance operation for updating the x/ugdmint module
+	// parameters. The authority is defaults to the x/gov module account.
+	//
+	// Since: cosmos-sdk 0.47
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SetMintingPaused defines a governance operation for pausing and resuming
+	// the block provisions and the Hedgehog mints.
+	SetMintingPaused(context.Context, *MsgSetMintingPaused) (*MsgSetMintingPausedResponse, error)
+	// ScheduleParamsChange defines a governance operation for replacing the
+	// x/ugdmint module parameters at a future height.
+	ScheduleParamsChange(context.Context, *MsgScheduleParamsChange) (*MsgScheduleParamsChangeResponse, error)
+	// CancelParamsChange defines a governance operation for cancelling a
+	// scheduled params change.
+	CancelParamsChange(context.Context, *MsgCancelParamsChange) (*MsgCancelParamsChangeResponse, error)
+	// ClaimVested pays a recipient the escrowed coins of its Hedgehog mints
+	// that have unlocked.
+	ClaimVested(context.Context, *MsgClaimVested) (*MsgClaimVestedResponse, error)
+	// ClawbackMint defines a governance operation for taking back the coins of
+	// a Hedgehog mint that have not vested yet.
+	ClawbackMint(context.Context, *MsgClawbackMint) (*MsgClawbackMintResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) SetMintingPaused(ctx context.Context, req *MsgSetMintingPaused) (*MsgSetMintingPausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMintingPaused not implemented")
+}
+func (*UnimplementedMsgServer) ScheduleParamsChange(ctx context.Context, req *MsgScheduleParamsChange) (*MsgScheduleParamsChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleParamsChange not implemented")
+}
+func (*UnimplementedMsgServer) CancelParamsChange(ctx context.Context, req *MsgCancelParamsChange) (*MsgCancelParamsChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelParamsChange not implemented")
+}
+func (*UnimplementedMsgServer) ClaimVested(ctx context.Context, req *MsgClaimVested) (*MsgClaimVestedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimVested not implemented")
+}
+func (*UnimplementedMsgServer) ClawbackMint(ctx context.Context, req *MsgClawbackMint) (*MsgClawbackMintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClawbackMint not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.ugdmint.v1beta1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetMintingPaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMintingPaused)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMintingPaused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.ugdmint.v1beta1.Msg/SetMintingPaused",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMintingPaused(ctx, req.(*MsgSetMintingPaused))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ScheduleParamsChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleParamsChange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleParamsChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.ugdmint.v1beta1.Msg/ScheduleParamsChange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleParamsChange(ctx, req.(*MsgScheduleParamsChange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelParamsChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelParamsChange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelParamsChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.ugdmint.v1beta1.Msg/CancelParamsChange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelParamsChange(ctx, req.(*MsgCancelParamsChange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimVested_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimVested)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimVested(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.ugdmint.v1beta1.Msg/ClaimVested",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimVested(ctx, req.(*MsgClaimVested))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClawbackMint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClawbackMint)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClawbackMint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.ugdmint.v1beta1.Msg/ClawbackMint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClawbackMint(ctx, req.(*MsgClawbackMint))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.ugdmint.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "SetMintingPaused",
+			Handler:    _Msg_SetMintingPaused_Handler,
+		},
+		{
+			MethodName: "ScheduleParamsChange",
+			Handler:    _Msg_ScheduleParamsChange_Handler,
+		},
+		{
+			MethodName: "CancelParamsChange",
+			Handler:    _Msg_CancelParamsChange_Handler,
+		},
+		{
+			MethodName: "ClaimVested",
+			Handler:    _Msg_ClaimVested_Handler,
+		},
+		{
+			MethodName: "ClawbackMint",
+			Handler:    _Msg_ClawbackMint_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/ugdmint/v1beta1/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMintingPaused) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMintingPaused) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMintingPaused) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ResumeHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ResumeHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.HedgehogMintsPaused {
+		i--
+		if m.HedgehogMintsPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BlockProvisionsPaused {
+		i--
+		if m.BlockProvisionsPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMintingPausedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMintingPausedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMintingPausedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleParamsChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleParamsChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleParamsChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.ActivationHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleParamsChangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleParamsChangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleParamsChangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelParamsChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelParamsChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelParamsChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActivationHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelParamsChangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelParamsChangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelParamsChangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimVested) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimVested) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimVested) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimVestedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimVestedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimVestedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClawbackMint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClawbackMint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClawbackMint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MintId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MintId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClawbackMintResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClawbackMintResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClawbackMintResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetMintingPaused) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BlockProvisionsPaused {
+		n += 2
+	}
+	if m.HedgehogMintsPaused {
+		n += 2
+	}
+	if m.ResumeHeight != 0 {
+		n += 1 + sovTx(uint64(m.ResumeHeight))
+	}
+	return n
+}
+
+func (m *MsgSetMintingPausedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgScheduleParamsChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovTx(uint64(m.ActivationHeight))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgScheduleParamsChangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelParamsChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovTx(uint64(m.ActivationHeight))
+	}
+	return n
+}
+
+func (m *MsgCancelParamsChangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimVested) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimVestedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgClawbackMint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MintId != 0 {
+		n += 1 + sovTx(uint64(m.MintId))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClawbackMintResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetMintingPaused) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetMintingPaused: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetMintingPaused: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockProvisionsPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BlockProvisionsPaused = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HedgehogMintsPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HedgehogMintsPaused = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResumeHeight", wireType)
+			}
+			m.ResumeHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResumeHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetMintingPausedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetMintingPausedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetMintingPausedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgScheduleParamsChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleParamsChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleParamsChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgScheduleParamsChangeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleParamsChangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleParamsChangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelParamsChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelParamsChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelParamsChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelParamsChangeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelParamsChangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelParamsChangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimVested) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimVested: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimVested: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgClaimVestedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimVestedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimVestedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgClawbackMint) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -379,10 +2515,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClawbackMint: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClawbackMint: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -419,9 +2555,9 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -431,24 +2567,74 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintId", wireType)
+			}
+			m.MintId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MintId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Destination = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -471,7 +2657,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClawbackMintResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -494,12 +2680,46 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClawbackMintResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClawbackMintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -0,0 +1,241 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// ContinuousUnlockSeconds is the interval continuous vesting is unlocked at
+// once it is merged into a periodic vesting account. The unlocks are aligned
+// to multiples of the interval, so merged tranches share their unlock times.
+const ContinuousUnlockSeconds = 24 * 60 * 60
+
+// MergeVestingAccount returns acc vesting coins as an additional tranche under
+// the policy, starting at now. The result is a periodic vesting account that
+// keeps the schedule of acc: coins that are vested at now stay vested, coins
+// that are still vesting unlock at their original times and the delegated
+// vesting and delegated free coins are carried over. Continuous vesting, of
+// acc or of the tranche, unlocks every ContinuousUnlockSeconds instead, never
+// earlier than it would have continuously. Continuous vesting of acc that
+// would unlock more than MaxVestingUnlocks times unlocks every multiple of
+// ContinuousUnlockSeconds that keeps it within MaxVestingUnlocks unlocks.
+//
+// Delayed, continuous and periodic vesting accounts can be merged into. The
+// vesting policy none has no tranche to merge.
+func (p VestingPolicy) MergeVestingAccount(acc vestexported.VestingAccount, coins sdk.Coins, now int64) (*vestingtypes.PeriodicVestingAccount, error) {
+	if p.Kind == VestingNone {
+		return nil, fmt.Errorf("vesting policy %s has no vesting to merge", p.Kind)
+	}
+
+	bva, startTime, err := baseVestingAccount(acc, now)
+	if err != nil {
+		return nil, err
+	}
+
+	unlocks, err := vestingUnlocks(acc, now)
+	if err != nil {
+		return nil, err
+	}
+	tranche, err := p.NewVestingAccount(authtypes.NewBaseAccountWithAddress(acc.GetAddress()), coins, now)
+	if err != nil {
+		return nil, err
+	}
+	trancheUnlocks, err := vestingUnlocks(tranche.(vestexported.VestingAccount), now)
+	if err != nil {
+		return nil, err
+	}
+
+	stillVesting := sdk.NewCoins()
+	for _, amount := range unlocks {
+		stillVesting = stillVesting.Add(amount...)
+	}
+	for t, amount := range trancheUnlocks {
+		unlocks[t] = unlocks[t].Add(amount...)
+	}
+
+	return periodicVestingAccount(&vestingtypes.BaseVestingAccount{
+		BaseAccount:      bva.BaseAccount,
+		OriginalVesting:  bva.OriginalVesting.Add(coins...),
+		DelegatedFree:    bva.DelegatedFree,
+		DelegatedVesting: bva.DelegatedVesting,
+	}, startTime, now, bva.OriginalVesting.Sub(stillVesting...), unlocks)
+}
+
+// ClawbackVestingAccount takes the coins of a mint that have not vested at now
+// out of the schedule of acc. unlocks are the unlocks of the mint; the coins
+// that unlock after now are taken from the unlocks of acc at the same times
+// and, where acc no longer vests them at those times, from its last unlocks.
+// No more than the coins still vesting in acc are taken.
+//
+// It returns the account without the clawed back coins along with those
+// coins. The account is a periodic vesting account like the one of
+// MergeVestingAccount, or its base account when nothing is left to vest.
+// Delegated vesting coins in excess of the coins still vesting are moved to
+// the delegated free coins.
+func ClawbackVestingAccount(acc vestexported.VestingAccount, unlocks []EscrowUnlock, now int64) (sdk.AccountI, sdk.Coins, error) {
+	bva, startTime, err := baseVestingAccount(acc, now)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	accUnlocks, err := vestingUnlocks(acc, now)
+	if err != nil {
+		return nil, nil, err
+	}
+	stillVesting := sdk.NewCoins()
+	for _, amount := range accUnlocks {
+		stillVesting = stillVesting.Add(amount...)
+	}
+
+	unvested := sdk.NewCoins()
+	for _, unlock := range unlocks {
+		if unlock.UnlockTime > now {
+			unvested = unvested.Add(unlock.Amount...)
+		}
+	}
+	clawback := unvested.Min(stillVesting)
+
+	remaining := clawback
+	take := func(t int64, amount sdk.Coins) {
+		taken := amount.Min(accUnlocks[t]).Min(remaining)
+		accUnlocks[t] = accUnlocks[t].Sub(taken...)
+		if accUnlocks[t].IsZero() {
+			delete(accUnlocks, t)
+		}
+		remaining = remaining.Sub(taken...)
+	}
+	for _, unlock := range unlocks {
+		if unlock.UnlockTime > now {
+			take(unlock.UnlockTime, unlock.Amount)
+		}
+	}
+	for _, t := range sortedTimes(accUnlocks, true) {
+		take(t, remaining)
+	}
+
+	vesting := stillVesting.Sub(clawback...)
+	originalVesting := bva.OriginalVesting.Sub(clawback...)
+	if originalVesting.IsZero() {
+		return bva.BaseAccount, clawback, nil
+	}
+
+	delegatedVesting := bva.DelegatedVesting.Min(vesting)
+	clawedback, err := periodicVestingAccount(&vestingtypes.BaseVestingAccount{
+		BaseAccount:      bva.BaseAccount,
+		OriginalVesting:  originalVesting,
+		DelegatedFree:    bva.DelegatedFree.Add(bva.DelegatedVesting.Sub(delegatedVesting...)...),
+		DelegatedVesting: delegatedVesting,
+	}, startTime, now, bva.OriginalVesting.Sub(stillVesting...), accUnlocks)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return clawedback, clawback, nil
+}
+
+// periodicVestingAccount returns bva as a periodic vesting account starting at
+// startTime, with a period that ends at now for the coins vested by then and a
+// period for every unlock after now. The end time of bva is set to the end of
+// the last period.
+func periodicVestingAccount(bva *vestingtypes.BaseVestingAccount, startTime, now int64, vested sdk.Coins, unlocks map[int64]sdk.Coins) (*vestingtypes.PeriodicVestingAccount, error) {
+	var periods vestingtypes.Periods
+	periodStart := startTime
+	if !vested.IsZero() {
+		periods = append(periods, vestingtypes.Period{Length: now - startTime, Amount: vested})
+		periodStart = now
+	}
+	for _, t := range sortedTimes(unlocks, false) {
+		periods = append(periods, vestingtypes.Period{Length: t - periodStart, Amount: unlocks[t]})
+		periodStart = t
+	}
+	bva.EndTime = periodStart
+
+	acc := vestingtypes.NewPeriodicVestingAccountRaw(bva, startTime, periods)
+	return acc, acc.Validate()
+}
+
+// sortedTimes returns the unlock times of unlocks in ascending order, or in
+// descending order when reverse is set.
+func sortedTimes(unlocks map[int64]sdk.Coins, reverse bool) []int64 {
+	times := make([]int64, 0, len(unlocks))
+	for t := range unlocks {
+		times = append(times, t)
+	}
+	sort.Slice(times, func(i, j int) bool { return (times[i] < times[j]) != reverse })
+	return times
+}
+
+// baseVestingAccount returns the base vesting account of acc and the start
+// time of the merged periodic vesting account. The start time is no later than
+// now, so the coins that are vested at now stay vested.
+func baseVestingAccount(acc vestexported.VestingAccount, now int64) (*vestingtypes.BaseVestingAccount, int64, error) {
+	switch acc := acc.(type) {
+	case *vestingtypes.DelayedVestingAccount:
+		// delayed vesting has no start time, the coins of an account that
+		// is vested must still be vested at now
+		return acc.BaseVestingAccount, min(acc.EndTime-1, now), nil
+	case *vestingtypes.ContinuousVestingAccount:
+		return acc.BaseVestingAccount, min(acc.StartTime, now), nil
+	case *vestingtypes.PeriodicVestingAccount:
+		return acc.BaseVestingAccount, min(acc.StartTime, now), nil
+	}
+
+	return nil, 0, fmt.Errorf("cannot merge vesting into account of type %T", acc)
+}
+
+// vestingUnlocks returns the coins of acc that unlock after now, keyed by the
+// unix time they unlock at.
+func vestingUnlocks(acc vestexported.VestingAccount, now int64) (map[int64]sdk.Coins, error) {
+	unlocks := make(map[int64]sdk.Coins)
+	add := func(t int64, coins sdk.Coins) {
+		if t > now && !coins.IsZero() {
+			unlocks[t] = unlocks[t].Add(coins...)
+		}
+	}
+
+	switch acc := acc.(type) {
+	case *vestingtypes.DelayedVestingAccount:
+		add(acc.EndTime, acc.OriginalVesting)
+
+	case *vestingtypes.PeriodicVestingAccount:
+		t := acc.StartTime
+		for _, period := range acc.VestingPeriods {
+			t += period.Length
+			add(t, period.Amount)
+		}
+
+	case *vestingtypes.ContinuousVestingAccount:
+		if acc.EndTime <= now {
+			break
+		}
+		// Vesting that runs for longer than MaxVestingUnlocks intervals
+		// unlocks every few intervals instead, so an account vesting for
+		// decades is not expanded into one unlock per day
+		step := int64(ContinuousUnlockSeconds)
+		if intervals := (acc.EndTime - now) / ContinuousUnlockSeconds / MaxVestingUnlocks; intervals > 0 {
+			step = (intervals + 1) * ContinuousUnlockSeconds
+		}
+
+		previous := acc.GetVestedCoins(time.Unix(now, 0))
+		for t := now - now%step + step; ; t += step {
+			t = min(t, acc.EndTime)
+			vested := acc.GetVestedCoins(time.Unix(t, 0))
+			add(t, vested.Sub(previous...))
+			previous = vested
+			if t == acc.EndTime {
+				break
+			}
+		}
+
+	default:
+		return nil, fmt.Errorf("cannot merge vesting of account of type %T", acc)
+	}
+
+	return unlocks, nil
+}
@@ -0,0 +1,359 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+const day = ContinuousUnlockSeconds
+
+func ugd(amount int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin("uugd", amount))
+}
+
+func vestingBaseAccount() *authtypes.BaseAccount {
+	return authtypes.NewBaseAccount(sdk.AccAddress("recipient"), nil, 7, 3)
+}
+
+func requirePeriods(t *testing.T, acc *vestingtypes.PeriodicVestingAccount, expected vestingtypes.Periods) {
+	t.Helper()
+	if len(acc.VestingPeriods) != len(expected) {
+		t.Fatalf("expected periods %s, got %s", expected, vestingtypes.Periods(acc.VestingPeriods))
+	}
+	for i := range expected {
+		if acc.VestingPeriods[i].Length != expected[i].Length || !acc.VestingPeriods[i].Amount.Equal(expected[i].Amount) {
+			t.Fatalf("expected periods %s, got %s", expected, vestingtypes.Periods(acc.VestingPeriods))
+		}
+	}
+}
+
+func requireVested(t *testing.T, acc vestexported.VestingAccount, at int64, expected int64) {
+	t.Helper()
+	if vested := acc.GetVestedCoins(time.Unix(at, 0)).AmountOf("uugd"); vested.Int64() != expected {
+		t.Errorf("expected %d to be vested at %d, got %s", expected, at, vested)
+	}
+}
+
+func TestMergeVestingAccountDelayed(t *testing.T) {
+	const now = int64(1_000_000)
+	policy := VestingPolicy{Kind: VestingDelayed, DurationSeconds: 100}
+
+	acc, err := vestingtypes.NewDelayedVestingAccount(vestingBaseAccount(), ugd(1000), now+500)
+	if err != nil {
+		t.Fatal(err)
+	}
+	merged, err := policy.MergeVestingAccount(acc, ugd(200), now)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	requirePeriods(t, merged, vestingtypes.Periods{{Length: 100, Amount: ugd(200)}, {Length: 400, Amount: ugd(1000)}})
+	if merged.StartTime != now || merged.EndTime != now+500 {
+		t.Errorf("expected vesting from %d to %d, got %d to %d", now, now+500, merged.StartTime, merged.EndTime)
+	}
+	requireVested(t, merged, now+99, 0)
+	requireVested(t, merged, now+100, 200)
+	requireVested(t, merged, now+499, 200)
+	requireVested(t, merged, now+500, 1200)
+}
+
+func TestMergeVestingAccountVestedDelayed(t *testing.T) {
+	const now = int64(1_000_000)
+	policy := VestingPolicy{Kind: VestingDelayed, DurationSeconds: 100}
+
+	for _, endTime := range []int64{now - 10, now} {
+		acc, err := vestingtypes.NewDelayedVestingAccount(vestingBaseAccount(), ugd(1000), endTime)
+		if err != nil {
+			t.Fatal(err)
+		}
+		merged, err := policy.MergeVestingAccount(acc, ugd(200), now)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// the coins vested before the merge must not be locked again
+		requireVested(t, merged, now, 1000)
+		requireVested(t, merged, now+100, 1200)
+	}
+}
+
+func TestMergeVestingAccountContinuous(t *testing.T) {
+	const start = int64(100 * day)
+	now := start + 5*day/2
+	policy := VestingPolicy{Kind: VestingDelayed, DurationSeconds: day}
+
+	acc, err := vestingtypes.NewContinuousVestingAccount(vestingBaseAccount(), ugd(1000), start, start+10*day)
+	if err != nil {
+		t.Fatal(err)
+	}
+	merged, err := policy.MergeVestingAccount(acc, ugd(500), now)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if merged.StartTime != start || merged.EndTime != start+10*day {
+		t.Errorf("expected vesting from %d to %d, got %d to %d", start, start+10*day, merged.StartTime, merged.EndTime)
+	}
+	requireVested(t, merged, now, 250)
+	requireVested(t, merged, start+3*day, 300)
+	requireVested(t, merged, now+day-1, 300)
+	requireVested(t, merged, now+day, 800)
+	requireVested(t, merged, start+10*day, 1500)
+
+	// the continuous vesting never unlocks earlier than it did before
+	for at := now; at <= start+10*day; at += 3600 {
+		expected := acc.GetVestedCoins(time.Unix(at, 0))
+		if at >= now+day {
+			expected = expected.Add(ugd(500)...)
+		}
+		if vested := merged.GetVestedCoins(time.Unix(at, 0)); !vested.IsAllLTE(expected) {
+			t.Fatalf("expected at most %s to be vested at %d, got %s", expected, at, vested)
+		}
+	}
+}
+
+func TestMergeVestingAccountContinuousTranche(t *testing.T) {
+	const now = int64(100 * day)
+	policy := VestingPolicy{Kind: VestingContinuous, DurationSeconds: 4 * day, CliffSeconds: day}
+
+	acc, err := vestingtypes.NewDelayedVestingAccount(vestingBaseAccount(), ugd(1000), now+2*day)
+	if err != nil {
+		t.Fatal(err)
+	}
+	merged, err := policy.MergeVestingAccount(acc, ugd(300), now)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	requirePeriods(t, merged, vestingtypes.Periods{
+		{Length: 2 * day, Amount: ugd(1100)},
+		{Length: day, Amount: ugd(100)},
+		{Length: day, Amount: ugd(100)},
+	})
+}
+
+func TestMergeVestingAccountPeriodic(t *testing.T) {
+	const start = int64(1_000_000)
+	now := start + 150
+	policy := VestingPolicy{Kind: VestingPeriodic, DurationSeconds: 100, PeriodCount: 2}
+
+	acc, err := vestingtypes.NewPeriodicVestingAccount(vestingBaseAccount(), ugd(300), start, vestingtypes.Periods{
+		{Length: 100, Amount: ugd(100)},
+		{Length: 100, Amount: ugd(100)},
+		{Length: 100, Amount: ugd(100)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	merged, err := policy.MergeVestingAccount(acc, ugd(60), now)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	requirePeriods(t, merged, vestingtypes.Periods{
+		{Length: 150, Amount: ugd(100)},
+		{Length: 50, Amount: ugd(130)},
+		{Length: 50, Amount: ugd(30)},
+		{Length: 50, Amount: ugd(100)},
+	})
+	if merged.StartTime != start || merged.EndTime != start+300 {
+		t.Errorf("expected vesting from %d to %d, got %d to %d", start, start+300, merged.StartTime, merged.EndTime)
+	}
+	requireVested(t, merged, now, 100)
+	requireVested(t, merged, start+200, 230)
+}
+
+func TestMergeVestingAccountDelegated(t *testing.T) {
+	const now = int64(1_000_000)
+	policy := VestingPolicy{Kind: VestingDelayed, DurationSeconds: 100}
+
+	acc, err := vestingtypes.NewContinuousVestingAccount(vestingBaseAccount(), ugd(1000), now-500, now+500)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// delegate 800 of the balance, 500 of which are vesting
+	acc.TrackDelegation(time.Unix(now, 0), ugd(1000), ugd(800))
+
+	merged, err := policy.MergeVestingAccount(acc, ugd(200), now)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !merged.DelegatedVesting.Equal(ugd(500)) || !merged.DelegatedFree.Equal(ugd(300)) {
+		t.Errorf("expected 500 delegated vesting and 300 delegated free, got %s and %s", merged.DelegatedVesting, merged.DelegatedFree)
+	}
+	if merged.GetAccountNumber() != 7 || merged.GetSequence() != 3 || !merged.GetAddress().Equals(acc.GetAddress()) {
+		t.Errorf("expected the base account to be kept, got %s", merged.BaseAccount)
+	}
+	if !merged.OriginalVesting.Equal(ugd(1200)) {
+		t.Errorf("expected 1200 original vesting, got %s", merged.OriginalVesting)
+	}
+	// the 700 coins still vesting are covered by the delegation up to 500
+	if locked := merged.LockedCoins(time.Unix(now, 0)); !locked.Equal(ugd(200)) {
+		t.Errorf("expected 200 to be locked, got %s", locked)
+	}
+}
+
+func TestMergeVestingAccountUnsupported(t *testing.T) {
+	acc, err := vestingtypes.NewDelayedVestingAccount(vestingBaseAccount(), ugd(1000), 2_000_000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := (VestingPolicy{Kind: VestingNone}).MergeVestingAccount(acc, ugd(200), 1_000_000); err == nil {
+		t.Error("expected the vesting policy none to have nothing to merge")
+	}
+
+	locked, err := vestingtypes.NewPermanentLockedAccount(vestingBaseAccount(), ugd(1000))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := DefaultVestingPolicy().MergeVestingAccount(locked, ugd(200), 1_000_000); err == nil {
+		t.Error("expected permanently locked accounts not to be merged into")
+	}
+}
+
+func TestClawbackVestingAccount(t *testing.T) {
+	const now = int64(1_000_000)
+	policy := VestingPolicy{Kind: VestingDelayed, DurationSeconds: 100}
+
+	acc, err := vestingtypes.NewDelayedVestingAccount(vestingBaseAccount(), ugd(1000), now+500)
+	if err != nil {
+		t.Fatal(err)
+	}
+	merged, err := policy.MergeVestingAccount(acc, ugd(200), now)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	clawedback, clawback, err := ClawbackVestingAccount(merged, []EscrowUnlock{{UnlockTime: now + 100, Amount: ugd(200)}}, now+50)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !clawback.Equal(ugd(200)) {
+		t.Fatalf("expected 200 to be clawed back, got %s", clawback)
+	}
+	periodic := clawedback.(*vestingtypes.PeriodicVestingAccount)
+	requirePeriods(t, periodic, vestingtypes.Periods{{Length: 500, Amount: ugd(1000)}})
+	if !periodic.OriginalVesting.Equal(ugd(1000)) || periodic.GetAccountNumber() != 7 {
+		t.Errorf("expected 1000 original vesting in account 7, got %s in account %d", periodic.OriginalVesting, periodic.GetAccountNumber())
+	}
+	requireVested(t, periodic, now+100, 0)
+	requireVested(t, periodic, now+500, 1000)
+
+	// nothing is left to claw back once the mint has vested
+	if _, clawback, err := ClawbackVestingAccount(merged, []EscrowUnlock{{UnlockTime: now + 100, Amount: ugd(200)}}, now+100); err != nil || !clawback.IsZero() {
+		t.Errorf("expected nothing to be clawed back, got %s: %v", clawback, err)
+	}
+}
+
+func TestClawbackVestingAccountAll(t *testing.T) {
+	const now = int64(1_000_000)
+
+	acc, err := vestingtypes.NewDelayedVestingAccount(vestingBaseAccount(), ugd(200), now+100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	clawedback, clawback, err := ClawbackVestingAccount(acc, []EscrowUnlock{{UnlockTime: now + 100, Amount: ugd(200)}}, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !clawback.Equal(ugd(200)) {
+		t.Fatalf("expected 200 to be clawed back, got %s", clawback)
+	}
+	if base, ok := clawedback.(*authtypes.BaseAccount); !ok || base.GetAccountNumber() != 7 || base.GetSequence() != 3 {
+		t.Errorf("expected the base account to be left, got %v", clawedback)
+	}
+}
+
+func TestClawbackVestingAccountLastUnlocks(t *testing.T) {
+	const start = int64(1_000_000)
+
+	acc, err := vestingtypes.NewPeriodicVestingAccount(vestingBaseAccount(), ugd(650), start, vestingtypes.Periods{
+		{Length: 100, Amount: ugd(100)},
+		{Length: 100, Amount: ugd(50)},
+		{Length: 200, Amount: ugd(500)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the mint unlocks more at start+200 than the account does, the rest is
+	// taken from the last unlock of the account
+	clawedback, clawback, err := ClawbackVestingAccount(acc, []EscrowUnlock{
+		{UnlockTime: start + 100, Amount: ugd(100)},
+		{UnlockTime: start + 200, Amount: ugd(200)},
+	}, start+150)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !clawback.Equal(ugd(200)) {
+		t.Fatalf("expected 200 to be clawed back, got %s", clawback)
+	}
+	requirePeriods(t, clawedback.(*vestingtypes.PeriodicVestingAccount), vestingtypes.Periods{
+		{Length: 150, Amount: ugd(100)},
+		{Length: 250, Amount: ugd(350)},
+	})
+}
+
+func TestClawbackVestingAccountDelegated(t *testing.T) {
+	const now = int64(1_000_000)
+
+	acc, err := vestingtypes.NewDelayedVestingAccount(vestingBaseAccount(), ugd(1000), now+500)
+	if err != nil {
+		t.Fatal(err)
+	}
+	acc.TrackDelegation(time.Unix(now, 0), ugd(1000), ugd(1000))
+
+	clawedback, clawback, err := ClawbackVestingAccount(acc, []EscrowUnlock{{UnlockTime: now + 500, Amount: ugd(200)}}, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !clawback.Equal(ugd(200)) {
+		t.Fatalf("expected 200 to be clawed back, got %s", clawback)
+	}
+	periodic := clawedback.(*vestingtypes.PeriodicVestingAccount)
+	if !periodic.DelegatedVesting.Equal(ugd(800)) || !periodic.DelegatedFree.Equal(ugd(200)) {
+		t.Errorf("expected 800 delegated vesting and 200 delegated free, got %s and %s", periodic.DelegatedVesting, periodic.DelegatedFree)
+	}
+}
+
+func TestMergeVestingAccountLongContinuous(t *testing.T) {
+	const start = int64(100 * day)
+	now := start + day/2
+	end := start + 50*365*day
+	policy := VestingPolicy{Kind: VestingDelayed, DurationSeconds: day}
+
+	acc, err := vestingtypes.NewContinuousVestingAccount(vestingBaseAccount(), ugd(1_000_000), start, end)
+	if err != nil {
+		t.Fatal(err)
+	}
+	merged, err := policy.MergeVestingAccount(acc, ugd(500), now)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the vested period, the tranche and at most MaxVestingUnlocks unlocks of
+	// the continuous vesting
+	if len(merged.VestingPeriods) > MaxVestingUnlocks+2 {
+		t.Fatalf("expected at most %d periods, got %d", MaxVestingUnlocks+2, len(merged.VestingPeriods))
+	}
+	if merged.EndTime != end {
+		t.Errorf("expected vesting to end at %d, got %d", end, merged.EndTime)
+	}
+	requireVested(t, merged, end, 1_000_500)
+
+	// the continuous vesting never unlocks earlier than it did before
+	for at := now; at <= end; at += 73 * day {
+		expected := acc.GetVestedCoins(time.Unix(at, 0))
+		if at >= now+day {
+			expected = expected.Add(ugd(500)...)
+		}
+		if vested := merged.GetVestedCoins(time.Unix(at, 0)); !vested.IsAllLTE(expected) {
+			t.Fatalf("expected at most %s to be vested at %d, got %s", expected, at, vested)
+		}
+	}
+}
@@ -0,0 +1,153 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// Kinds of vesting of the vesting policy
+const (
+	// VestingNone pays the coins of a Hedgehog mint out unlocked.
+	VestingNone = "none"
+	// VestingDelayed unlocks the coins all at once at the end of the duration.
+	VestingDelayed = "delayed"
+	// VestingContinuous unlocks the coins linearly from the end of the cliff
+	// to the end of the duration.
+	VestingContinuous = "continuous"
+	// VestingPeriodic unlocks the coins in equal periods from the end of the
+	// cliff to the end of the duration.
+	VestingPeriodic = "periodic"
+)
+
+// MaxVestingUnlocks caps the number of times the coins of a single Hedgehog
+// mint unlock at. Every unlock is stored with the mint record, the escrow
+// tranche and the periods of the vesting account of the recipient, so the
+// number of periods and, as continuous vesting unlocks every
+// ContinuousUnlockSeconds once it is merged or escrowed, the number of days a
+// continuous policy vests over are bounded by it.
+const MaxVestingUnlocks = 1000
+
+// DefaultVestingPolicy locks the coins of a Hedgehog mint for ten years.
+func DefaultVestingPolicy() VestingPolicy {
+	return VestingPolicy{
+		Kind:            VestingDelayed,
+		DurationSeconds: 10 * 365 * 24 * 60 * 60,
+	}
+}
+
+// NewVestingAccount returns baseAcc vesting coins under the policy, starting
+// at startTime. The vesting policy none returns baseAcc itself.
+func (p VestingPolicy) NewVestingAccount(baseAcc *authtypes.BaseAccount, coins sdk.Coins, startTime int64) (sdk.AccountI, error) {
+	endTime := startTime + int64(p.DurationSeconds)
+
+	switch p.Kind {
+	case VestingNone:
+		return baseAcc, nil
+	case VestingDelayed:
+		return vestingtypes.NewDelayedVestingAccount(baseAcc, coins, endTime)
+	case VestingContinuous:
+		return vestingtypes.NewContinuousVestingAccount(baseAcc, coins, startTime+int64(p.CliffSeconds), endTime)
+	case VestingPeriodic:
+		return vestingtypes.NewPeriodicVestingAccount(baseAcc, coins, startTime, p.Periods(coins))
+	}
+
+	return nil, fmt.Errorf("unknown vesting policy: %s", p.Kind)
+}
+
+// Periods returns the periods coins vest in under the periodic vesting
+// policy. The first period includes the cliff, and the seconds and coins that
+// do not divide evenly vest with the last period. Periods that would vest no
+// coins, because there are fewer coins than periods, are joined with the
+// period after them.
+func (p VestingPolicy) Periods(coins sdk.Coins) vestingtypes.Periods {
+	if p.PeriodCount == 0 {
+		return nil
+	}
+
+	count := int64(p.PeriodCount)
+	length := int64(p.DurationSeconds-p.CliffSeconds) / count
+	amount := sdk.NewCoins()
+	for _, coin := range coins {
+		amount = amount.Add(sdk.NewCoin(coin.Denom, coin.Amount.QuoRaw(count)))
+	}
+
+	periods := make(vestingtypes.Periods, count)
+	for i := range periods {
+		periods[i] = vestingtypes.Period{Length: length, Amount: amount}
+	}
+	periods[0].Length += int64(p.CliffSeconds)
+
+	last := &periods[count-1]
+	last.Length += int64(p.DurationSeconds-p.CliffSeconds) - length*count
+	last.Amount = last.Amount.Add(coins.Sub(amount.MulInt(math.NewInt(count))...)...)
+
+	if amount.IsZero() {
+		length := periods.TotalLength()
+		return vestingtypes.Periods{{Length: length, Amount: last.Amount}}
+	}
+	return periods
+}
+
+// Validate validates the vesting policy.
+func (p VestingPolicy) Validate() error {
+	switch p.Kind {
+	case VestingNone:
+		if p.DurationSeconds != 0 || p.PeriodCount != 0 || p.CliffSeconds != 0 {
+			return fmt.Errorf("vesting policy none cannot have a duration, period count or cliff")
+		}
+		if p.Escrow {
+			return fmt.Errorf("vesting policy none has no coins to escrow")
+		}
+		return nil
+	case VestingDelayed, VestingContinuous, VestingPeriodic:
+	default:
+		return fmt.Errorf("unknown vesting policy: %s", p.Kind)
+	}
+
+	if p.DurationSeconds == 0 {
+		return fmt.Errorf("%s vesting policy must have a duration", p.Kind)
+	}
+	if p.Kind == VestingDelayed && p.CliffSeconds != 0 {
+		return fmt.Errorf("delayed vesting policy cannot have a cliff")
+	}
+	if p.CliffSeconds >= p.DurationSeconds {
+		return fmt.Errorf("vesting cliff %d must be shorter than the duration %d", p.CliffSeconds, p.DurationSeconds)
+	}
+
+	if p.Kind == VestingContinuous {
+		if unlocks := (p.DurationSeconds-p.CliffSeconds)/ContinuousUnlockSeconds + 1; unlocks > MaxVestingUnlocks {
+			return fmt.Errorf("continuous vesting policy unlocks %d times, at most %d are allowed", unlocks, MaxVestingUnlocks)
+		}
+	}
+	if p.Kind != VestingPeriodic {
+		if p.PeriodCount != 0 {
+			return fmt.Errorf("%s vesting policy cannot have a period count", p.Kind)
+		}
+		return nil
+	}
+	if p.PeriodCount == 0 {
+		return fmt.Errorf("periodic vesting policy must have a period count")
+	}
+	if uint64(p.PeriodCount) > p.DurationSeconds-p.CliffSeconds {
+		return fmt.Errorf("vesting period count %d exceeds the %d seconds after the cliff", p.PeriodCount, p.DurationSeconds-p.CliffSeconds)
+	}
+	if p.PeriodCount > MaxVestingUnlocks {
+		return fmt.Errorf("vesting period count %d exceeds the maximum of %d", p.PeriodCount, MaxVestingUnlocks)
+	}
+
+	return nil
+}
+
+func validateVestingPolicy(i interface{}) error {
+	v, ok := i.(VestingPolicy)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return v.Validate()
+}
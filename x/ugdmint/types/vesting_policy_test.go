@@ -0,0 +1,137 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+func TestVestingPolicyValidate(t *testing.T) {
+	valid := []VestingPolicy{
+		{Kind: VestingNone},
+		{Kind: VestingDelayed, DurationSeconds: 100},
+		{Kind: VestingContinuous, DurationSeconds: 100, CliffSeconds: 99},
+		{Kind: VestingPeriodic, DurationSeconds: 100, CliffSeconds: 40, PeriodCount: 60},
+		{Kind: VestingPeriodic, DurationSeconds: 10 * 365 * ContinuousUnlockSeconds, PeriodCount: MaxVestingUnlocks},
+		{Kind: VestingContinuous, DurationSeconds: (MaxVestingUnlocks - 1) * ContinuousUnlockSeconds},
+		{Kind: VestingContinuous, DurationSeconds: 10 * 365 * ContinuousUnlockSeconds, CliffSeconds: 9 * 365 * ContinuousUnlockSeconds},
+	}
+	for _, policy := range valid {
+		if err := policy.Validate(); err != nil {
+			t.Errorf("expected %s to be valid: %v", policy.String(), err)
+		}
+	}
+
+	invalid := []VestingPolicy{
+		{Kind: ""},
+		{Kind: VestingNone, DurationSeconds: 100},
+		{Kind: VestingNone, Escrow: true},
+		{Kind: VestingDelayed},
+		{Kind: VestingDelayed, DurationSeconds: 100, CliffSeconds: 10},
+		{Kind: VestingContinuous, DurationSeconds: 100, CliffSeconds: 100},
+		{Kind: VestingContinuous, DurationSeconds: 100, PeriodCount: 4},
+		{Kind: VestingPeriodic, DurationSeconds: 100},
+		{Kind: VestingPeriodic, DurationSeconds: 100, CliffSeconds: 40, PeriodCount: 61},
+		{Kind: VestingPeriodic, DurationSeconds: 10 * 365 * ContinuousUnlockSeconds, PeriodCount: MaxVestingUnlocks + 1},
+		{Kind: VestingContinuous, DurationSeconds: MaxVestingUnlocks * ContinuousUnlockSeconds},
+		{Kind: VestingContinuous, DurationSeconds: 10 * 365 * ContinuousUnlockSeconds},
+	}
+	for _, policy := range invalid {
+		if err := policy.Validate(); err == nil {
+			t.Errorf("expected %s to be invalid", policy.String())
+		}
+	}
+}
+
+func TestVestingPolicyPeriods(t *testing.T) {
+	policy := VestingPolicy{Kind: VestingPeriodic, DurationSeconds: 100, CliffSeconds: 30, PeriodCount: 3}
+	coins := sdk.NewCoins(sdk.NewInt64Coin("uugd", 1000))
+
+	periods := policy.Periods(coins)
+	expected := vestingtypes.Periods{
+		{Length: 53, Amount: sdk.NewCoins(sdk.NewInt64Coin("uugd", 333))},
+		{Length: 23, Amount: sdk.NewCoins(sdk.NewInt64Coin("uugd", 333))},
+		{Length: 24, Amount: sdk.NewCoins(sdk.NewInt64Coin("uugd", 334))},
+	}
+	if len(periods) != len(expected) {
+		t.Fatalf("expected %d periods, got %d", len(expected), len(periods))
+	}
+	for i := range expected {
+		if periods[i].Length != expected[i].Length || !periods[i].Amount.Equal(expected[i].Amount) {
+			t.Errorf("expected period %d to be %s, got %s", i, expected[i].String(), periods[i].String())
+		}
+	}
+	if total := periods.TotalAmount(); !total.Equal(coins) {
+		t.Errorf("expected the periods to vest %s, got %s", coins, total)
+	}
+	if length := periods.TotalLength(); length != int64(policy.DurationSeconds) {
+		t.Errorf("expected the periods to last %ds, got %ds", policy.DurationSeconds, length)
+	}
+}
+
+func TestVestingPolicyPeriodsFewCoins(t *testing.T) {
+	policy := VestingPolicy{Kind: VestingPeriodic, DurationSeconds: 100, CliffSeconds: 30, PeriodCount: 3}
+	coins := sdk.NewCoins(sdk.NewInt64Coin("uugd", 2))
+
+	periods := policy.Periods(coins)
+	if len(periods) != 1 || periods[0].Length != 100 || !periods[0].Amount.Equal(coins) {
+		t.Errorf("expected a single period vesting %s after 100s, got %s", coins, periods.String())
+	}
+	if _, err := policy.NewVestingAccount(authtypes.NewBaseAccountWithAddress(sdk.AccAddress("recipient")), coins, 1_000_000); err != nil {
+		t.Errorf("expected the coins to vest: %v", err)
+	}
+}
+
+func TestVestingPolicyNewVestingAccount(t *testing.T) {
+	coins := sdk.NewCoins(sdk.NewInt64Coin("uugd", 1000))
+	const start = int64(1_000_000)
+
+	tests := []struct {
+		policy  VestingPolicy
+		endTime int64
+		// vested is the amount vested halfway through the duration
+		vested int64
+	}{
+		{VestingPolicy{Kind: VestingDelayed, DurationSeconds: 100}, start + 100, 0},
+		{VestingPolicy{Kind: VestingContinuous, DurationSeconds: 100, CliffSeconds: 50}, start + 100, 0},
+		{VestingPolicy{Kind: VestingContinuous, DurationSeconds: 100}, start + 100, 500},
+		{VestingPolicy{Kind: VestingPeriodic, DurationSeconds: 100, PeriodCount: 4}, start + 100, 500},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.policy.String(), func(t *testing.T) {
+			base := authtypes.NewBaseAccountWithAddress(sdk.AccAddress("recipient"))
+			acc, err := tc.policy.NewVestingAccount(base, coins, start)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			vestingAcc, ok := acc.(vestexported.VestingAccount)
+			if !ok {
+				t.Fatalf("expected a vesting account, got %T", acc)
+			}
+			if vestingAcc.GetEndTime() != tc.endTime {
+				t.Errorf("expected vesting to end at %d, got %d", tc.endTime, vestingAcc.GetEndTime())
+			}
+			if !vestingAcc.GetOriginalVesting().Equal(coins) {
+				t.Errorf("expected %s to vest, got %s", coins, vestingAcc.GetOriginalVesting())
+			}
+			vested := vestingAcc.GetVestedCoins(time.Unix(start+50, 0)).AmountOf("uugd")
+			if vested.Int64() != tc.vested {
+				t.Errorf("expected %d to be vested halfway, got %s", tc.vested, vested)
+			}
+		})
+	}
+
+	acc, err := VestingPolicy{Kind: VestingNone}.NewVestingAccount(authtypes.NewBaseAccountWithAddress(sdk.AccAddress("recipient")), coins, start)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := acc.(*authtypes.BaseAccount); !ok {
+		t.Errorf("expected the vesting policy none to keep the base account, got %T", acc)
+	}
+}
@@ -0,0 +1,57 @@
+package types
+
+import (
+	"fmt"
+)
+
+// MaxMintsPerVoteExtension caps the number of mints a single validator may put
+// into its vote extension, bounding the size of the injected commit info.
+const MaxMintsPerVoteExtension = 256
+
+// Key returns the Hedgehog key of the mint, in the address/height form used by
+// the mint-storage spork.
+func (m HedgehogMint) Key() string {
+	return fmt.Sprintf("%s/%d", m.Address, m.Height)
+}
+
+// Validate performs a stateless sanity check of the mint.
+func (m HedgehogMint) Validate() error {
+	if _, err := ConvertStringToAcc(m.Address); err != nil {
+		return fmt.Errorf("invalid mint address %s: %w", m.Address, err)
+	}
+	if m.Height == 0 {
+		return fmt.Errorf("mint %s has no height", m.Key())
+	}
+	if m.Amount <= 0 {
+		return fmt.Errorf("mint %s amount must be positive, is %d", m.Key(), m.Amount)
+	}
+
+	return nil
+}
+
+// Validate checks that every mint of the vote extension is valid, scheduled
+// for the extension height and listed only once.
+func (ve MintVoteExtension) Validate() error {
+	if ve.Height <= 0 {
+		return fmt.Errorf("vote extension height must be positive, is %d", ve.Height)
+	}
+	if len(ve.Mints) > MaxMintsPerVoteExtension {
+		return fmt.Errorf("vote extension holds %d mints, at most %d are allowed", len(ve.Mints), MaxMintsPerVoteExtension)
+	}
+
+	seen := make(map[string]bool, len(ve.Mints))
+	for _, mint := range ve.Mints {
+		if err := mint.Validate(); err != nil {
+			return err
+		}
+		if mint.Height != uint64(ve.Height) {
+			return fmt.Errorf("mint %s does not belong to vote extension height %d", mint.Key(), ve.Height)
+		}
+		if seen[mint.Key()] {
+			return fmt.Errorf("duplicate mint %s in vote extension", mint.Key())
+		}
+		seen[mint.Key()] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,894 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/ugdmint/v1beta1/vote_extension.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// HedgehogMint is a single mint published by Hedgehog that pays amount uugd
+// to address at the given block height.
+type HedgehogMint struct {
+	// bech32 address of the account receiving the mint
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// block height at which the mint is executed
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// amount of uugd to mint
+	Amount int64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// timestamp of the Hedgehog payload the mint was observed in
+	Timestamp string `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (m *HedgehogMint) Reset()         { *m = HedgehogMint{} }
+func (m *HedgehogMint) String() string { return proto.CompactTextString(m) }
+func (*HedgehogMint) ProtoMessage()    {}
+func (*HedgehogMint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59a1487b638d2c58, []int{0}
+}
+func (m *HedgehogMint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HedgehogMint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HedgehogMint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HedgehogMint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HedgehogMint.Merge(m, src)
+}
+func (m *HedgehogMint) XXX_Size() int {
+	return m.Size()
+}
+func (m *HedgehogMint) XXX_DiscardUnknown() {
+	xxx_messageInfo_HedgehogMint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HedgehogMint proto.InternalMessageInfo
+
+func (m *HedgehogMint) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *HedgehogMint) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *HedgehogMint) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *HedgehogMint) GetTimestamp() string {
+	if m != nil {
+		return m.Timestamp
+	}
+	return ""
+}
+
+// MintVoteExtension is attached by a validator to its pre-commit vote and lists
+// the Hedgehog mints it observed for the next block height.
+type MintVoteExtension struct {
+	// height the observed mints are scheduled for
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// mints observed by the validator
+	Mints []HedgehogMint `protobuf:"bytes,2,rep,name=mints,proto3" json:"mints"`
+}
+
+func (m *MintVoteExtension) Reset()         { *m = MintVoteExtension{} }
+func (m *MintVoteExtension) String() string { return proto.CompactTextString(m) }
+func (*MintVoteExtension) ProtoMessage()    {}
+func (*MintVoteExtension) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59a1487b638d2c58, []int{1}
+}
+func (m *MintVoteExtension) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintVoteExtension) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintVoteExtension.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintVoteExtension) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintVoteExtension.Merge(m, src)
+}
+func (m *MintVoteExtension) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintVoteExtension) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintVoteExtension.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintVoteExtension proto.InternalMessageInfo
+
+func (m *MintVoteExtension) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *MintVoteExtension) GetMints() []HedgehogMint {
+	if m != nil {
+		return m.Mints
+	}
+	return nil
+}
+
+// AgreedMints holds the Hedgehog mints that validators with more than 2/3 of
+// the voting power agreed on for a block height.
+type AgreedMints struct {
+	// height the mints are executed at
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// mints to execute, in deterministic order
+	Mints []HedgehogMint `protobuf:"bytes,2,rep,name=mints,proto3" json:"mints"`
+}
+
+func (m *AgreedMints) Reset()         { *m = AgreedMints{} }
+func (m *AgreedMints) String() string { return proto.CompactTextString(m) }
+func (*AgreedMints) ProtoMessage()    {}
+func (*AgreedMints) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59a1487b638d2c58, []int{2}
+}
+func (m *AgreedMints) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AgreedMints) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AgreedMints.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AgreedMints) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AgreedMints.Merge(m, src)
+}
+func (m *AgreedMints) XXX_Size() int {
+	return m.Size()
+}
+func (m *AgreedMints) XXX_DiscardUnknown() {
+	xxx_messageInfo_AgreedMints.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AgreedMints proto.InternalMessageInfo
+
+func (m *AgreedMints) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *AgreedMints) GetMints() []HedgehogMint {
+	if m != nil {
+		return m.Mints
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*HedgehogMint)(nil), "cosmos.ugdmint.v1beta1.HedgehogMint")
+	proto.RegisterType((*MintVoteExtension)(nil), "cosmos.ugdmint.v1beta1.MintVoteExtension")
+	proto.RegisterType((*AgreedMints)(nil), "cosmos.ugdmint.v1beta1.AgreedMints")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/ugdmint/v1beta1/vote_extension.proto", fileDescriptor_59a1487b638d2c58)
+}
+
+var fileDescriptor_59a1487b638d2c58 = []byte{
+	// 314 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x91, 0x41, 0x4b, 0xfb, 0x40,
+	0x10, 0xc5, 0xb3, 0x6d, 0xff, 0xfd, 0xd3, 0xad, 0x17, 0x83, 0x94, 0x20, 0x12, 0x43, 0xf1, 0x10,
+	0x90, 0x26, 0x54, 0x4f, 0xde, 0xb4, 0x20, 0x78, 0xf1, 0x12, 0xc1, 0x83, 0x17, 0x49, 0xbb, 0xc3,
+	0x66, 0x85, 0xdd, 0x09, 0xd9, 0x49, 0xa9, 0xdf, 0xc2, 0x8f, 0xd5, 0x63, 0x8f, 0x9e, 0x44, 0xda,
+	0x2f, 0x22, 0x69, 0x52, 0xed, 0xc1, 0xab, 0xb7, 0x7d, 0xb3, 0x6f, 0xe6, 0xc7, 0xe3, 0xf1, 0xf3,
+	0x19, 0x5a, 0x8d, 0x36, 0x2e, 0xa5, 0xd0, 0xca, 0x50, 0x3c, 0x1f, 0x4f, 0x81, 0xd2, 0x71, 0x3c,
+	0x47, 0x82, 0x67, 0x58, 0x10, 0x18, 0xab, 0xd0, 0x44, 0x79, 0x81, 0x84, 0xee, 0xa0, 0x36, 0x47,
+	0x8d, 0x39, 0x6a, 0xcc, 0xc7, 0x47, 0x12, 0x25, 0x6e, 0x2d, 0x71, 0xf5, 0xaa, 0xdd, 0xc3, 0x39,
+	0x3f, 0xb8, 0x03, 0x21, 0x21, 0x43, 0x79, 0xaf, 0x0c, 0xb9, 0x1e, 0xff, 0x9f, 0x0a, 0x51, 0x80,
+	0xb5, 0x1e, 0x0b, 0x58, 0xd8, 0x4b, 0x76, 0xd2, 0x1d, 0xf0, 0x6e, 0x06, 0x4a, 0x66, 0xe4, 0xb5,
+	0x02, 0x16, 0x76, 0x92, 0x46, 0x55, 0xf3, 0x54, 0x63, 0x69, 0xc8, 0x6b, 0x07, 0x2c, 0x6c, 0x27,
+	0x8d, 0x72, 0x4f, 0x78, 0x8f, 0x94, 0x06, 0x4b, 0xa9, 0xce, 0xbd, 0xce, 0xf6, 0xd6, 0xcf, 0x60,
+	0xa8, 0xf9, 0x61, 0xc5, 0x7b, 0x44, 0x82, 0xdb, 0x5d, 0x80, 0x3d, 0x04, 0xab, 0x4f, 0x35, 0x88,
+	0x6b, 0xfe, 0xaf, 0x8a, 0x62, 0xbd, 0x56, 0xd0, 0x0e, 0xfb, 0x17, 0x67, 0xd1, 0xef, 0x11, 0xa3,
+	0xfd, 0x24, 0x93, 0xce, 0xf2, 0xe3, 0xd4, 0x49, 0xea, 0xc5, 0xa1, 0xe4, 0xfd, 0x1b, 0x59, 0x00,
+	0x88, 0xea, 0xcb, 0xfe, 0x1d, 0x68, 0xf2, 0xb0, 0x5c, 0xfb, 0x6c, 0xb5, 0xf6, 0xd9, 0xe7, 0xda,
+	0x67, 0x6f, 0x1b, 0xdf, 0x59, 0x6d, 0x7c, 0xe7, 0x7d, 0xe3, 0x3b, 0x4f, 0x57, 0x52, 0x51, 0x56,
+	0x4e, 0xa3, 0x19, 0xea, 0xb8, 0x34, 0x4a, 0x16, 0x4a, 0x8c, 0xf2, 0x02, 0x5f, 0x60, 0x46, 0x71,
+	0x8d, 0x19, 0xed, 0xfa, 0x5d, 0x7c, 0x37, 0x4d, 0xaf, 0x39, 0xd8, 0x69, 0x77, 0xdb, 0xd5, 0xe5,
+	0xd7, 0x00, 0x6c, 0xd9, 0x3a, 0x25, 0x08, 0x02, 0x00, 0x00,
+}
+
+func (m *HedgehogMint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HedgehogMint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HedgehogMint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Timestamp) > 0 {
+		i -= len(m.Timestamp)
+		copy(dAtA[i:], m.Timestamp)
+		i = encodeVarintVoteExtension(dAtA, i, uint64(len(m.Timestamp)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Amount != 0 {
+		i = encodeVarintVoteExtension(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Height != 0 {
+		i = encodeVarintVoteExtension(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintVoteExtension(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MintVoteExtension) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintVoteExtension) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintVoteExtension) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Mints) > 0 {
+		for iNdEx := len(m.Mints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Mints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVoteExtension(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintVoteExtension(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AgreedMints) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AgreedMints) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AgreedMints) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Mints) > 0 {
+		for iNdEx := len(m.Mints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Mints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVoteExtension(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintVoteExtension(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintVoteExtension(dAtA []byte, offset int, v uint64) int {
+	offset -= sovVoteExtension(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *HedgehogMint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovVoteExtension(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovVoteExtension(uint64(m.Height))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovVoteExtension(uint64(m.Amount))
+	}
+	l = len(m.Timestamp)
+	if l > 0 {
+		n += 1 + l + sovVoteExtension(uint64(l))
+	}
+	return n
+}
+
+func (m *MintVoteExtension) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovVoteExtension(uint64(m.Height))
+	}
+	if len(m.Mints) > 0 {
+		for _, e := range m.Mints {
+			l = e.Size()
+			n += 1 + l + sovVoteExtension(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AgreedMints) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovVoteExtension(uint64(m.Height))
+	}
+	if len(m.Mints) > 0 {
+		for _, e := range m.Mints {
+			l = e.Size()
+			n += 1 + l + sovVoteExtension(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovVoteExtension(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozVoteExtension(x uint64) (n int) {
+	return sovVoteExtension(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *HedgehogMint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVoteExtension
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HedgehogMint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HedgehogMint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Timestamp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVoteExtension(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MintVoteExtension) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVoteExtension
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintVoteExtension: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintVoteExtension: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mints = append(m.Mints, HedgehogMint{})
+			if err := m.Mints[len(m.Mints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVoteExtension(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AgreedMints) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVoteExtension
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AgreedMints: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AgreedMints: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mints = append(m.Mints, HedgehogMint{})
+			if err := m.Mints[len(m.Mints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVoteExtension(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipVoteExtension(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowVoteExtension
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowVoteExtension
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowVoteExtension
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthVoteExtension
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupVoteExtension
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthVoteExtension
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthVoteExtension        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowVoteExtension          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupVoteExtension = fmt.Errorf("proto: unexpected end of group")
+)